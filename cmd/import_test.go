@@ -5,27 +5,15 @@ import (
 	"github.com/webws/go-moda/logger"
 	"github.com/yonisaka/similarity/internal/di"
 	"testing"
-	"time"
 )
 
 func TestImport(t *testing.T) {
 	importUsecase := di.GetImportUsecase()
 
 	ctx := context.Background()
-	count := 0
-	for {
-		if count > 50 {
-			break
-		}
-
-		err := importUsecase.Import(ctx, nil, "sample_lelang.csv")
-		if err != nil {
-			logger.Errorw("error importing", "err", err)
-		}
-
-		time.Sleep(60 * time.Second)
-
-		count += 3
+	err := importUsecase.Import(ctx, nil, "sample_lelang.csv", "")
+	if err != nil {
+		logger.Errorw("error importing", "err", err)
 	}
 }
 
@@ -33,7 +21,7 @@ func TestMigrateToQdrant(t *testing.T) {
 	importUsecase := di.GetImportUsecase()
 
 	ctx := context.Background()
-	err := importUsecase.MigrateToQdrant(ctx)
+	err := importUsecase.MigrateToQdrant(ctx, "sample_lelang.csv")
 	if err != nil {
 		logger.Errorw("error migrating", "err", err)
 	}
@@ -43,8 +31,18 @@ func TestMigrateToElasticsearch(t *testing.T) {
 	importUsecase := di.GetImportUsecase()
 
 	ctx := context.Background()
-	err := importUsecase.MigrateToElasticsearch(ctx)
+	err := importUsecase.MigrateToElasticsearch(ctx, "sample_lelang.csv")
 	if err != nil {
 		logger.Errorw("error migrating", "err", err)
 	}
 }
+
+func TestReindexQdrant(t *testing.T) {
+	importUsecase := di.GetImportUsecase()
+
+	ctx := context.Background()
+	_, err := importUsecase.ReindexQdrant(ctx, "sample_lelang.csv")
+	if err != nil {
+		logger.Errorw("error reindexing", "err", err)
+	}
+}
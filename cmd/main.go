@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/healthcheck"
@@ -8,7 +9,9 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/requestid"
 	"github.com/joho/godotenv"
 	"github.com/yonisaka/similarity/internal/di"
+	"log"
 	"os"
+	"strconv"
 )
 
 func main() {
@@ -17,6 +20,23 @@ func main() {
 		panic(err)
 	}
 
+	// Apply pending schema migrations
+	if migrateOnStart, _ := strconv.ParseBool(os.Getenv("MIGRATE_ON_START")); migrateOnStart {
+		applied, err := di.GetSchemaMigrator().Up(context.Background())
+		if err != nil {
+			panic(err)
+		}
+
+		for _, m := range applied {
+			log.Printf("applied migration %03d_%s", m.Version, m.Name)
+		}
+	}
+
+	// Check the stored vectors match the embedder
+	if err := di.CheckVectorDimensions(context.Background()); err != nil {
+		panic(err)
+	}
+
 	// Create new Fiber instance
 	app := fiber.New()
 
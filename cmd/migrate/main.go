@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/joho/godotenv"
+	"github.com/yonisaka/similarity/internal/di"
+	"github.com/yonisaka/similarity/pkg/migrate"
+)
+
+const usage = `usage: migrate [-seeds] <command>
+
+commands:
+  up        apply every pending migration
+  down [n]  roll back the last n migrations, 1 by default
+  version   print the current version`
+
+func main() {
+	seeds := flag.Bool("seeds", false, "run the seed migrations instead of the schema ones")
+	flag.Usage = func() {
+		fmt.Println(usage)
+	}
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	migrator := di.GetSchemaMigrator()
+	if *seeds {
+		migrator = di.GetSeedMigrator()
+	}
+
+	ctx := context.Background()
+
+	switch flag.Arg(0) {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		report("applied", applied)
+		if err != nil {
+			log.Fatalf("failed to migrate up: %v", err)
+		}
+	case "down":
+		steps := 1
+		if flag.NArg() > 1 {
+			steps, err = strconv.Atoi(flag.Arg(1))
+			if err != nil || steps < 1 {
+				log.Fatalf("invalid number of steps %q", flag.Arg(1))
+			}
+		}
+
+		rolledBack, err := migrator.Down(ctx, steps)
+		report("rolled back", rolledBack)
+		if err != nil {
+			log.Fatalf("failed to migrate down: %v", err)
+		}
+	case "version":
+		version, err := migrator.Version(ctx)
+		if err != nil {
+			log.Fatalf("failed to get version: %v", err)
+		}
+
+		fmt.Println(version)
+	default:
+		flag.Usage()
+	}
+}
+
+func report(action string, migrations []migrate.Migration) {
+	for _, m := range migrations {
+		log.Printf("%s %03d_%s", action, m.Version, m.Name)
+	}
+}
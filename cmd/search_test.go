@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/yonisaka/similarity/internal/di"
+	"github.com/yonisaka/similarity/internal/types"
 	"log"
 	"testing"
 )
@@ -48,17 +49,25 @@ func TestSearch(t *testing.T) {
 
 	for question, expectedAnswerContains := range qna7 {
 		ctx := context.Background()
-		result, err := searchUsecase.Search(ctx, question)
+		result, err := searchUsecase.Search(ctx, types.SearchRequest{
+			Query: question,
+			Scope: "sample_lelang.csv",
+		})
 		if err != nil {
 			log.Println(err)
 		}
 
+		var answer string
+		if result != nil {
+			answer = result.Answer
+		}
+
 		log.Println(
 			fmt.Sprintf(
-				"\nquestion: %s \n answer: %s \n expected: %s \n", question, result, expectedAnswerContains,
+				"\nquestion: %s \n answer: %s \n expected: %s \n", question, answer, expectedAnswerContains,
 			),
 		)
 
-		assert.Contains(t, result, expectedAnswerContains)
+		assert.Contains(t, answer, expectedAnswerContains)
 	}
 }
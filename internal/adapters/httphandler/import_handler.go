@@ -1,8 +1,11 @@
 package httphandler
 
 import (
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/log"
+	"github.com/yonisaka/similarity/internal/entities/repository"
 	"github.com/yonisaka/similarity/internal/types"
 	"github.com/yonisaka/similarity/internal/usecases"
 )
@@ -19,22 +22,78 @@ func NewImportHandler(importUsecase usecases.ImportUsecase) ImportHandler {
 
 type ImportHandler interface {
 	Import(c *fiber.Ctx) error
+	GetImportJob(c *fiber.Ctx) error
+	CancelImportJob(c *fiber.Ctx) error
 }
 
+// Import starts a background import job and returns it right away. The scope
+// form value defaults to the file name.
 func (h *importHandler) Import(c *fiber.Ctx) error {
 	fileHeader, err := c.FormFile("file")
 	if err != nil {
 		return c.JSON(fiber.ErrBadRequest)
 	}
 
-	err = h.importUsecase.Import(c.Context(), fileHeader, "")
+	job, err := h.importUsecase.StartImport(c.Context(), fileHeader, c.FormValue("scope"))
+	if err != nil {
+		if errors.Is(err, usecases.ErrInvalidScope) {
+			return c.JSON(fiber.ErrBadRequest)
+		}
+		log.Warn(err)
+		return c.JSON(fiber.ErrInternalServerError)
+	}
+
+	return c.JSON(types.Http{
+		Code:    fiber.StatusAccepted,
+		Message: "Import started",
+		Data:    job,
+	})
+}
+
+// GetImportJob returns the progress of an import job.
+func (h *importHandler) GetImportJob(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.JSON(fiber.ErrBadRequest)
+	}
+
+	job, err := h.importUsecase.GetImportJob(c.Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return c.JSON(fiber.ErrNotFound)
+		}
+		log.Warn(err)
+		return c.JSON(fiber.ErrInternalServerError)
+	}
+
+	return c.JSON(types.Http{
+		Code:    fiber.StatusOK,
+		Message: "Success",
+		Data:    job,
+	})
+}
+
+// CancelImportJob cancels a running import job.
+func (h *importHandler) CancelImportJob(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.JSON(fiber.ErrBadRequest)
+	}
+
+	err = h.importUsecase.CancelImportJob(c.Context(), uint(id))
 	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return c.JSON(fiber.ErrNotFound)
+		}
+		if errors.Is(err, usecases.ErrImportJobFinished) {
+			return c.JSON(fiber.ErrConflict)
+		}
 		log.Warn(err)
 		return c.JSON(fiber.ErrInternalServerError)
 	}
 
 	return c.JSON(types.Http{
-		Code:    fiber.StatusCreated,
-		Message: "Success importing",
+		Code:    fiber.StatusOK,
+		Message: "Import canceled",
 	})
 }
@@ -0,0 +1,80 @@
+package httphandler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/internal/usecases"
+)
+
+type scopeHandler struct {
+	scopeUsecase usecases.ScopeUsecase
+}
+
+func NewScopeHandler(scopeUsecase usecases.ScopeUsecase) ScopeHandler {
+	return &scopeHandler{
+		scopeUsecase: scopeUsecase,
+	}
+}
+
+type ScopeHandler interface {
+	ListScopes(c *fiber.Ctx) error
+	CreateScope(c *fiber.Ctx) error
+	DeleteScope(c *fiber.Ctx) error
+}
+
+// ListScopes returns every scope with its number of embeddings.
+func (h *scopeHandler) ListScopes(c *fiber.Ctx) error {
+	scopes, err := h.scopeUsecase.ListScopes(c.Context())
+	if err != nil {
+		log.Warn(err)
+		return c.JSON(fiber.ErrInternalServerError)
+	}
+
+	return c.JSON(types.Http{
+		Code:    fiber.StatusOK,
+		Message: "Success",
+		Data:    scopes,
+	})
+}
+
+// CreateScope registers an empty scope from the name form value.
+func (h *scopeHandler) CreateScope(c *fiber.Ctx) error {
+	scope, err := h.scopeUsecase.CreateScope(c.Context(), c.FormValue("name"))
+	if err != nil {
+		if errors.Is(err, usecases.ErrInvalidScope) {
+			return c.JSON(fiber.ErrBadRequest)
+		}
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return c.JSON(fiber.ErrConflict)
+		}
+		log.Warn(err)
+		return c.JSON(fiber.ErrInternalServerError)
+	}
+
+	return c.JSON(types.Http{
+		Code:    fiber.StatusCreated,
+		Message: "Scope created",
+		Data:    scope,
+	})
+}
+
+// DeleteScope removes a scope with its embeddings, collection and index.
+func (h *scopeHandler) DeleteScope(c *fiber.Ctx) error {
+	err := h.scopeUsecase.DeleteScope(c.Context(), c.Params("name"))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return c.JSON(fiber.ErrNotFound)
+		}
+		log.Warn(err)
+		return c.JSON(fiber.ErrInternalServerError)
+	}
+
+	return c.JSON(types.Http{
+		Code:    fiber.StatusOK,
+		Message: "Scope deleted",
+	})
+}
@@ -1,8 +1,18 @@
 package httphandler
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/log"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/prompt"
 	"github.com/yonisaka/similarity/internal/types"
 	"github.com/yonisaka/similarity/internal/usecases"
 )
@@ -19,19 +29,18 @@ func NewSearchHandler(searchUsecase usecases.SearchUsecase) SearchHandler {
 
 type SearchHandler interface {
 	Search(c *fiber.Ctx) error
+	StreamSearch(c *fiber.Ctx) error
 }
 
 func (h *searchHandler) Search(c *fiber.Ctx) error {
-	prompt := c.FormValue("prompt")
-	answer, err := h.searchUsecase.Search(c.Context(), prompt)
+	request, err := searchRequest(c)
 	if err != nil {
-		log.Warn(err)
-		return c.JSON(fiber.ErrInternalServerError)
+		return c.JSON(fiber.ErrBadRequest)
 	}
 
-	result := types.SearchResponse{
-		Question: prompt,
-		Answer:   answer,
+	result, err := h.searchUsecase.Search(c.Context(), request)
+	if err != nil {
+		return searchError(c, err)
 	}
 
 	return c.JSON(
@@ -42,3 +51,93 @@ func (h *searchHandler) Search(c *fiber.Ctx) error {
 		},
 	)
 }
+
+// StreamSearch answers like Search over Server-Sent Events: a sources event,
+// then a delta event for every piece of the answer, or a single extraction
+// event in extract mode, then a done event with the usage and latency, or an
+// error event when the answer fails midway.
+func (h *searchHandler) StreamSearch(c *fiber.Ctx) error {
+	request, err := searchRequest(c)
+	if err != nil {
+		return c.JSON(fiber.ErrBadRequest)
+	}
+
+	stream, err := h.searchUsecase.StreamSearch(c.Context(), request)
+	if err != nil {
+		return searchError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	// the writer runs once the handler returned, the request context is gone
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := stream.Send(ctx, func(event types.SearchEvent) error {
+			return writeEvent(w, event)
+		})
+		if err != nil {
+			log.Warn(err)
+			_ = writeEvent(w, types.SearchEvent{
+				Event: types.SearchEventError,
+				Data:  types.SearchError{Message: err.Error()},
+			})
+		}
+	})
+
+	return nil
+}
+
+// searchRequest reads the search form values. They are copied, so the
+// request outlives the fiber context.
+func searchRequest(c *fiber.Ctx) (types.SearchRequest, error) {
+	retrievalOnly := false
+	if value := c.FormValue("retrieval_only"); value != "" {
+		var err error
+		retrievalOnly, err = strconv.ParseBool(value)
+		if err != nil {
+			return types.SearchRequest{}, err
+		}
+	}
+
+	return types.SearchRequest{
+		Query:         utils.CopyString(c.FormValue("prompt")),
+		Scope:         utils.CopyString(c.FormValue("scope")),
+		Method:        utils.CopyString(c.FormValue("method")),
+		RetrievalOnly: retrievalOnly,
+		SessionID:     utils.CopyString(c.FormValue("session_id")),
+		Template:      utils.CopyString(c.FormValue("template")),
+		Mode:          utils.CopyString(c.FormValue("mode")),
+	}, nil
+}
+
+func searchError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, usecases.ErrUnknownMethod) || errors.Is(err, usecases.ErrInvalidSession) ||
+		errors.Is(err, usecases.ErrUnknownMode) || errors.Is(err, prompt.ErrInvalidName) {
+		return c.JSON(fiber.ErrBadRequest)
+	}
+	if errors.Is(err, repository.ErrNotFound) || errors.Is(err, prompt.ErrNotFound) {
+		return c.JSON(fiber.ErrNotFound)
+	}
+	log.Warn(err)
+	return c.JSON(fiber.ErrInternalServerError)
+}
+
+// writeEvent writes and flushes one Server-Sent Event. A flush error means
+// the client went away.
+func writeEvent(w *bufio.Writer, event types.SearchEvent) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Event, data); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
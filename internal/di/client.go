@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"github.com/sashabaranov/go-openai"
 	"github.com/yonisaka/similarity/pkg/elasticsearch"
+	"github.com/yonisaka/similarity/pkg/embedder"
 	"github.com/yonisaka/similarity/pkg/qdrant"
 	"os"
 	"strconv"
+	"strings"
 )
 
 // GetOpenAIClient returns OpenAI client instance.
@@ -52,6 +54,75 @@ func GetQdrantClient() qdrant.QdrantClient {
 	)
 }
 
+// GetESClient returns Elasticsearch client instance.
 func GetESClient() elasticsearch.ESClient {
-	return *elasticsearch.NewElasticsearch()
+	return *elasticsearch.NewElasticsearch(GetESIndexConfig())
+}
+
+// GetEmbeddingDimensions returns the length of the embedding vectors:
+// EMBEDDING_DIMENSIONS, else the one of the configured embedder, else
+// QDRANT_SIZE.
+func GetEmbeddingDimensions() int {
+	dims := getEnvInt("EMBEDDING_DIMENSIONS")
+	if dims == 0 {
+		dims = GetEmbedder().Dimensions()
+	}
+	if dims == 0 {
+		dims = getEnvInt("QDRANT_SIZE")
+	}
+	if dims == 0 {
+		panic("unknown embedding dimensions, set EMBEDDING_DIMENSIONS")
+	}
+
+	return dims
+}
+
+// GetESIndexConfig returns the index mapping configuration. The vector dims
+// follow GetEmbeddingDimensions.
+func GetESIndexConfig() elasticsearch.IndexConfig {
+	dims := GetEmbeddingDimensions()
+
+	var searchFields []string
+	if fields := os.Getenv("ELASTICSEARCH_SEARCH_FIELDS"); fields != "" {
+		for _, field := range strings.Split(fields, ",") {
+			searchFields = append(searchFields, strings.TrimSpace(field))
+		}
+	}
+
+	return elasticsearch.IndexConfig{
+		Dims:          dims,
+		Shards:        getEnvInt("ELASTICSEARCH_SHARDS"),
+		Replicas:      getEnvInt("ELASTICSEARCH_REPLICAS"),
+		TextAnalyzer:  os.Getenv("ELASTICSEARCH_TEXT_ANALYZER"),
+		PrefixMinGram: getEnvInt("ELASTICSEARCH_PREFIX_MIN_GRAM"),
+		PrefixMaxGram: getEnvInt("ELASTICSEARCH_PREFIX_MAX_GRAM"),
+		SearchFields:  searchFields,
+	}
+}
+
+// GetEmbedder returns the Embedder selected by EMBEDDING_PROVIDER.
+// Import and search share it, so stored and query vectors always match.
+func GetEmbedder() embedder.Embedder {
+	switch os.Getenv("EMBEDDING_PROVIDER") {
+	case "", "openai":
+		return embedder.NewOpenAIEmbedder(
+			os.Getenv("OPENAI_API_KEY"),
+			os.Getenv("OPENAI_EMBEDDING_MODEL"),
+		)
+	case "compatible":
+		return embedder.NewCompatibleEmbedder(
+			os.Getenv("EMBEDDING_BASE_URL"),
+			os.Getenv("EMBEDDING_API_KEY"),
+			os.Getenv("OPENAI_EMBEDDING_MODEL"),
+		)
+	case "hash":
+		size, err := strconv.Atoi(os.Getenv("QDRANT_SIZE"))
+		if err != nil {
+			panic(err)
+		}
+
+		return embedder.NewHashEmbedder(size)
+	default:
+		panic(fmt.Sprintf("unknown embedding provider: %s", os.Getenv("EMBEDDING_PROVIDER")))
+	}
 }
@@ -0,0 +1,95 @@
+package di
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// getEnvInt returns the integer value of the environment variable, or zero when unset.
+func getEnvInt(key string) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0
+	}
+
+	ret, err := strconv.Atoi(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+// getEnvBool returns the boolean value of the environment variable, or false when unset.
+func getEnvBool(key string) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return false
+	}
+
+	ret, err := strconv.ParseBool(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+// getEnvDuration returns the duration value of the environment variable, e.g. "2s", or zero when unset.
+func getEnvDuration(key string) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0
+	}
+
+	ret, err := time.ParseDuration(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+// getEnvFloat returns the float value of the environment variable, or zero when unset.
+func getEnvFloat(key string) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0
+	}
+
+	ret, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+// getEnvWeights returns the "name=weight" pairs of the comma separated
+// environment variable, or nil when unset.
+func getEnvWeights(key string) map[string]float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	ret := make(map[string]float64)
+	for _, pair := range strings.Split(value, ",") {
+		name, weight, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			panic(fmt.Sprintf("%s: expected name=weight, got %q", key, pair))
+		}
+
+		w, err := strconv.ParseFloat(weight, 64)
+		if err != nil {
+			panic(err)
+		}
+
+		ret[strings.TrimSpace(name)] = w
+	}
+
+	return ret
+}
@@ -15,3 +15,10 @@ func GetSearchHandler() httphandler.SearchHandler {
 		GetSearchUsecase(),
 	)
 }
+
+// GetScopeHandler is a function to get http scope handler
+func GetScopeHandler() httphandler.ScopeHandler {
+	return httphandler.NewScopeHandler(
+		GetScopeUsecase(),
+	)
+}
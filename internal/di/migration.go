@@ -0,0 +1,20 @@
+package di
+
+import (
+	"github.com/yonisaka/similarity/internal/infrastructure/datastore"
+	"github.com/yonisaka/similarity/migrations"
+	"github.com/yonisaka/similarity/pkg/migrate"
+)
+
+// GetSchemaMigrator returns the migrator of the database schema. Its table
+// is not schema_migrations, the one of golang-migrate, which older setups
+// ran against the same database.
+func GetSchemaMigrator() *migrate.Migrator {
+	return migrate.New(datastore.GetDatabaseMaster(), migrations.Schema(), "similarity_schema_migrations")
+}
+
+// GetSeedMigrator returns the migrator of the seed data, tracked apart from
+// the schema so production databases can skip it.
+func GetSeedMigrator() *migrate.Migrator {
+	return migrate.New(datastore.GetDatabaseMaster(), migrations.Seeds(), "similarity_seed_migrations")
+}
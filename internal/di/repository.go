@@ -1,8 +1,26 @@
 package di
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
 	"github.com/yonisaka/similarity/internal/entities/repository"
 	"github.com/yonisaka/similarity/internal/infrastructure/datastore"
+	"github.com/yonisaka/similarity/internal/infrastructure/memory"
+)
+
+const (
+	defaultSessionTTL = 30 * time.Minute
+	// maxIndexedDimensions is the most dimensions of a pgvector HNSW index.
+	maxIndexedDimensions = 2000
+)
+
+var (
+	memorySessionRepoOnce sync.Once
+	memorySessionRepo     repository.SessionRepo
 )
 
 // GetBaseRepo returns BaseRepo instance.
@@ -14,3 +32,51 @@ func GetBaseRepo() *datastore.BaseRepo {
 func GetEmbeddingRepo() repository.EmbeddingRepo {
 	return datastore.NewEmbeddingRepo(GetBaseRepo())
 }
+
+// CheckVectorDimensions returns an error when the embeddings stored in
+// Postgres cannot hold the vectors of the embedder: over the dimensions of an
+// HNSW index, or of another length than the vector column.
+func CheckVectorDimensions(ctx context.Context) error {
+	dims := GetEmbeddingDimensions()
+	if dims > maxIndexedDimensions {
+		return fmt.Errorf("embeddings of %d dimensions are over the %d of a pgvector HNSW index, use a model of at most %d dimensions", dims, maxIndexedDimensions, maxIndexedDimensions)
+	}
+
+	column, err := GetEmbeddingRepo().VectorDimensions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if column != 0 && column != dims {
+		return fmt.Errorf("the embeddings column is vector(%d) but the embedder returns %d dimensions, see Vector dimensions in the README", column, dims)
+	}
+
+	return nil
+}
+
+// GetImportJobRepo returns ImportJobRepo instance.
+func GetImportJobRepo() repository.ImportJobRepo {
+	return datastore.NewImportJobRepo(GetBaseRepo())
+}
+
+// GetSessionRepo returns the SessionRepo of SESSION_STORE, "postgres" by
+// default or "memory". Sessions expire after SESSION_TTL, 30m by default.
+func GetSessionRepo() repository.SessionRepo {
+	ttl := getEnvDuration("SESSION_TTL")
+	if ttl == 0 {
+		ttl = defaultSessionTTL
+	}
+
+	switch store := os.Getenv("SESSION_STORE"); store {
+	case "", "postgres":
+		return datastore.NewSessionRepo(GetBaseRepo(), ttl)
+	case "memory":
+		// the sessions live in the repo, every use case must share it
+		memorySessionRepoOnce.Do(func() {
+			memorySessionRepo = memory.NewSessionRepo(ttl)
+		})
+		return memorySessionRepo
+	default:
+		panic(fmt.Sprintf("unknown SESSION_STORE %q", store))
+	}
+}
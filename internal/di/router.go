@@ -9,7 +9,15 @@ func GetRouter(app *fiber.App) {
 
 	importHandler := GetImportHandler()
 	v1.Post("/import", importHandler.Import)
+	v1.Get("/import/:id", importHandler.GetImportJob)
+	v1.Delete("/import/:id", importHandler.CancelImportJob)
+
+	scopeHandler := GetScopeHandler()
+	v1.Get("/scopes", scopeHandler.ListScopes)
+	v1.Post("/scopes", scopeHandler.CreateScope)
+	v1.Delete("/scopes/:name", scopeHandler.DeleteScope)
 
 	searchHandler := GetSearchHandler()
 	v1.Post("/search", searchHandler.Search)
+	v1.Post("/search/stream", searchHandler.StreamSearch)
 }
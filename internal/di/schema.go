@@ -0,0 +1,28 @@
+package di
+
+import (
+	"os"
+
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/schema"
+)
+
+// GetSchemaLoader returns the loader of the per scope import schemas.
+func GetSchemaLoader() *schema.Loader {
+	dir := os.Getenv("IMPORT_SCHEMA_DIR")
+	if dir == "" {
+		dir = "../schemas"
+	}
+
+	return schema.NewLoader(dir)
+}
+
+// GetPromptLoader returns the loader of the answer prompt templates.
+func GetPromptLoader() *prompt.Loader {
+	dir := os.Getenv("PROMPT_DIR")
+	if dir == "" {
+		dir = "../prompts"
+	}
+
+	return prompt.NewLoader(dir)
+}
@@ -1,24 +1,154 @@
 package di
 
-import "github.com/yonisaka/similarity/internal/usecases"
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/yonisaka/similarity/internal/usecases"
+	"github.com/yonisaka/similarity/pkg/tokenizer"
+)
 
 // GetSearchUsecase returns SearchUsecase instance.
 func GetSearchUsecase() usecases.SearchUsecase {
+	retrievers := GetRetrievers()
+
 	return usecases.NewSearchUsecase(
 		GetOpenAIClient(),
-		GetQdrantClient(),
+		GetEmbedder(),
 		GetEmbeddingRepo(),
-		GetESClient(),
+		GetSessionRepo(),
+		retrievers,
+		GetSearchMethod(retrievers),
+		GetSchemaLoader(),
+		GetPromptLoader(),
+		GetTokenizer(),
 		GetLogger(),
 	)
 }
 
+// GetTokenizer returns the encoding of TOKENIZER_ENCODING, or of the
+// OPENAI_GPT_MODEL when unset. It panics when the vocabulary of the encoding
+// is not embedded, see pkg/tokenizer/vocab. Only a model of no known encoding
+// falls back to counting words.
+func GetTokenizer() *tokenizer.Encoding {
+	if name := os.Getenv("TOKENIZER_ENCODING"); name != "" {
+		encoding, err := tokenizer.Get(name)
+		if err != nil {
+			panic(err)
+		}
+
+		return encoding
+	}
+
+	encoding, err := tokenizer.ForModel(os.Getenv("OPENAI_GPT_MODEL"))
+	if errors.Is(err, tokenizer.ErrMissingVocabulary) {
+		panic(err)
+	}
+	if err != nil {
+		GetLogger().Warn(fmt.Sprintf("counting tokens by words: %s", err))
+		return nil
+	}
+
+	return encoding
+}
+
+// GetRetrievers returns the retriever of every search method.
+func GetRetrievers() usecases.Retrievers {
+	return usecases.Retrievers{
+		usecases.MethodPostgresql: usecases.NewPostgresRetriever(
+			GetEmbedder(),
+			GetEmbeddingRepo(),
+			GetFusionConfig(),
+			GetLogger(),
+		),
+		usecases.MethodQdrant: usecases.NewQdrantRetriever(
+			GetEmbedder(),
+			GetQdrantClient(),
+			GetFusionConfig(),
+			GetLogger(),
+			getEnvBool("QDRANT_SCROLL"),
+		),
+		usecases.MethodElastic: usecases.NewElasticRetriever(
+			GetEmbedder(),
+			GetESClient(),
+			GetFusionConfig(),
+			GetLogger(),
+		),
+	}
+}
+
+// GetSearchMethod returns the search method used when a request names none,
+// from SIMILARITY_METHOD and postgresql when unset. It panics when none of the
+// retrievers serves it.
+func GetSearchMethod(retrievers usecases.Retrievers) string {
+	method := os.Getenv("SIMILARITY_METHOD")
+	if method == "" {
+		return usecases.MethodPostgresql
+	}
+
+	if _, err := retrievers.Get(method); err != nil {
+		panic(err)
+	}
+
+	return method
+}
+
+// GetFusionConfig returns how the search merges the ranked lists of a
+// backend. FUSION_WEIGHTS is a list like "vector=0.7,keyword=0.3".
+func GetFusionConfig() usecases.FusionConfig {
+	config := usecases.FusionConfig{
+		Method:     os.Getenv("FUSION_METHOD"),
+		RRFK:       getEnvFloat("FUSION_RRF_K"),
+		Weights:    getEnvWeights("FUSION_WEIGHTS"),
+		Candidates: getEnvInt("FUSION_CANDIDATES"),
+		TopN:       getEnvInt("FUSION_TOP_N"),
+	}
+
+	if err := config.Validate(); err != nil {
+		panic(err)
+	}
+
+	return config
+}
+
+// GetImportConfig returns the import configuration from the environment.
+// Unset values fall back to the use case defaults.
+func GetImportConfig() usecases.ImportConfig {
+	return usecases.ImportConfig{
+		BatchSize:         getEnvInt("IMPORT_BATCH_SIZE"),
+		BatchTokens:       getEnvInt("IMPORT_BATCH_TOKENS"),
+		RequestsPerMinute: getEnvInt("IMPORT_REQUESTS_PER_MINUTE"),
+		TokensPerMinute:   getEnvInt("IMPORT_TOKENS_PER_MINUTE"),
+		MaxRetries:        getEnvInt("IMPORT_MAX_RETRIES"),
+		RetryBackoff:      getEnvDuration("IMPORT_RETRY_BACKOFF"),
+		SyncBatchSize:     getEnvInt("QDRANT_SYNC_BATCH_SIZE"),
+		ReindexRetention:  getEnvDuration("QDRANT_REINDEX_RETENTION"),
+		BulkSize:          getEnvInt("ELASTICSEARCH_BULK_SIZE"),
+		BulkBytes:         getEnvInt("ELASTICSEARCH_BULK_BYTES"),
+	}
+}
+
 // GetImportUsecase returns ImportUsecase instance.
 func GetImportUsecase() usecases.ImportUsecase {
 	return usecases.NewImportUsecase(
 		GetOpenAIClient(),
+		GetEmbedder(),
 		GetQdrantClient(),
 		GetEmbeddingRepo(),
+		GetImportJobRepo(),
+		GetESClient(),
+		GetSchemaLoader(),
+		GetLogger(),
+		GetImportConfig(),
+	)
+}
+
+// GetScopeUsecase returns ScopeUsecase instance.
+func GetScopeUsecase() usecases.ScopeUsecase {
+	return usecases.NewScopeUsecase(
+		GetEmbeddingRepo(),
+		GetQdrantClient(),
 		GetESClient(),
 		GetLogger(),
 	)
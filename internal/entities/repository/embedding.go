@@ -2,9 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrNotFound is an error for indicates record not found.
+var ErrNotFound = errors.New("error not found")
+
 // Embedding is an embedding entity.
 type Embedding struct {
 	ID        uint       `json:"id"`
@@ -15,8 +19,21 @@ type Embedding struct {
 	CreatedAt *time.Time `json:"created_at"`
 }
 
+// NearestEmbedding is an embedding with its cosine similarity to a query vector.
+type NearestEmbedding struct {
+	Embedding
+	Relatedness float64 `json:"relatedness"`
+}
+
 type EmbeddingRepo interface {
 	ListEmbeddingByScope(ctx context.Context, scope string) ([]Embedding, error)
+	NearestByScope(ctx context.Context, scope string, vector []float64, k int) ([]NearestEmbedding, error)
+	FindByField(ctx context.Context, scope, column, value string, limit int) ([]Embedding, error)
+	VectorDimensions(ctx context.Context) (int, error)
 	CountEmbeddingByScope(ctx context.Context, scope string) (int, error)
 	CreateEmbedding(ctx context.Context, embedding *Embedding) error
+	ListScopes(ctx context.Context) ([]Scope, error)
+	GetScope(ctx context.Context, name string) (*Scope, error)
+	CreateScope(ctx context.Context, scope *Scope) error
+	DeleteScope(ctx context.Context, name string) error
 }
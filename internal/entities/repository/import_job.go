@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	ImportJobPending   = "pending"
+	ImportJobRunning   = "running"
+	ImportJobCompleted = "completed"
+	ImportJobFailed    = "failed"
+	ImportJobCanceled  = "canceled"
+)
+
+// ImportJob is an import job entity. RowsResumed are the rows an earlier
+// interrupted import of the scope already stored, RowsSkipped the lines left
+// out, see SkippedLines.
+type ImportJob struct {
+	ID           uint          `json:"id"`
+	Scope        string        `json:"scope"`
+	Status       string        `json:"status"`
+	RowsTotal    int           `json:"rows_total"`
+	RowsEmbedded int           `json:"rows_embedded"`
+	RowsResumed  int           `json:"rows_resumed"`
+	RowsSkipped  int           `json:"rows_skipped"`
+	RowsFailed   int           `json:"rows_failed"`
+	TokensUsed   int           `json:"tokens_used"`
+	SkippedLines []SkippedLine `json:"skipped_lines,omitempty"`
+	Error        string        `json:"error,omitempty"`
+	CreatedAt    *time.Time    `json:"created_at"`
+	UpdatedAt    *time.Time    `json:"updated_at"`
+	FinishedAt   *time.Time    `json:"finished_at"`
+}
+
+// SkippedLine is a source line left out of an import.
+type SkippedLine struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+// IsFinished reports whether the job reached a final status.
+func (j *ImportJob) IsFinished() bool {
+	return j.Status == ImportJobCompleted || j.Status == ImportJobFailed || j.Status == ImportJobCanceled
+}
+
+type ImportJobRepo interface {
+	CreateImportJob(ctx context.Context, job *ImportJob) error
+	GetImportJob(ctx context.Context, id uint) (*ImportJob, error)
+	UpdateImportJob(ctx context.Context, job *ImportJob) error
+}
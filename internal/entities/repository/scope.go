@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrAlreadyExists is an error for indicates record already exists.
+var ErrAlreadyExists = errors.New("error already exists")
+
+// Scope is a dataset of embeddings, searched and synced on its own.
+type Scope struct {
+	Name       string     `json:"name"`
+	Embeddings int        `json:"embeddings"`
+	CreatedAt  *time.Time `json:"created_at"`
+}
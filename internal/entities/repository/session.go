@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	TurnUser      = "user"
+	TurnAssistant = "assistant"
+)
+
+// Turn is a message of a conversation session.
+type Turn struct {
+	Role      string    `json:"role"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SessionRepo stores the turns of conversation sessions. A session expires
+// once it was not written for its TTL.
+type SessionRepo interface {
+	// ListTurns returns the last limit turns of the session, oldest first, and
+	// none when the session is unknown or expired.
+	ListTurns(ctx context.Context, id string, limit int) ([]Turn, error)
+	// AppendTurns adds the turns to the session, starting a new one when it is
+	// unknown or expired.
+	AppendTurns(ctx context.Context, id string, turns ...Turn) error
+}
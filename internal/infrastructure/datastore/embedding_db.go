@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/jackc/pgx/v5"
 	"github.com/yonisaka/similarity/internal/entities/repository"
 	"strconv"
 	"strings"
@@ -11,7 +12,12 @@ import (
 
 var (
 	// ErrNotFound is an error for indicates record not found.
-	ErrNotFound = errors.New("error not found")
+	ErrNotFound = repository.ErrNotFound
+)
+
+const (
+	defaultEfSearch = 40
+	maxEfSearch     = 1000
 )
 
 type embeddingRepo struct {
@@ -26,7 +32,7 @@ func NewEmbeddingRepo(base *BaseRepo) repository.EmbeddingRepo {
 }
 
 func (r *embeddingRepo) ListEmbeddingByScope(ctx context.Context, scope string) ([]repository.Embedding, error) {
-	query := `SELECT id, combined, translate(embeddings, '[]', '{}')::float[], n_tokens, created_at
+	query := `SELECT id, combined, embeddings::real[]::float[], n_tokens, created_at
 				FROM embeddings
 					WHERE scope = $1 `
 
@@ -53,6 +59,134 @@ func (r *embeddingRepo) ListEmbeddingByScope(ctx context.Context, scope string)
 	return embeddings, nil
 }
 
+// NearestByScope returns the k embeddings of the scope closest to the vector
+// by cosine distance, ranked by the vector index. The vectors themselves are
+// not loaded.
+//
+// The HNSW index is shared by every scope and the scope is filtered after
+// the index scan, so the scan of the transaction is iterative: it goes on
+// until k rows of the scope are found. It requires pgvector 0.8.
+func (r *embeddingRepo) NearestByScope(ctx context.Context, scope string, vector []float64, k int) ([]repository.NearestEmbedding, error) {
+	tx, err := r.dbSlave.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	settings := `SELECT set_config('hnsw.ef_search', $1, true), set_config('hnsw.iterative_scan', 'strict_order', true)`
+	if _, err := tx.Exec(ctx, settings, strconv.Itoa(efSearch(k))); err != nil {
+		return nil, err
+	}
+
+	query := `SELECT id, scope, combined, n_tokens, created_at, 1 - (embeddings <=> $2::vector)
+				FROM embeddings
+					WHERE scope = $1
+				ORDER BY embeddings <=> $2::vector
+				LIMIT $3`
+
+	rows, err := tx.Query(ctx, query, scope, floatArrayToString(vector, ","), k)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var embeddings []repository.NearestEmbedding
+
+	for rows.Next() {
+		var embedding repository.NearestEmbedding
+		if err := rows.Scan(
+			&embedding.ID,
+			&embedding.Scope,
+			&embedding.Combined,
+			&embedding.NTokens,
+			&embedding.CreatedAt,
+			&embedding.Relatedness,
+		); err != nil {
+			return nil, err
+		}
+		embeddings = append(embeddings, embedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return embeddings, nil
+}
+
+// FindByField returns up to limit embeddings of the scope whose column holds
+// exactly the value, ignoring case. It matches the "column: value" segment of
+// the combined text, the vectors are not loaded.
+func (r *embeddingRepo) FindByField(ctx context.Context, scope, column, value string, limit int) ([]repository.Embedding, error) {
+	query := `SELECT id, scope, combined, n_tokens, created_at
+				FROM embeddings
+					WHERE scope = $1
+					AND '; ' || combined || ';' ILIKE $2
+				ORDER BY id
+				LIMIT $3`
+
+	segment := "%; " + likeEscaper.Replace(column+": "+value) + ";%"
+
+	rows, err := r.dbSlave.Query(ctx, query, scope, segment, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var embeddings []repository.Embedding
+
+	for rows.Next() {
+		var embedding repository.Embedding
+		if err := rows.Scan(
+			&embedding.ID,
+			&embedding.Scope,
+			&embedding.Combined,
+			&embedding.NTokens,
+			&embedding.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		embeddings = append(embeddings, embedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return embeddings, nil
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern, backslash being the
+// default escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// efSearch returns the candidate list size of an HNSW scan for k rows, at
+// least the pgvector default and at most its limit.
+func efSearch(k int) int {
+	return min(max(k, defaultEfSearch), maxEfSearch)
+}
+
+// VectorDimensions returns the dimensions of the vector column, 0 before it
+// is a vector.
+func (r *embeddingRepo) VectorDimensions(ctx context.Context) (int, error) {
+	query := `SELECT atttypmod
+				FROM pg_attribute
+					WHERE attrelid = 'embeddings'::regclass
+					AND attname = 'embeddings'
+					AND atttypid = 'vector'::regtype`
+
+	var dims int
+	err := r.dbSlave.QueryRow(ctx, query).Scan(&dims)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return dims, nil
+}
+
 func (r *embeddingRepo) CountEmbeddingByScope(ctx context.Context, scope string) (int, error) {
 	query := `SELECT COUNT(*)
 				FROM embeddings
@@ -68,9 +202,9 @@ func (r *embeddingRepo) CountEmbeddingByScope(ctx context.Context, scope string)
 
 func (r *embeddingRepo) CreateEmbedding(ctx context.Context, embedding *repository.Embedding) error {
 	query := `INSERT INTO embeddings(scope, combined, embeddings, n_tokens, created_at)
-				VALUES($1, $2, $3, $4, NOW())`
+				VALUES($1, $2, $3::vector, $4, NOW())`
 
-	if _, err := r.dbMaster.Exec(ctx, query, embedding.Scope, embedding.Combined, floatArrayToString(embedding.Embedding, ", "), embedding.NTokens); err != nil {
+	if _, err := r.dbMaster.Exec(ctx, query, embedding.Scope, embedding.Combined, floatArrayToString(embedding.Embedding, ","), embedding.NTokens); err != nil {
 		return err
 	}
 
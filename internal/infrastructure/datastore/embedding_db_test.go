@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/stretchr/testify/assert"
 	"github.com/yonisaka/similarity/internal/di"
+	"github.com/yonisaka/similarity/internal/infrastructure/datastore"
+	"log"
 	"os"
 	"testing"
 )
@@ -18,6 +20,15 @@ func TestMain(m *testing.M) {
 	_ = os.Setenv("APP_ENV", "test")
 	_ = os.Setenv("IS_REPLICA", "false")
 
+	// the tests read the lelang seed
+	ctx := context.Background()
+	if _, err := di.GetSchemaMigrator().Up(ctx); err != nil {
+		log.Fatalf("failed to migrate schema: %v", err)
+	}
+	if _, err := di.GetSeedMigrator().Up(ctx); err != nil {
+		log.Fatalf("failed to migrate seeds: %v", err)
+	}
+
 	code = m.Run()
 }
 
@@ -75,3 +86,150 @@ func TestEmbeddingRepo_ListEmbeddingByScope(t *testing.T) {
 		})
 	}
 }
+
+func TestEmbeddingRepo_NearestByScope(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		scope  string
+		vector []float64
+		k      int
+	}
+
+	type test struct {
+		args    args
+		want    int
+		wantErr error
+	}
+
+	vector := make([]float64, 1536)
+	for i := range vector {
+		vector[i] = 0.01
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Nearest Embedding, When query executed successfully, Return k records": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx:    context.Background(),
+					scope:  "lelang",
+					vector: vector,
+					k:      3,
+				},
+				want:    3,
+				wantErr: nil,
+			}
+		},
+		"Given unknown scope, When query executed successfully, Return no records": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx:    context.Background(),
+					scope:  "unknown",
+					vector: vector,
+					k:      3,
+				},
+				want:    0,
+				wantErr: nil,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			sut := di.GetEmbeddingRepo()
+
+			got, err := sut.NearestByScope(tt.args.ctx, tt.args.scope, tt.args.vector, tt.args.k)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			assert.Equal(t, tt.want, len(got))
+			for i := 1; i < len(got); i++ {
+				assert.GreaterOrEqual(t, got[i-1].Relatedness, got[i].Relatedness)
+			}
+		})
+	}
+}
+
+func TestEmbeddingRepo_GetScope(t *testing.T) {
+	type test struct {
+		name           string
+		wantEmbeddings int
+		wantErr        error
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given seeded scope, When query executed successfully, Return the scope": func(t *testing.T) test {
+			return test{
+				name:           "lelang",
+				wantEmbeddings: 5,
+				wantErr:        nil,
+			}
+		},
+		"Given unknown scope, When query executed, Return not found": func(t *testing.T) test {
+			return test{
+				name:    "unknown",
+				wantErr: datastore.ErrNotFound,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			sut := di.GetEmbeddingRepo()
+
+			got, err := sut.GetScope(context.Background(), tt.name)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) || tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantEmbeddings, got.Embeddings)
+		})
+	}
+}
+
+func TestEmbeddingRepo_FindByField(t *testing.T) {
+	type test struct {
+		column string
+		value  string
+		want   int
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a seeded stock number, When query executed successfully, Return its record": func(t *testing.T) test {
+			return test{
+				column: "Stock No",
+				value:  "ba00001023j09",
+				want:   1,
+			}
+		},
+		"Given a prefix of a stock number, When query executed successfully, Return no records": func(t *testing.T) test {
+			return test{
+				column: "Stock No",
+				value:  "BA0000",
+				want:   0,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			sut := di.GetEmbeddingRepo()
+
+			got, err := sut.FindByField(context.Background(), "lelang", tt.column, tt.value, 5)
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, len(got))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+)
+
+type importJobRepo struct {
+	*BaseRepo
+}
+
+// NewImportJobRepo returns ImportJobRepo.
+func NewImportJobRepo(base *BaseRepo) repository.ImportJobRepo {
+	return &importJobRepo{
+		BaseRepo: base,
+	}
+}
+
+func (r *importJobRepo) CreateImportJob(ctx context.Context, job *repository.ImportJob) error {
+	query := `INSERT INTO import_jobs(scope, status, rows_total, rows_embedded, rows_resumed, rows_skipped, rows_failed,
+                        tokens_used, skipped_lines, error, created_at, updated_at)
+				VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())
+				RETURNING id, created_at, updated_at`
+
+	skippedLines, err := json.Marshal(job.SkippedLines)
+	if err != nil {
+		return err
+	}
+
+	return r.dbMaster.QueryRow(ctx, query,
+		job.Scope, job.Status, job.RowsTotal, job.RowsEmbedded, job.RowsResumed, job.RowsSkipped, job.RowsFailed,
+		job.TokensUsed, skippedLines, job.Error,
+	).Scan(&job.ID, &job.CreatedAt, &job.UpdatedAt)
+}
+
+func (r *importJobRepo) GetImportJob(ctx context.Context, id uint) (*repository.ImportJob, error) {
+	// read from master, progress is updated continuously and the replica may lag behind
+	query := `SELECT id, scope, status, rows_total, rows_embedded, COALESCE(rows_resumed, 0), rows_skipped, rows_failed,
+       			tokens_used, COALESCE(skipped_lines, 'null'), COALESCE(error, ''), created_at, updated_at, finished_at
+				FROM import_jobs
+					WHERE id = $1`
+
+	var job repository.ImportJob
+	var skippedLines []byte
+	if err := r.dbMaster.QueryRow(ctx, query, id).Scan(
+		&job.ID, &job.Scope, &job.Status, &job.RowsTotal, &job.RowsEmbedded, &job.RowsResumed, &job.RowsSkipped,
+		&job.RowsFailed, &job.TokensUsed, &skippedLines, &job.Error, &job.CreatedAt, &job.UpdatedAt, &job.FinishedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(skippedLines, &job.SkippedLines); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (r *importJobRepo) UpdateImportJob(ctx context.Context, job *repository.ImportJob) error {
+	query := `UPDATE import_jobs
+				SET status = $2, rows_total = $3, rows_embedded = $4, rows_resumed = $5, rows_skipped = $6,
+				    rows_failed = $7, tokens_used = $8, skipped_lines = $9, error = $10, updated_at = NOW(),
+				    finished_at = $11
+					WHERE id = $1`
+
+	skippedLines, err := json.Marshal(job.SkippedLines)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.dbMaster.Exec(ctx, query,
+		job.ID, job.Status, job.RowsTotal, job.RowsEmbedded, job.RowsResumed, job.RowsSkipped, job.RowsFailed,
+		job.TokensUsed, skippedLines, job.Error, job.FinishedAt,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+)
+
+const uniqueViolation = "23505"
+
+func (r *embeddingRepo) ListScopes(ctx context.Context) ([]repository.Scope, error) {
+	query := `SELECT s.name, COUNT(e.id), s.created_at
+				FROM scopes s
+					LEFT JOIN embeddings e ON e.scope = s.name
+				GROUP BY s.name, s.created_at
+				ORDER BY s.name`
+
+	rows, err := r.dbSlave.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scopes []repository.Scope
+
+	for rows.Next() {
+		var scope repository.Scope
+		if err := rows.Scan(&scope.Name, &scope.Embeddings, &scope.CreatedAt); err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return scopes, nil
+}
+
+func (r *embeddingRepo) GetScope(ctx context.Context, name string) (*repository.Scope, error) {
+	query := `SELECT s.name, (SELECT COUNT(*) FROM embeddings e WHERE e.scope = s.name), s.created_at
+				FROM scopes s
+					WHERE s.name = $1`
+
+	var scope repository.Scope
+	if err := r.dbSlave.QueryRow(ctx, query, name).Scan(&scope.Name, &scope.Embeddings, &scope.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &scope, nil
+}
+
+func (r *embeddingRepo) CreateScope(ctx context.Context, scope *repository.Scope) error {
+	query := `INSERT INTO scopes(name, created_at)
+				VALUES($1, NOW())
+				RETURNING created_at`
+
+	if err := r.dbMaster.QueryRow(ctx, query, scope.Name).Scan(&scope.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return repository.ErrAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// DeleteScope removes the scope and all its embeddings.
+func (r *embeddingRepo) DeleteScope(ctx context.Context, name string) error {
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if _, err := tx.Exec(ctx, `DELETE FROM embeddings WHERE scope = $1`, name); err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM scopes WHERE name = $1`, name)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,80 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/yonisaka/similarity/internal/entities/repository"
+)
+
+type sessionRepo struct {
+	*BaseRepo
+	ttl time.Duration
+}
+
+// NewSessionRepo returns a SessionRepo whose sessions expire after ttl.
+func NewSessionRepo(base *BaseRepo, ttl time.Duration) repository.SessionRepo {
+	return &sessionRepo{
+		BaseRepo: base,
+		ttl:      ttl,
+	}
+}
+
+func (r *sessionRepo) ListTurns(ctx context.Context, id string, limit int) ([]repository.Turn, error) {
+	// read from master, the previous turn was just written and the replica may lag behind
+	query := `SELECT role, content, created_at FROM (
+				SELECT t.id, t.role, t.content, t.created_at
+					FROM session_turns t
+					JOIN sessions s ON s.id = t.session_id
+						WHERE s.id = $1 AND s.updated_at > NOW() - make_interval(secs => $2)
+						ORDER BY t.id DESC
+						LIMIT $3
+				) recent ORDER BY id`
+
+	rows, err := r.dbMaster.Query(ctx, query, id, r.ttl.Seconds(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var turns []repository.Turn
+	for rows.Next() {
+		var turn repository.Turn
+		if err := rows.Scan(&turn.Role, &turn.Content, &turn.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		turns = append(turns, turn)
+	}
+
+	return turns, rows.Err()
+}
+
+func (r *sessionRepo) AppendTurns(ctx context.Context, id string, turns ...repository.Turn) error {
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	// drop the expired sessions, this one included, their turns cascade
+	if _, err := tx.Exec(ctx, `DELETE FROM sessions WHERE updated_at <= NOW() - make_interval(secs => $1)`,
+		r.ttl.Seconds()); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `INSERT INTO sessions(id, created_at, updated_at)
+				VALUES($1, NOW(), NOW())
+				ON CONFLICT (id) DO UPDATE SET updated_at = NOW()`, id); err != nil {
+		return err
+	}
+
+	for _, turn := range turns {
+		if _, err := tx.Exec(ctx, `INSERT INTO session_turns(session_id, role, content, created_at)
+				VALUES($1, $2, $3, NOW())`, id, turn.Role, turn.Content); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
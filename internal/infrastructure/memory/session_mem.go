@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/yonisaka/similarity/internal/entities/repository"
+)
+
+type session struct {
+	turns     []repository.Turn
+	updatedAt time.Time
+}
+
+type sessionRepo struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	now      func() time.Time
+	sessions map[string]*session
+}
+
+// NewSessionRepo returns a SessionRepo keeping the sessions in this process,
+// they are lost on restart. Sessions expire after ttl.
+func NewSessionRepo(ttl time.Duration) repository.SessionRepo {
+	return &sessionRepo{
+		ttl:      ttl,
+		now:      time.Now,
+		sessions: make(map[string]*session),
+	}
+}
+
+func (r *sessionRepo) ListTurns(ctx context.Context, id string, limit int) ([]repository.Turn, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.sessions[id]
+	if !ok || r.expired(s) {
+		return nil, nil
+	}
+
+	turns := s.turns
+	if len(turns) > limit {
+		turns = turns[len(turns)-limit:]
+	}
+
+	return append([]repository.Turn(nil), turns...), nil
+}
+
+func (r *sessionRepo) AppendTurns(ctx context.Context, id string, turns ...repository.Turn) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// drop the expired sessions, this one included
+	for key, s := range r.sessions {
+		if r.expired(s) {
+			delete(r.sessions, key)
+		}
+	}
+
+	s, ok := r.sessions[id]
+	if !ok {
+		s = &session{}
+		r.sessions[id] = s
+	}
+
+	now := r.now()
+	for _, turn := range turns {
+		turn.CreatedAt = now
+		s.turns = append(s.turns, turn)
+	}
+	s.updatedAt = now
+
+	return nil
+}
+
+func (r *sessionRepo) expired(s *session) bool {
+	return !r.now().Before(s.updatedAt.Add(r.ttl))
+}
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+)
+
+func TestSessionRepo(t *testing.T) {
+	type test struct {
+		append  map[string][]string
+		elapsed time.Duration
+		id      string
+		limit   int
+		want    []string
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a session, When listing its turns, Return them oldest first": func(t *testing.T) test {
+			return test{
+				append: map[string][]string{"a": {"harga B1207KDZ?", "135000000", "warnanya?"}},
+				id:     "a",
+				limit:  10,
+				want:   []string{"harga B1207KDZ?", "135000000", "warnanya?"},
+			}
+		},
+		"Given more turns than the limit, When listing, Return the last ones": func(t *testing.T) test {
+			return test{
+				append: map[string][]string{"a": {"1", "2", "3"}},
+				id:     "a",
+				limit:  2,
+				want:   []string{"2", "3"},
+			}
+		},
+		"Given an unknown session, When listing, Return no turns": func(t *testing.T) test {
+			return test{
+				append: map[string][]string{"a": {"1"}},
+				id:     "b",
+				limit:  10,
+			}
+		},
+		"Given an expired session, When listing, Return no turns": func(t *testing.T) test {
+			return test{
+				append:  map[string][]string{"a": {"1"}},
+				elapsed: time.Hour,
+				id:      "a",
+				limit:   10,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			now := time.Now()
+			repo := NewSessionRepo(time.Minute).(*sessionRepo)
+			repo.now = func() time.Time { return now }
+
+			for id, contents := range tt.append {
+				for _, content := range contents {
+					assert.NoError(t, repo.AppendTurns(context.Background(), id, repository.Turn{
+						Role:    repository.TurnUser,
+						Content: content,
+					}))
+				}
+			}
+
+			now = now.Add(tt.elapsed)
+
+			turns, err := repo.ListTurns(context.Background(), tt.id, tt.limit)
+			assert.NoError(t, err)
+
+			var got []string
+			for _, turn := range turns {
+				got = append(got, turn.Content)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
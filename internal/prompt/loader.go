@@ -0,0 +1,143 @@
+package prompt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const defaultName = "default"
+
+var (
+	// ErrNotFound is returned when the prompt asked for has no file.
+	ErrNotFound = errors.New("prompt not found")
+	// ErrInvalidName is returned when a prompt name is not a file name.
+	ErrInvalidName = errors.New("prompt name must be 1 to 50 letters, digits, dots, dashes or underscores")
+)
+
+var (
+	extensions  = []string{".yaml", ".yml", ".json"}
+	namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,49}$`)
+)
+
+type cached struct {
+	modTime time.Time
+	size    int64
+	prompt  *Prompt
+}
+
+// Loader reads prompts from <dir>/<name>.{yaml,yml,json}. Files are read
+// again once they changed, so a prompt can be tuned without a restart.
+type Loader struct {
+	dir   string
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+// NewLoader returns a Loader reading prompts from dir.
+func NewLoader(dir string) *Loader {
+	return &Loader{
+		dir:   dir,
+		cache: make(map[string]cached),
+	}
+}
+
+// Load returns the prompt of the name when given, ErrNotFound when it has no
+// file. Without a name it returns the prompt of the scope, named like the
+// scope without its file extension, then the "default" prompt, then the
+// built-in one.
+func (l *Loader) Load(name, scope string) (*Prompt, error) {
+	if name != "" {
+		if !namePattern.MatchString(name) {
+			return nil, ErrInvalidName
+		}
+
+		p, err := l.load(name)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+
+		return p, nil
+	}
+
+	if scope != "" {
+		base := filepath.Base(scope)
+		p, err := l.load(strings.TrimSuffix(base, filepath.Ext(base)))
+		if err != nil || p != nil {
+			return p, err
+		}
+	}
+
+	p, err := l.load(defaultName)
+	if err != nil || p != nil {
+		return p, err
+	}
+
+	return Default(), nil
+}
+
+// load returns the prompt of the first file of the name, nil when there is
+// none.
+func (l *Loader) load(name string) (*Prompt, error) {
+	for _, ext := range extensions {
+		path := filepath.Join(l.dir, name+ext)
+
+		info, err := os.Stat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return l.read(name, path, info)
+	}
+
+	return nil, nil
+}
+
+// read returns the cached prompt of the file while it is unchanged.
+func (l *Loader) read(name, path string, info fs.FileInfo) (*Prompt, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if c, ok := l.cache[path]; ok && c.modTime.Equal(info.ModTime()) && c.size == info.Size() {
+		return c.prompt, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f File
+	if filepath.Ext(path) == ".json" {
+		err = json.Unmarshal(data, &f)
+	} else {
+		err = yaml.Unmarshal(data, &f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("prompt %s: %w", path, err)
+	}
+
+	p, err := Compile(name, f)
+	if err != nil {
+		return nil, fmt.Errorf("prompt %s: %w", path, err)
+	}
+
+	l.cache[path] = cached{modTime: info.ModTime(), size: info.Size(), prompt: p}
+
+	return p, nil
+}
@@ -0,0 +1,138 @@
+package prompt_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/prompt"
+)
+
+func writePrompt(t *testing.T, dir, name, content string) {
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+}
+
+func TestLoader_Load(t *testing.T) {
+	type test struct {
+		files    map[string]string
+		name     string
+		scope    string
+		want     string
+		wantErr  error
+		question string
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given no file, When loading, Return the built-in prompt": func(t *testing.T) test {
+			return test{scope: "sample_lelang.csv", want: "default", question: "\n\nQuestion: warna?"}
+		},
+		"Given a scope file, When loading the scope, Return its prompt with the defaults": func(t *testing.T) test {
+			return test{
+				files:    map[string]string{"sample_lelang.yaml": "question: \"\\n\\nPertanyaan: {{.Question}}\"\n"},
+				scope:    "sample_lelang.csv",
+				want:     "sample_lelang",
+				question: "\n\nPertanyaan: warna?",
+			}
+		},
+		"Given a default file, When loading another scope, Return the default file": func(t *testing.T) test {
+			return test{
+				files:    map[string]string{"default.json": `{"question": "Q: {{.Question}}"}`},
+				scope:    "other",
+				want:     "default",
+				question: "Q: warna?",
+			}
+		},
+		"Given a named prompt, When loading it, Return it over the scope one": func(t *testing.T) test {
+			return test{
+				files: map[string]string{
+					"sample_lelang.yaml": "question: \"scope\"\n",
+					"short.yml":          "question: \"short {{.Question}}\"\n",
+				},
+				name:     "short",
+				scope:    "sample_lelang.csv",
+				want:     "short",
+				question: "short warna?",
+			}
+		},
+		"Given an unknown name, When loading, Return ErrNotFound": func(t *testing.T) test {
+			return test{name: "missing", wantErr: prompt.ErrNotFound}
+		},
+		"Given a path as name, When loading, Return ErrInvalidName": func(t *testing.T) test {
+			return test{name: "../secrets", wantErr: prompt.ErrInvalidName}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			dir := t.TempDir()
+			for file, content := range tt.files {
+				writePrompt(t, dir, file, content)
+			}
+
+			got, err := prompt.NewLoader(dir).Load(tt.name, tt.scope)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.Name())
+
+			question, err := got.Question(prompt.Data{Question: "warna?"})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.question, question)
+		})
+	}
+}
+
+func TestLoader_HotReload(t *testing.T) {
+	dir := t.TempDir()
+	loader := prompt.NewLoader(dir)
+
+	writePrompt(t, dir, "lelang.yaml", "not_found: Tidak ada.\n")
+	first, err := loader.Load("", "lelang")
+	assert.NoError(t, err)
+
+	writePrompt(t, dir, "lelang.yaml", "not_found: Tidak ditemukan.\n")
+	// the file system may keep the same modification time within a tick
+	later := time.Now().Add(time.Second)
+	assert.NoError(t, os.Chtimes(filepath.Join(dir, "lelang.yaml"), later, later))
+
+	second, err := loader.Load("", "lelang")
+	assert.NoError(t, err)
+
+	introduction, err := second.Introduction(prompt.Data{})
+	assert.NoError(t, err)
+	assert.Contains(t, introduction, "Tidak ditemukan.")
+	assert.NotSame(t, first, second)
+
+	third, err := loader.Load("", "lelang")
+	assert.NoError(t, err)
+	assert.Same(t, second, third)
+}
+
+func TestCompile(t *testing.T) {
+	_, err := prompt.Compile("broken", prompt.File{Section: "{{.Record}}"})
+	assert.Error(t, err)
+
+	_, err = prompt.Compile("unclosed", prompt.File{Question: "{{.Question"})
+	assert.Error(t, err)
+
+	p, err := prompt.Compile("fields", prompt.File{Section: `{{index .Fields "plat_no"}} ({{.Index}})`})
+	assert.NoError(t, err)
+
+	section, err := p.Section(prompt.Data{Fields: map[string]string{"plat_no": "B1207KDZ"}, Index: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "B1207KDZ (1)", section)
+}
+
+func TestRepositoryPrompts(t *testing.T) {
+	p, err := prompt.NewLoader("../../prompts").Load("", "sample_lelang.csv")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sample_lelang", p.Name())
+}
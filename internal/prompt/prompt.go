@@ -0,0 +1,182 @@
+package prompt
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Data is what every template of a prompt is executed with.
+type Data struct {
+	// Scope of the search.
+	Scope string
+	// Question of the user, the standalone query for a follow-up.
+	Question string
+	// NotFound is the wording of the prompt for a question without answer.
+	NotFound string
+	// ID, Text and Fields of the record of a context section, its 1-based
+	// Index and Score. ID is only set when the answer cites the record.
+	ID     string
+	Text   string
+	Fields map[string]string
+	Index  int
+	Score  float64
+}
+
+// File is the content of a prompt file. Empty values keep the default.
+type File struct {
+	// System is the system message of the answer.
+	System string `json:"system" yaml:"system"`
+	// Introduction starts the user message, before the context sections.
+	Introduction string `json:"introduction" yaml:"introduction"`
+	// Section is the format of every record given as context.
+	Section string `json:"section" yaml:"section"`
+	// Question ends the user message.
+	Question string `json:"question" yaml:"question"`
+	// NotFound is the wording asked for when the data has no answer.
+	NotFound string `json:"not_found" yaml:"not_found"`
+	// Rewrite is the system message turning a follow-up into a standalone
+	// question.
+	Rewrite string `json:"rewrite" yaml:"rewrite"`
+}
+
+var defaultFile = File{
+	System:       "You answer questions about the records of a data source given by the user. Answer in the language of the question.",
+	Introduction: `Use the below sample data to answer the subsequent question. If the answer cannot be found in the data source, write "{{.NotFound}}"`,
+	Section:      "\n\nPrompt section{{with .ID}} {{.}}{{end}}:\n\"\"\"\n{{.Text}}\n\"\"\"",
+	Question:     "\n\nQuestion: {{.Question}}",
+	NotFound:     "I could not find an answer.",
+	Rewrite:      "Rewrite the last question of the user as a standalone question that can be understood without the conversation. Keep every identifier, like plate, stock or chassis numbers, as written. Reply with the question only.",
+}
+
+// Prompt is a set of compiled templates of the answer step.
+type Prompt struct {
+	name         string
+	notFound     string
+	system       *template.Template
+	introduction *template.Template
+	section      *template.Template
+	question     *template.Template
+	rewrite      *template.Template
+}
+
+var defaultPrompt = mustCompile("default", File{})
+
+// Default returns the built-in English prompt.
+func Default() *Prompt {
+	return defaultPrompt
+}
+
+// Compile parses the templates of the file, the empty ones fall back to the
+// default prompt. Every template is tried on empty data, so a reference to
+// an unknown field fails here rather than during a search.
+func Compile(name string, f File) (*Prompt, error) {
+	f = f.withDefaults()
+
+	p := &Prompt{name: name, notFound: f.NotFound}
+
+	templates := []struct {
+		name string
+		text string
+		dst  **template.Template
+	}{
+		{"system", f.System, &p.system},
+		{"introduction", f.Introduction, &p.introduction},
+		{"section", f.Section, &p.section},
+		{"question", f.Question, &p.question},
+		{"rewrite", f.Rewrite, &p.rewrite},
+	}
+
+	for _, t := range templates {
+		parsed, err := template.New(t.name).Option("missingkey=zero").Parse(t.text)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.name, err)
+		}
+
+		if err := parsed.Execute(&strings.Builder{}, Data{}); err != nil {
+			return nil, fmt.Errorf("%s: %w", t.name, err)
+		}
+
+		*t.dst = parsed
+	}
+
+	return p, nil
+}
+
+func mustCompile(name string, f File) *Prompt {
+	p, err := Compile(name, f)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+func (f File) withDefaults() File {
+	if f.System == "" {
+		f.System = defaultFile.System
+	}
+	if f.Introduction == "" {
+		f.Introduction = defaultFile.Introduction
+	}
+	if f.Section == "" {
+		f.Section = defaultFile.Section
+	}
+	if f.Question == "" {
+		f.Question = defaultFile.Question
+	}
+	if f.NotFound == "" {
+		f.NotFound = defaultFile.NotFound
+	}
+	if f.Rewrite == "" {
+		f.Rewrite = defaultFile.Rewrite
+	}
+
+	return f
+}
+
+// Name returns the name of the prompt, its file name without extension.
+func (p *Prompt) Name() string {
+	return p.name
+}
+
+// NotFound returns the wording of the prompt for a question without answer.
+func (p *Prompt) NotFound() string {
+	return p.notFound
+}
+
+// System renders the system message of the answer.
+func (p *Prompt) System(data Data) (string, error) {
+	return p.execute(p.system, data)
+}
+
+// Introduction renders the start of the user message.
+func (p *Prompt) Introduction(data Data) (string, error) {
+	return p.execute(p.introduction, data)
+}
+
+// Section renders the context section of a record.
+func (p *Prompt) Section(data Data) (string, error) {
+	return p.execute(p.section, data)
+}
+
+// Question renders the end of the user message.
+func (p *Prompt) Question(data Data) (string, error) {
+	return p.execute(p.question, data)
+}
+
+// Rewrite renders the system message of the follow-up rewriting.
+func (p *Prompt) Rewrite(data Data) (string, error) {
+	return p.execute(p.rewrite, data)
+}
+
+func (p *Prompt) execute(t *template.Template, data Data) (string, error) {
+	data.NotFound = p.notFound
+
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("prompt %s: %w", p.name, err)
+	}
+
+	return b.String(), nil
+}
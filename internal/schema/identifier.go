@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Identifier finds the values of a column in a query, e.g. plate numbers
+// of the plat_no column, so the records holding them are looked up exactly.
+type Identifier struct {
+	Column string `json:"column" yaml:"column"`
+	// Pattern is a regular expression of the query. The value is its first
+	// capturing group, the whole match without one.
+	Pattern string `json:"pattern" yaml:"pattern"`
+
+	re *regexp.Regexp
+}
+
+// Match is a value of an identifier column found in a query.
+type Match struct {
+	Column string
+	Value  string
+}
+
+// compileIdentifiers compiles the pattern of every identifier. A dropped
+// column is never stored, so it cannot be looked up.
+func (s *Schema) compileIdentifiers() error {
+	for i, identifier := range s.Identifiers {
+		if identifier.Column == "" {
+			return fmt.Errorf("identifier %d: no column", i)
+		}
+
+		if s.Column(identifier.Column).Role == RoleDrop {
+			return fmt.Errorf("identifier %s: column is dropped", identifier.Column)
+		}
+
+		re, err := regexp.Compile(identifier.Pattern)
+		if err != nil {
+			return fmt.Errorf("identifier %s: %w", identifier.Column, err)
+		}
+
+		s.Identifiers[i].re = re
+	}
+
+	return nil
+}
+
+// Identify returns the identifier values of the query, in the order of the
+// identifiers then of their position in the query, without duplicates.
+func (s *Schema) Identify(query string) []Match {
+	var matches []Match
+	seen := make(map[Match]bool)
+	for _, identifier := range s.Identifiers {
+		if identifier.re == nil {
+			continue
+		}
+
+		for _, submatches := range identifier.re.FindAllStringSubmatch(query, -1) {
+			value := submatches[0]
+			if len(submatches) > 1 {
+				value = submatches[1]
+			}
+
+			match := Match{Column: identifier.Column, Value: value}
+			if value == "" || seen[match] {
+				continue
+			}
+
+			seen[match] = true
+			matches = append(matches, match)
+		}
+	}
+
+	return matches
+}
+
+// IdentifierColumns returns the columns of the identifiers, each once.
+func (s *Schema) IdentifierColumns() []string {
+	var columns []string
+	seen := make(map[string]bool)
+	for _, identifier := range s.Identifiers {
+		if !seen[identifier.Column] {
+			seen[identifier.Column] = true
+			columns = append(columns, identifier.Column)
+		}
+	}
+
+	return columns
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var extensions = []string{".yaml", ".yml", ".json"}
+
+// Loader reads the schema of a scope from <dir>/<scope>.{yaml,yml,json}.
+// The file extension of the scope itself is ignored, so the scope
+// "sample_lelang.csv" uses "sample_lelang.yaml".
+type Loader struct {
+	dir string
+}
+
+// NewLoader returns a Loader reading schemas from dir.
+func NewLoader(dir string) *Loader {
+	return &Loader{
+		dir: dir,
+	}
+}
+
+// Load returns the schema of the scope, or the default schema when the
+// scope has no schema file.
+func (l *Loader) Load(scope string) (*Schema, error) {
+	name := filepath.Base(scope)
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+
+	for _, ext := range extensions {
+		path := filepath.Join(l.dir, name+ext)
+
+		data, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		s := &Schema{}
+		if ext == ".json" {
+			err = json.Unmarshal(data, s)
+		} else {
+			err = yaml.Unmarshal(data, s)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("schema %s: %w", path, err)
+		}
+
+		s.setDefaults()
+		if err := s.Validate(); err != nil {
+			return nil, fmt.Errorf("schema %s: %w", path, err)
+		}
+
+		return s, nil
+	}
+
+	return Default(), nil
+}
@@ -0,0 +1,165 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yonisaka/similarity/pkg/csvreader"
+)
+
+const (
+	// RoleEmbed columns are embedded and stored as payload.
+	RoleEmbed = "embed"
+	// RolePayload columns are stored as payload only.
+	RolePayload = "payload"
+	// RoleDrop columns are never stored, e.g. personal identity numbers.
+	RoleDrop = "drop"
+)
+
+// Column describes how one source column is imported.
+type Column struct {
+	Role    string `json:"role" yaml:"role"`
+	Keyword bool   `json:"keyword" yaml:"keyword"`
+}
+
+// Schema maps the columns of a scope to their import roles.
+type Schema struct {
+	// Default applies to the columns missing from Columns.
+	Default Column            `json:"default" yaml:"default"`
+	Columns map[string]Column `json:"columns" yaml:"columns"`
+	// CSV is the dialect of the CSV files of the scope. Its strict mode and
+	// header override also apply to the other formats.
+	CSV csvreader.Dialect `json:"csv" yaml:"csv"`
+	// Identifiers find exact keys of records in search queries.
+	Identifiers []Identifier `json:"identifiers" yaml:"identifiers"`
+}
+
+// Row is a source row mapped through a schema.
+type Row struct {
+	// Combined is the "column: value" text of every stored column.
+	Combined string
+	// Embed is the text sent to the embedder.
+	Embed string
+	// Keywords is the text of the keyword-indexed columns.
+	Keywords string
+	// Fields holds the value of every stored column.
+	Fields map[string]string
+}
+
+// Default returns the schema used when a scope has none: every column is
+// embedded and keyword-indexed.
+func Default() *Schema {
+	return &Schema{
+		Default: Column{Role: RoleEmbed, Keyword: true},
+	}
+}
+
+// setDefaults fills the roles left empty: the default role is payload and a
+// column without a role takes the default one.
+func (s *Schema) setDefaults() {
+	if s.Default.Role == "" {
+		s.Default.Role = RolePayload
+	}
+
+	for name, column := range s.Columns {
+		if column.Role == "" {
+			column.Role = s.Default.Role
+			s.Columns[name] = column
+		}
+	}
+}
+
+// Validate checks that every role is known and compiles the identifier
+// patterns.
+func (s *Schema) Validate() error {
+	if err := validateRole(s.Default.Role); err != nil {
+		return fmt.Errorf("default: %w", err)
+	}
+
+	for name, column := range s.Columns {
+		if err := validateRole(column.Role); err != nil {
+			return fmt.Errorf("column %s: %w", name, err)
+		}
+	}
+
+	return s.compileIdentifiers()
+}
+
+func validateRole(role string) error {
+	switch role {
+	case RoleEmbed, RolePayload, RoleDrop:
+		return nil
+	default:
+		return fmt.Errorf("unknown role %q", role)
+	}
+}
+
+// Column returns the import rule of a column.
+func (s *Schema) Column(name string) Column {
+	if column, ok := s.Columns[normalize(name)]; ok {
+		return column
+	}
+
+	return s.Default
+}
+
+// Apply maps a source record through the schema. Empty values are left out.
+func (s *Schema) Apply(headers, record []string) Row {
+	row := Row{Fields: make(map[string]string)}
+
+	var combined, embed, keywords []string
+	for i, value := range record {
+		if value == "" || i >= len(headers) {
+			continue
+		}
+
+		name := normalize(headers[i])
+		column := s.Column(name)
+		if column.Role == RoleDrop {
+			continue
+		}
+
+		row.Fields[name] = value
+		combined = append(combined, fmt.Sprintf("%s: %s", name, value))
+
+		if column.Role == RoleEmbed {
+			embed = append(embed, value)
+		}
+
+		if column.Keyword {
+			keywords = append(keywords, value)
+		}
+	}
+
+	row.Combined = strings.Join(combined, "; ")
+	row.Embed = strings.Join(embed, " ")
+	row.Keywords = strings.Join(keywords, " ")
+
+	return row
+}
+
+// Parse maps a stored combined text back through the schema. A segment
+// without a "column: " label belongs to the value before it.
+func (s *Schema) Parse(combined string) Row {
+	var headers, record []string
+	for _, segment := range strings.Split(combined, "; ") {
+		name, value, ok := strings.Cut(segment, ": ")
+		if !ok && len(record) > 0 {
+			record[len(record)-1] += "; " + segment
+			continue
+		}
+
+		if !ok {
+			value = segment
+		}
+
+		headers = append(headers, name)
+		record = append(record, value)
+	}
+
+	return s.Apply(headers, record)
+}
+
+func normalize(name string) string {
+	return strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+}
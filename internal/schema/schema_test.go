@@ -0,0 +1,134 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/schema"
+)
+
+func TestSchema_Apply(t *testing.T) {
+	s := &schema.Schema{
+		Default: schema.Column{Role: schema.RolePayload},
+		Columns: map[string]schema.Column{
+			"stock_no":     {Role: schema.RoleEmbed, Keyword: true},
+			"warna":        {Role: schema.RoleEmbed},
+			"npwp_pembeli": {Role: schema.RoleDrop},
+		},
+	}
+
+	type test struct {
+		headers []string
+		record  []string
+		want    schema.Row
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a record, When applied, Return row without dropped and empty columns": func(t *testing.T) test {
+			return test{
+				headers: []string{"\ufeffstock_no", "warna", "npwp_pembeli", "note1", "odometer"},
+				record:  []string{"BA00001023J09", "Hitam", "1,23457E+14", "KM 108585", ""},
+				want: schema.Row{
+					Combined: "stock_no: BA00001023J09; warna: Hitam; note1: KM 108585",
+					Embed:    "BA00001023J09 Hitam",
+					Keywords: "BA00001023J09",
+					Fields: map[string]string{
+						"stock_no": "BA00001023J09",
+						"warna":    "Hitam",
+						"note1":    "KM 108585",
+					},
+				},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			got := s.Apply(tt.headers, tt.record)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, s.Parse(got.Combined))
+		})
+	}
+}
+
+func TestLoader_Load(t *testing.T) {
+	sut := schema.NewLoader("../../schemas")
+
+	got, err := sut.Load("sample_lelang.csv")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, schema.RoleDrop, got.Column("npwp_pembeli").Role)
+	assert.True(t, got.Column("plat_no").Keyword)
+
+	missing, err := sut.Load("unknown.csv")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, schema.Default(), missing)
+}
+
+func TestSchema_Identify(t *testing.T) {
+	s, err := schema.NewLoader("../../schemas").Load("sample_lelang.csv")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	type test struct {
+		query string
+		want  []schema.Match
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a stock number, When identified, Return it": func(t *testing.T) test {
+			return test{
+				query: "stok nomor BA00001023J09 memiliki odometer berapa?",
+				want:  []schema.Match{{Column: "stock_no", Value: "BA00001023J09"}},
+			}
+		},
+		"Given a plate number, When identified, Return it": func(t *testing.T) test {
+			return test{
+				query: "mobil dengan plat nomor T8324AP memiliki harga awal berapa?",
+				want:  []schema.Match{{Column: "plat_no", Value: "T8324AP"}},
+			}
+		},
+		"Given a question about the engine number, When identified, Return the plate only": func(t *testing.T) test {
+			return test{
+				query: "mobil dengan plat nomor D1167AGX memiliki nomor mesin apa?",
+				want:  []schema.Match{{Column: "plat_no", Value: "D1167AGX"}},
+			}
+		},
+		"Given no identifier, When identified, Return nothing": func(t *testing.T) test {
+			return test{
+				query: "mobil apa yang paling murah?",
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			assert.Equal(t, tt.want, s.Identify(tt.query))
+		})
+	}
+}
+
+func TestSchema_Validate_Identifiers(t *testing.T) {
+	s := &schema.Schema{
+		Default:     schema.Column{Role: schema.RolePayload},
+		Identifiers: []schema.Identifier{{Column: "plat_no", Pattern: "("}},
+	}
+	assert.Error(t, s.Validate())
+
+	s = &schema.Schema{
+		Default:     schema.Column{Role: schema.RoleDrop},
+		Identifiers: []schema.Identifier{{Column: "plat_no", Pattern: `\w+`}},
+	}
+	assert.Error(t, s.Validate())
+}
@@ -2,8 +2,15 @@ package types
 
 // StringAndRelatedness holds a text and its relatedness score.
 type StringAndRelatedness struct {
-	ID          uint
-	QdrantID    string
-	Text        string
-	Relatedness float64
+	ID          uint    `json:"id,omitempty"`
+	QdrantID    string  `json:"qdrant_id,omitempty"`
+	Text        string  `json:"text"`
+	Relatedness float64 `json:"relatedness"`
+	// Key identifies the record in every search backend. Qdrant and
+	// Elasticsearch share the md5 point ID, Postgres rows have none.
+	Key string `json:"key,omitempty"`
+	// Ranks and Scores hold the 1-based rank and the raw score of the hit in
+	// every source that found it, once fused.
+	Ranks  map[string]int     `json:"ranks,omitempty"`
+	Scores map[string]float64 `json:"scores,omitempty"`
 }
@@ -6,7 +6,68 @@ type Http struct {
 	Data    any    `json:"data"`
 }
 
+// SearchRequest is a question asked about the records of a scope.
+type SearchRequest struct {
+	Query string
+	// Scope is the default scope when empty.
+	Scope string
+	// Method names the retriever, the default one when empty.
+	Method string
+	// RetrievalOnly returns the sources without asking GPT.
+	RetrievalOnly bool
+	// SessionID names the conversation the query follows up on, none when
+	// empty.
+	SessionID string
+	// Template names the prompt template, the one of the scope when empty.
+	Template string
+	// Mode is how the question is answered, free text when empty.
+	Mode string
+}
+
 type SearchResponse struct {
 	Question string `json:"question"`
 	Answer   string `json:"answer"`
+	// SessionID is the conversation of the question.
+	SessionID string `json:"session_id,omitempty"`
+	// RetrievalQuery is the standalone query retrieved for a follow-up.
+	RetrievalQuery string `json:"retrieval_query,omitempty"`
+	// Extraction is the field value answered in extract mode.
+	Extraction *Extraction `json:"extraction,omitempty"`
+	// Sources are the retrieved records, best first.
+	Sources []Source `json:"sources"`
+}
+
+// Extraction is the value of one field of one record answering a question.
+type Extraction struct {
+	// Found is false when no record answers the question.
+	Found bool `json:"found"`
+	// RecordID is the ID of the cited source.
+	RecordID string `json:"record_id,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Value    string `json:"value,omitempty"`
+	// Confidence is how sure GPT is of the value, from 0 to 1.
+	Confidence float64 `json:"confidence"`
+	// Verified is true when the value appears in the cited source.
+	Verified bool `json:"verified"`
+}
+
+// Source is a retrieved record of a search.
+type Source struct {
+	// ID is the record ID in the store of the retriever.
+	ID        string  `json:"id"`
+	Scope     string  `json:"scope"`
+	Score     float64 `json:"score"`
+	Retriever string  `json:"retriever"`
+	// Ranks and Scores hold the rank and raw score of the record in every
+	// ranked list of the retriever that found it.
+	Ranks  map[string]int     `json:"ranks,omitempty"`
+	Scores map[string]float64 `json:"scores,omitempty"`
+	Text   string             `json:"text"`
+	// Fields holds the payload fields of the record.
+	Fields map[string]string `json:"fields,omitempty"`
+	// Used is true when the record was part of the prompt, it may not fit
+	// in the token budget.
+	Used bool `json:"used"`
+	// Truncated is true when only the start of the record fit in the prompt.
+	Truncated bool `json:"truncated,omitempty"`
 }
@@ -0,0 +1,51 @@
+package types
+
+const (
+	// SearchEventSources carries the []Source of the search.
+	SearchEventSources = "sources"
+	// SearchEventDelta carries a SearchDelta of the answer.
+	SearchEventDelta = "delta"
+	// SearchEventExtraction carries the Extraction answered in extract mode,
+	// in place of the deltas.
+	SearchEventExtraction = "extraction"
+	// SearchEventDone carries the SearchDone closing the stream.
+	SearchEventDone = "done"
+	// SearchEventError carries a SearchError ending the stream early.
+	SearchEventError = "error"
+)
+
+// SearchEvent is an event of a streamed search.
+type SearchEvent struct {
+	Event string
+	Data  any
+}
+
+// SearchDelta is the next piece of the answer.
+type SearchDelta struct {
+	Content string `json:"content"`
+}
+
+// SearchDone ends a streamed search.
+type SearchDone struct {
+	Usage   Usage         `json:"usage"`
+	Latency SearchLatency `json:"latency"`
+}
+
+// Usage counts the tokens of a completion.
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// SearchLatency holds the milliseconds since the search started at every step.
+type SearchLatency struct {
+	RetrievalMs  int64 `json:"retrieval_ms"`
+	FirstTokenMs int64 `json:"first_token_ms"`
+	TotalMs      int64 `json:"total_ms"`
+}
+
+// SearchError is why a streamed search stopped.
+type SearchError struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,63 @@
+package usecases
+
+// estimateTokens approximates the tokens of a text at four characters per token.
+func estimateTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
+// batchRows groups the row indexes from offset onward so that every batch has
+// at most maxInputs rows and maxTokens estimated tokens. A single row larger
+// than maxTokens still gets a batch of its own.
+func batchRows(rows []string, offset, maxInputs, maxTokens int) [][]int {
+	var batches [][]int
+	var batch []int
+	batchTokens := 0
+
+	for i := offset; i < len(rows); i++ {
+		nTokens := estimateTokens(rows[i])
+
+		if len(batch) > 0 && (len(batch) >= maxInputs || batchTokens+nTokens > maxTokens) {
+			batches = append(batches, batch)
+			batch = nil
+			batchTokens = 0
+		}
+
+		batch = append(batch, i)
+		batchTokens += nTokens
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// splitTokens distributes the total tokens reported for a batch over its rows
+// in proportion to their estimated size.
+func splitTokens(total int, texts []string) []int {
+	ret := make([]int, len(texts))
+	if len(texts) == 0 {
+		return ret
+	}
+
+	estimated := 0
+	for _, text := range texts {
+		estimated += estimateTokens(text)
+	}
+
+	assigned := 0
+	for i, text := range texts {
+		if i == len(texts)-1 {
+			ret[i] = total - assigned
+			break
+		}
+
+		if estimated > 0 {
+			ret[i] = total * estimateTokens(text) / estimated
+		}
+		assigned += ret[i]
+	}
+
+	return ret
+}
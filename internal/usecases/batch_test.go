@@ -0,0 +1,130 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/pkg/embedder"
+	"github.com/yonisaka/similarity/pkg/logger"
+)
+
+func TestBatchRows(t *testing.T) {
+	type args struct {
+		rows      []string
+		offset    int
+		maxInputs int
+		maxTokens int
+	}
+
+	type test struct {
+		args args
+		want [][]int
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given rows under the token limit, When batched, Return batches capped by input count": func(t *testing.T) test {
+			return test{
+				args: args{
+					rows:      []string{"a", "b", "c", "d", "e"},
+					maxInputs: 2,
+					maxTokens: 100,
+				},
+				want: [][]int{{0, 1}, {2, 3}, {4}},
+			}
+		},
+		"Given large rows, When batched, Return batches capped by tokens": func(t *testing.T) test {
+			row := strings.Repeat("x", 40) // 10 tokens
+			return test{
+				args: args{
+					rows:      []string{row, row, row, row},
+					maxInputs: 10,
+					maxTokens: 25,
+				},
+				want: [][]int{{0, 1}, {2, 3}},
+			}
+		},
+		"Given an offset, When batched, Return only rows after the offset": func(t *testing.T) test {
+			return test{
+				args: args{
+					rows:      []string{"a", "b", "c"},
+					offset:    2,
+					maxInputs: 10,
+					maxTokens: 100,
+				},
+				want: [][]int{{2}},
+			}
+		},
+		"Given a row over the token limit, When batched, Return it in its own batch": func(t *testing.T) test {
+			return test{
+				args: args{
+					rows:      []string{"a", strings.Repeat("x", 400), "b"},
+					maxInputs: 10,
+					maxTokens: 10,
+				},
+				want: [][]int{{0}, {1}, {2}},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			got := batchRows(tt.args.rows, tt.args.offset, tt.args.maxInputs, tt.args.maxTokens)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitTokens(t *testing.T) {
+	got := splitTokens(10, []string{strings.Repeat("x", 4), strings.Repeat("x", 12)})
+
+	assert.Equal(t, []int{2, 8}, got)
+}
+
+// resumeRepo holds the rows of an interrupted import of a scope.
+type resumeRepo struct {
+	repository.EmbeddingRepo
+	stored  int
+	created int
+}
+
+func (r *resumeRepo) CountEmbeddingByScope(ctx context.Context, scope string) (int, error) {
+	return r.stored, nil
+}
+
+func (r *resumeRepo) CreateEmbedding(ctx context.Context, embedding *repository.Embedding) error {
+	r.created++
+	return nil
+}
+
+func TestRunImport_Resume(t *testing.T) {
+	l, err := logger.NewLogger()
+	assert.NoError(t, err)
+
+	repo := &resumeRepo{stored: 2}
+	u := &importUsecase{
+		embedder:      embedder.NewHashEmbedder(8),
+		embeddingRepo: repo,
+		logger:        l,
+		config:        ImportConfig{}.withDefaults(),
+		limiter:       newRateLimiter(6000, 1000000),
+	}
+
+	rows := &importRows{}
+	for i, text := range []string{"a", "b", "c", "d", "e"} {
+		rows.add(i+2, text, text)
+	}
+	job := &repository.ImportJob{Scope: "lelang", RowsTotal: 6, RowsSkipped: 1}
+
+	assert.NoError(t, u.runImport(context.Background(), job, rows, nil))
+
+	assert.Equal(t, 3, repo.created)
+	assert.Equal(t, 3, job.RowsEmbedded)
+	assert.Equal(t, 2, job.RowsResumed)
+	assert.Equal(t, 1, job.RowsSkipped)
+}
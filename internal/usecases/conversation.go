@@ -0,0 +1,128 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/prompt"
+)
+
+const (
+	roleSystem    = "system"
+	roleAssistant = "assistant"
+	// maxHistoryTurns is how many turns of a session are loaded.
+	maxHistoryTurns = 20
+	// historyBudget is the share of the token budget the history may take.
+	historyBudget = tokenBudget / 2
+)
+
+// ErrInvalidSession is returned when a session ID is not a valid name.
+var ErrInvalidSession = errors.New("session id must be 1 to 64 letters, digits, dashes or underscores")
+
+var sessionPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// ValidateSession checks the session ID of a request.
+func ValidateSession(id string) error {
+	if !sessionPattern.MatchString(id) {
+		return ErrInvalidSession
+	}
+
+	return nil
+}
+
+// history returns the turns of the session, none without a session.
+func (u *searchUsecase) history(ctx context.Context, sessionID string) ([]repository.Turn, error) {
+	if sessionID == "" {
+		return nil, nil
+	}
+
+	if err := ValidateSession(sessionID); err != nil {
+		return nil, err
+	}
+
+	return u.sessionRepo.ListTurns(ctx, sessionID, maxHistoryTurns)
+}
+
+// standaloneQuery rewrites a follow-up into a query that can be retrieved
+// without the earlier turns, with the rewrite message of the prompt. The
+// query is kept as is without history.
+func (u *searchUsecase) standaloneQuery(ctx context.Context, p *prompt.Prompt, scope string, history []repository.Turn, query string) (string, error) {
+	if len(history) == 0 {
+		return query, nil
+	}
+
+	rewrite, err := p.Rewrite(prompt.Data{Scope: scope, Question: query})
+	if err != nil {
+		return "", err
+	}
+
+	messages := []openai.ChatCompletionMessage{{Role: roleSystem, Content: rewrite}}
+	messages = append(messages, historyMessages(history)...)
+	messages = append(messages, openai.ChatCompletionMessage{Role: roleUser, Content: query})
+
+	rewritten, err := u.complete(ctx, messages)
+	if err != nil {
+		return "", fmt.Errorf("rewrite follow-up: %w", err)
+	}
+
+	rewritten = strings.TrimSpace(rewritten)
+	if rewritten == "" {
+		return query, nil
+	}
+
+	u.logger.Info(fmt.Sprintf("rewrote %q into %q", query, rewritten))
+
+	return rewritten, nil
+}
+
+// recentHistory returns the most recent turns fitting in the budget, oldest
+// first, and their number of tokens.
+func (u *searchUsecase) recentHistory(history []repository.Turn, budget int) ([]repository.Turn, int) {
+	tokens := 0
+	start := len(history)
+	for start > 0 {
+		n := u.NumTokens(history[start-1].Content)
+		if tokens+n > budget {
+			break
+		}
+
+		tokens += n
+		start--
+	}
+
+	return history[start:], tokens
+}
+
+// remember appends the question and its answer to the session.
+func (u *searchUsecase) remember(ctx context.Context, sessionID, question, answer string) {
+	if sessionID == "" {
+		return
+	}
+
+	if err := u.sessionRepo.AppendTurns(ctx, sessionID,
+		repository.Turn{Role: repository.TurnUser, Content: question},
+		repository.Turn{Role: repository.TurnAssistant, Content: answer},
+	); err != nil {
+		// the answer is still valid, only the follow-ups lose this turn
+		u.logger.Warn(fmt.Sprintf("failed to save session %s: %s", sessionID, err))
+	}
+}
+
+func historyMessages(history []repository.Turn) []openai.ChatCompletionMessage {
+	messages := make([]openai.ChatCompletionMessage, 0, len(history))
+	for _, turn := range history {
+		role := roleUser
+		if turn.Role == repository.TurnAssistant {
+			role = roleAssistant
+		}
+
+		messages = append(messages, openai.ChatCompletionMessage{Role: role, Content: turn.Content})
+	}
+
+	return messages
+}
@@ -0,0 +1,142 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/infrastructure/memory"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/pkg/logger"
+)
+
+// newChatClient returns a client answering the chat completions with the
+// replies in order and recording the requests.
+func newChatClient(t *testing.T, requests *[]openai.ChatCompletionRequest, replies ...string) openai.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request openai.ChatCompletionRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		*requests = append(*requests, request)
+
+		reply := replies[0]
+		replies = replies[1:]
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{{
+				Message: openai.ChatCompletionMessage{Role: roleAssistant, Content: reply},
+			}},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	config := openai.DefaultConfig("test")
+	config.BaseURL = server.URL + "/v1"
+
+	return *openai.NewClientWithConfig(config)
+}
+
+type recordingRetriever struct {
+	queries []string
+}
+
+func (r *recordingRetriever) Retrieve(ctx context.Context, scope, query string) ([]types.StringAndRelatedness, error) {
+	r.queries = append(r.queries, query)
+	return []types.StringAndRelatedness{{ID: 1, Text: "nopol: B1207KDZ; odometer: 108585"}}, nil
+}
+
+func TestSearch_Session(t *testing.T) {
+	type test struct {
+		history     []string
+		replies     []string
+		wantQuery   string
+		wantAnswer  string
+		wantReplies int
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a new session, When searching, Return the answer of the query as is": func(t *testing.T) test {
+			return test{
+				replies:     []string{"108585"},
+				wantQuery:   "odometer B1207KDZ?",
+				wantAnswer:  "108585",
+				wantReplies: 1,
+			}
+		},
+		"Given a follow-up, When searching, Return the answer of the rewritten query": func(t *testing.T) test {
+			return test{
+				history:     []string{"harga B1207KDZ?", "135000000"},
+				replies:     []string{"berapa odometer B1207KDZ?", "108585"},
+				wantQuery:   "berapa odometer B1207KDZ?",
+				wantAnswer:  "108585",
+				wantReplies: 2,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			sessions := memory.NewSessionRepo(time.Minute)
+			for i, content := range tt.history {
+				role := repository.TurnUser
+				if i%2 == 1 {
+					role = repository.TurnAssistant
+				}
+				assert.NoError(t, sessions.AppendTurns(context.Background(), "s1", repository.Turn{Role: role, Content: content}))
+			}
+
+			question := "odometer B1207KDZ?"
+			if len(tt.history) > 0 {
+				question = "odometernya?"
+			}
+
+			l, err := logger.NewLogger()
+			assert.NoError(t, err)
+
+			var requests []openai.ChatCompletionRequest
+			retriever := &recordingRetriever{}
+			u := &searchUsecase{
+				client:        newChatClient(t, &requests, tt.replies...),
+				embeddingRepo: fakeScopeRepo{},
+				sessionRepo:   sessions,
+				retrievers:    Retrievers{MethodPostgresql: retriever},
+				defaultMethod: MethodPostgresql,
+				schemas:       schema.NewLoader(t.TempDir()),
+				prompts:       prompt.NewLoader(t.TempDir()),
+				logger:        l,
+			}
+
+			got, err := u.Search(context.Background(), types.SearchRequest{Query: question, SessionID: "s1"})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAnswer, got.Answer)
+			assert.Equal(t, []string{tt.wantQuery}, retriever.queries)
+			assert.Len(t, requests, tt.wantReplies)
+
+			// the answer carries the earlier turns after the system prompt
+			answerRequest := requests[len(requests)-1]
+			assert.Len(t, answerRequest.Messages, len(tt.history)+2)
+
+			turns, err := sessions.ListTurns(context.Background(), "s1", maxHistoryTurns)
+			assert.NoError(t, err)
+			assert.Len(t, turns, len(tt.history)+2)
+			assert.Equal(t, question, turns[len(turns)-2].Content)
+		})
+	}
+}
+
+func TestValidateSession(t *testing.T) {
+	assert.NoError(t, ValidateSession("3f2c9a1e-chat_01"))
+	assert.ErrorIs(t, ValidateSession(""), ErrInvalidSession)
+	assert.ErrorIs(t, ValidateSession("a b"), ErrInvalidSession)
+}
@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+)
+
+// sourceExact ranks the records found by an exact identifier lookup.
+const sourceExact = "exact"
+
+// ExactRetriever is a Retriever that also finds the records holding exactly
+// the identifier values of a query.
+type ExactRetriever interface {
+	Retriever
+	Lookup(ctx context.Context, scope string, matches []schema.Match) ([]types.StringAndRelatedness, error)
+}
+
+// exactHits looks up the identifiers the schema of the scope finds in the
+// query. Retrievers without exact lookup find nothing.
+func (u *searchUsecase) exactHits(ctx context.Context, retriever Retriever, s *schema.Schema, scope, query string) ([]types.StringAndRelatedness, error) {
+	matches := s.Identify(query)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	exact, ok := retriever.(ExactRetriever)
+	if !ok {
+		return nil, nil
+	}
+
+	hits, err := exact.Lookup(ctx, scope, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Info(fmt.Sprintf("identifiers %v of scope %s: %d exact hits", matches, scope, len(hits)))
+
+	return hits, nil
+}
+
+// rankExact puts the exact hits ahead of the semantic ones, which keep their
+// order without the records found exactly. An exact hit scores 1 and keeps
+// the ranks and scores of the semantic search that found it too.
+func rankExact(exact, semantic []types.StringAndRelatedness) []types.StringAndRelatedness {
+	if len(exact) == 0 {
+		return semantic
+	}
+
+	found := make(map[string]types.StringAndRelatedness, len(semantic))
+	for _, hit := range semantic {
+		found[hitKey(hit)] = hit
+	}
+
+	results := make([]types.StringAndRelatedness, 0, len(exact)+len(semantic))
+	ranked := make(map[string]bool, len(exact))
+	for _, hit := range exact {
+		key := hitKey(hit)
+		if ranked[key] {
+			continue
+		}
+		ranked[key] = true
+
+		ranks := map[string]int{sourceExact: len(ranked)}
+		scores := map[string]float64{sourceExact: 1}
+		if other, ok := found[key]; ok {
+			for source, rank := range other.Ranks {
+				ranks[source] = rank
+			}
+			for source, score := range other.Scores {
+				scores[source] = score
+			}
+		}
+
+		hit.Relatedness = 1
+		hit.Ranks = ranks
+		hit.Scores = scores
+		results = append(results, hit)
+	}
+
+	for _, hit := range semantic {
+		if !ranked[hitKey(hit)] {
+			results = append(results, hit)
+		}
+	}
+
+	return results
+}
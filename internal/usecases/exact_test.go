@@ -0,0 +1,99 @@
+package usecases
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/pkg/logger"
+)
+
+type fakeExactRetriever struct {
+	fakeRetriever
+	exact   []types.StringAndRelatedness
+	matches []schema.Match
+}
+
+func (r *fakeExactRetriever) Lookup(ctx context.Context, scope string, matches []schema.Match) ([]types.StringAndRelatedness, error) {
+	r.matches = matches
+	return r.exact, nil
+}
+
+func TestRankExact(t *testing.T) {
+	semantic := hits("a", "b", "c")
+	semantic[1].Ranks = map[string]int{sourceVector: 2}
+
+	got := rankExact(hits("b", "d", "b"), semantic)
+
+	assert.Equal(t, []string{"b", "d", "a", "c"}, keys(got))
+	assert.Equal(t, map[string]int{sourceExact: 1, sourceVector: 2}, got[0].Ranks)
+	assert.Equal(t, map[string]int{sourceExact: 2}, got[1].Ranks)
+	assert.Equal(t, 1.0, got[1].Relatedness)
+	assert.Equal(t, semantic, rankExact(nil, semantic))
+}
+
+func TestSearch_Exact(t *testing.T) {
+	type test struct {
+		query       string
+		wantMatches []schema.Match
+		wantOrder   []string
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a plate number, When searching, Return its record first": func(t *testing.T) test {
+			return test{
+				query:       "plat nomor T8324AP harga awal?",
+				wantMatches: []schema.Match{{Column: "plat_no", Value: "T8324AP"}},
+				wantOrder:   []string{"c", "a", "b"},
+			}
+		},
+		"Given no identifier, When searching, Return the semantic order": func(t *testing.T) test {
+			return test{
+				query:     "mobil hitam?",
+				wantOrder: []string{"a", "b"},
+			}
+		},
+	}
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "lelang.yaml"), []byte(
+		"identifiers:\n  - column: plat_no\n    pattern: '(?i)plat nomor ([A-Z0-9]+)'\n",
+	), 0o600))
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			l, err := logger.NewLogger()
+			assert.NoError(t, err)
+
+			retriever := &fakeExactRetriever{fakeRetriever: fakeRetriever{hits: hits("a", "b")}, exact: hits("c")}
+			u := &searchUsecase{
+				embeddingRepo: fakeScopeRepo{},
+				retrievers:    Retrievers{MethodQdrant: retriever},
+				defaultMethod: MethodQdrant,
+				schemas:       schema.NewLoader(dir),
+				prompts:       prompt.NewLoader(t.TempDir()),
+				logger:        l,
+			}
+
+			got, err := u.Search(context.Background(), types.SearchRequest{Query: tt.query, Scope: "lelang", RetrievalOnly: true})
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			order := make([]string, 0, len(got.Sources))
+			for _, source := range got.Sources {
+				order = append(order, source.ID)
+			}
+
+			assert.Equal(t, tt.wantMatches, retriever.matches)
+			assert.Equal(t, tt.wantOrder, order)
+		})
+	}
+}
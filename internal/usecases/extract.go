@@ -0,0 +1,212 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/sashabaranov/go-openai/jsonschema"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/types"
+)
+
+const (
+	// AnswerText answers in free text, the default.
+	AnswerText = "text"
+	// AnswerExtract answers with the value of one field of one record.
+	AnswerExtract = "extract"
+
+	extractFunction = "answer_field"
+)
+
+// ErrUnknownMode is returned for an answer mode other than text or extract.
+var ErrUnknownMode = errors.New("unknown answer mode")
+
+func validateMode(mode string) error {
+	switch mode {
+	case "", AnswerText, AnswerExtract:
+		return nil
+	default:
+		return fmt.Errorf("%w %q, expected %s or %s", ErrUnknownMode, mode, AnswerText, AnswerExtract)
+	}
+}
+
+// extractArguments are the arguments GPT calls the answer function with.
+type extractArguments struct {
+	Found      bool    `json:"found"`
+	RecordID   string  `json:"record_id"`
+	Field      string  `json:"field"`
+	Value      string  `json:"value"`
+	Confidence float64 `json:"confidence"`
+}
+
+// extractTool describes the answer function. The record ID is restricted to
+// the IDs of the sections in the prompt.
+func extractTool(ids []string) openai.Tool {
+	recordID := jsonschema.Definition{
+		Type:        jsonschema.String,
+		Description: "ID of the prompt section holding the value.",
+	}
+	if len(ids) > 0 {
+		recordID.Enum = ids
+	}
+
+	return openai.Tool{
+		Type: openai.ToolTypeFunction,
+		Function: openai.FunctionDefinition{
+			Name:        extractFunction,
+			Description: "Answer the question with the value of one field of one record of the data.",
+			Parameters: jsonschema.Definition{
+				Type: jsonschema.Object,
+				Properties: map[string]jsonschema.Definition{
+					"found": {
+						Type:        jsonschema.Boolean,
+						Description: "Whether the data answers the question.",
+					},
+					"record_id": recordID,
+					"field": {
+						Type:        jsonschema.String,
+						Description: "Name of the field holding the value, as written in the record.",
+					},
+					"value": {
+						Type:        jsonschema.String,
+						Description: "The value exactly as written in the record, without unit or wording added.",
+					},
+					"confidence": {
+						Type:        jsonschema.Number,
+						Description: "How sure you are of the value, from 0 to 1.",
+					},
+				},
+				Required: []string{"found", "record_id", "field", "value", "confidence"},
+			},
+		},
+	}
+}
+
+// extract asks GPT for the answer through the answer function and checks
+// the value against the cited source. It returns the usage of the
+// completion.
+func (u *searchUsecase) extract(ctx context.Context, messages []openai.ChatCompletionMessage, sources []types.Source) (*types.Extraction, types.Usage, error) {
+	var ids []string
+	for _, source := range sources {
+		if source.Used {
+			ids = append(ids, source.ID)
+		}
+	}
+
+	request := chatRequest(messages)
+	request.Tools = []openai.Tool{extractTool(ids)}
+	request.ToolChoice = openai.ToolChoice{
+		Type:     openai.ToolTypeFunction,
+		Function: openai.ToolFunction{Name: extractFunction},
+	}
+
+	resp, err := u.client.CreateChatCompletion(ctx, request)
+	if err != nil {
+		return nil, types.Usage{}, err
+	}
+
+	usage := types.Usage{
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+		TotalTokens:      resp.Usage.TotalTokens,
+	}
+
+	if len(resp.Choices) == 0 || len(resp.Choices[0].Message.ToolCalls) == 0 {
+		return nil, usage, fmt.Errorf("extract: no %s call in the reply", extractFunction)
+	}
+
+	var arguments extractArguments
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.ToolCalls[0].Function.Arguments), &arguments); err != nil {
+		return nil, usage, fmt.Errorf("extract: %w", err)
+	}
+
+	extraction := &types.Extraction{
+		Found:      arguments.Found,
+		Confidence: min(max(arguments.Confidence, 0), 1),
+	}
+	if !arguments.Found {
+		return extraction, usage, nil
+	}
+
+	extraction.RecordID = arguments.RecordID
+	extraction.Field = arguments.Field
+	extraction.Value = arguments.Value
+	extraction.Verified = verifyExtraction(extraction, sources)
+
+	return extraction, usage, nil
+}
+
+// verifyExtraction reports whether the value is the field of the cited
+// source. Only a field the source does not have is looked for in its whole
+// text instead. Only the letters and digits are compared, so "T 8324 AP"
+// matches "T8324AP" and "108.585" matches "108585".
+func verifyExtraction(extraction *types.Extraction, sources []types.Source) bool {
+	value := normalizeValue(extraction.Value)
+	if value == "" {
+		return false
+	}
+
+	for _, source := range sources {
+		if source.ID != extraction.RecordID || !source.Used {
+			continue
+		}
+
+		if field, ok := source.Fields[extraction.Field]; ok {
+			return normalizeValue(field) == value
+		}
+
+		return strings.Contains(normalizeValue(source.Text), value)
+	}
+
+	return false
+}
+
+func normalizeValue(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, value)
+}
+
+// answerExtraction sets the extraction as the answer of the response, the
+// not found wording of the prompt when no record answers.
+func answerExtraction(p *prompt.Prompt, response *types.SearchResponse, extraction *types.Extraction) {
+	response.Extraction = extraction
+	response.Answer = extraction.Value
+	if !extraction.Found {
+		response.Answer = p.NotFound()
+	}
+}
+
+// Extract answers a query like Ask with the value of one field of one of the
+// records, checked against the record it cites.
+func (u *searchUsecase) Extract(ctx context.Context, query string, records []types.StringAndRelatedness, tokenBudget int) (*types.Extraction, error) {
+	sections := recordSections(records)
+	sources := make([]types.Source, 0, len(records))
+	for i, record := range records {
+		sections[i].ID = hitKey(record)
+		sources = append(sources, types.Source{ID: sections[i].ID, Text: record.Text})
+	}
+
+	message := query
+	if len(records) > 0 {
+		// the default prompt always renders
+		packed, _ := u.queryMessage(prompt.Default(), prompt.Data{Question: query}, sections, tokenBudget)
+		message = packed.text
+
+		for i := range sources[:packed.used] {
+			sources[i].Used = true
+		}
+	}
+
+	extraction, _, err := u.extract(ctx, []openai.ChatCompletionMessage{{Role: roleUser, Content: message}}, sources)
+
+	return extraction, err
+}
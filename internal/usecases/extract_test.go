@@ -0,0 +1,155 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/pkg/logger"
+)
+
+// newToolClient returns a client calling the answer function with the
+// arguments and recording the requests.
+func newToolClient(t *testing.T, requests *[]openai.ChatCompletionRequest, arguments extractArguments) openai.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request openai.ChatCompletionRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		*requests = append(*requests, request)
+
+		data, err := json.Marshal(arguments)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{{
+				Message: openai.ChatCompletionMessage{
+					Role: roleAssistant,
+					ToolCalls: []openai.ToolCall{{
+						ID:       "call_1",
+						Type:     openai.ToolTypeFunction,
+						Function: openai.FunctionCall{Name: extractFunction, Arguments: string(data)},
+					}},
+				},
+			}},
+			Usage: openai.Usage{PromptTokens: 40, CompletionTokens: 10, TotalTokens: 50},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	config := openai.DefaultConfig("test")
+	config.BaseURL = server.URL + "/v1"
+
+	return *openai.NewClientWithConfig(config)
+}
+
+func TestSearch_Extract(t *testing.T) {
+	type test struct {
+		arguments      extractArguments
+		wantAnswer     string
+		wantExtraction *types.Extraction
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a value of the cited record, When extracting, Return it verified": func(t *testing.T) test {
+			return test{
+				arguments:  extractArguments{Found: true, RecordID: "1", Field: "odometer", Value: "108585", Confidence: 0.9},
+				wantAnswer: "108585",
+				wantExtraction: &types.Extraction{
+					Found: true, RecordID: "1", Field: "odometer", Value: "108585", Confidence: 0.9, Verified: true,
+				},
+			}
+		},
+		"Given a value missing from the cited record, When extracting, Return it unverified": func(t *testing.T) test {
+			return test{
+				arguments:  extractArguments{Found: true, RecordID: "1", Field: "odometer", Value: "99000", Confidence: 1.5},
+				wantAnswer: "99000",
+				wantExtraction: &types.Extraction{
+					Found: true, RecordID: "1", Field: "odometer", Value: "99000", Confidence: 1,
+				},
+			}
+		},
+		"Given no answer in the records, When extracting, Return the not found wording": func(t *testing.T) test {
+			return test{
+				arguments:      extractArguments{Confidence: 0.8},
+				wantAnswer:     prompt.Default().NotFound(),
+				wantExtraction: &types.Extraction{Confidence: 0.8},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			l, err := logger.NewLogger()
+			assert.NoError(t, err)
+
+			var requests []openai.ChatCompletionRequest
+			u := &searchUsecase{
+				client:        newToolClient(t, &requests, tt.arguments),
+				embeddingRepo: fakeScopeRepo{},
+				retrievers:    Retrievers{MethodPostgresql: &recordingRetriever{}},
+				defaultMethod: MethodPostgresql,
+				schemas:       schema.NewLoader(t.TempDir()),
+				prompts:       prompt.NewLoader(t.TempDir()),
+				logger:        l,
+			}
+
+			got, err := u.Search(context.Background(), types.SearchRequest{Query: "odometer B1207KDZ?", Mode: AnswerExtract})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAnswer, got.Answer)
+			assert.Equal(t, tt.wantExtraction, got.Extraction)
+
+			assert.Len(t, requests, 1)
+			assert.Contains(t, requests[0].Messages[len(requests[0].Messages)-1].Content, "Prompt section 1:")
+			assert.Equal(t, extractFunction, requests[0].Tools[0].Function.Name)
+		})
+	}
+}
+
+func TestSearch_UnknownMode(t *testing.T) {
+	u := &searchUsecase{
+		retrievers:    Retrievers{MethodPostgresql: &recordingRetriever{}},
+		defaultMethod: MethodPostgresql,
+	}
+
+	_, err := u.Search(context.Background(), types.SearchRequest{Query: "odometer?", Mode: "table"})
+
+	assert.ErrorIs(t, err, ErrUnknownMode)
+}
+
+func TestVerifyExtraction(t *testing.T) {
+	sources := []types.Source{
+		{
+			ID:   "1",
+			Text: "nopol: T 8324 AP; tahun: 2022; odometer: 108.585",
+			Fields: map[string]string{
+				"nopol":    "T 8324 AP",
+				"tahun":    "2022",
+				"odometer": "108.585",
+			},
+			Used: true,
+		},
+		{ID: "2", Text: "nopol: B1207KDZ", Used: false},
+		{ID: "3", Text: "nopol: D1167AGX; odometer: 139396", Used: true},
+	}
+
+	assert.True(t, verifyExtraction(&types.Extraction{RecordID: "1", Field: "nopol", Value: "T8324AP"}, sources))
+	assert.True(t, verifyExtraction(&types.Extraction{RecordID: "1", Field: "km", Value: "108585"}, sources))
+	assert.True(t, verifyExtraction(&types.Extraction{RecordID: "3", Field: "odometer", Value: "139396"}, sources))
+	// a value of another field of the record
+	assert.False(t, verifyExtraction(&types.Extraction{RecordID: "1", Field: "odometer", Value: "2022"}, sources))
+	assert.False(t, verifyExtraction(&types.Extraction{RecordID: "1", Field: "odometer", Value: "1"}, sources))
+	assert.False(t, verifyExtraction(&types.Extraction{RecordID: "1", Field: "nopol", Value: "B1207KDZ"}, sources))
+	assert.False(t, verifyExtraction(&types.Extraction{RecordID: "2", Field: "nopol", Value: "B1207KDZ"}, sources))
+	assert.False(t, verifyExtraction(&types.Extraction{RecordID: "3", Field: "nopol", Value: "T8324AP"}, sources))
+	assert.False(t, verifyExtraction(&types.Extraction{RecordID: "1", Field: "nopol", Value: " - "}, sources))
+}
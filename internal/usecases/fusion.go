@@ -0,0 +1,188 @@
+package usecases
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/yonisaka/similarity/internal/types"
+)
+
+const (
+	// FusionRRF scores a hit by the sum of weight / (k + rank) over its lists.
+	FusionRRF = "rrf"
+	// FusionWeighted scores a hit by the weighted sum of its min-max
+	// normalized scores.
+	FusionWeighted = "weighted"
+
+	// sourceVector and sourceKeyword name the ranked lists of every backend.
+	sourceVector  = "vector"
+	sourceKeyword = "keyword"
+
+	defaultRRFK       = 60
+	defaultCandidates = 10
+)
+
+// FusionConfig tunes how the ranked lists of a backend are merged.
+type FusionConfig struct {
+	// Method is FusionRRF or FusionWeighted.
+	Method string
+	// RRFK dampens the weight of the top ranks in RRF, 60 in the literature.
+	RRFK float64
+	// Weights of every source, 1 when missing.
+	Weights map[string]float64
+	// Candidates is how many hits every list fetches.
+	Candidates int
+	// TopN is how many fused hits are kept.
+	TopN int
+}
+
+func (c FusionConfig) withDefaults() FusionConfig {
+	if c.Method == "" {
+		c.Method = FusionRRF
+	}
+
+	if c.RRFK <= 0 {
+		c.RRFK = defaultRRFK
+	}
+
+	if c.Candidates <= 0 {
+		c.Candidates = defaultCandidates
+	}
+
+	if c.TopN <= 0 {
+		c.TopN = topN
+	}
+
+	return c
+}
+
+// Validate checks the fusion method and weights.
+func (c FusionConfig) Validate() error {
+	switch c.Method {
+	case "", FusionRRF, FusionWeighted:
+	default:
+		return fmt.Errorf("unknown fusion method %q", c.Method)
+	}
+
+	for source, weight := range c.Weights {
+		if weight < 0 || math.IsNaN(weight) {
+			return fmt.Errorf("weight of %s must not be negative", source)
+		}
+	}
+
+	return nil
+}
+
+func (c FusionConfig) weight(source string) float64 {
+	if weight, ok := c.Weights[source]; ok {
+		return weight
+	}
+
+	return 1
+}
+
+// rankedList is the hits of one source, best first.
+type rankedList struct {
+	source string
+	hits   []types.StringAndRelatedness
+}
+
+// fuse merges the lists into one ranking. A hit found by several sources
+// keeps its rank and score in each of them. Relatedness becomes the fused
+// score.
+func fuse(config FusionConfig, lists ...rankedList) []types.StringAndRelatedness {
+	config = config.withDefaults()
+
+	var fused []*types.StringAndRelatedness
+	byKey := make(map[string]*types.StringAndRelatedness)
+
+	for _, list := range lists {
+		normalized := normalizeScores(list.hits)
+		weight := config.weight(list.source)
+
+		for i, hit := range list.hits {
+			key := hitKey(hit)
+
+			merged, ok := byKey[key]
+			if !ok {
+				copied := hit
+				copied.Relatedness = 0
+				copied.Ranks = make(map[string]int)
+				copied.Scores = make(map[string]float64)
+				merged = &copied
+				byKey[key] = merged
+				fused = append(fused, merged)
+			}
+
+			// a source listing the same hit twice counts its best rank only
+			if _, seen := merged.Ranks[list.source]; seen {
+				continue
+			}
+
+			rank := i + 1
+			merged.Ranks[list.source] = rank
+			merged.Scores[list.source] = hit.Relatedness
+
+			switch config.Method {
+			case FusionWeighted:
+				merged.Relatedness += weight * normalized[i]
+			default:
+				merged.Relatedness += weight / (config.RRFK + float64(rank))
+			}
+		}
+	}
+
+	// stable, so ties keep the order of the first lists
+	sort.SliceStable(fused, func(i, j int) bool {
+		return fused[i].Relatedness > fused[j].Relatedness
+	})
+
+	if len(fused) > config.TopN {
+		fused = fused[:config.TopN]
+	}
+
+	results := make([]types.StringAndRelatedness, 0, len(fused))
+	for _, hit := range fused {
+		results = append(results, *hit)
+	}
+
+	return results
+}
+
+// normalizeScores scales the scores of the hits to [0, 1]. Hits of a list
+// without distinct scores, like keyword matches, all get 1.
+func normalizeScores(hits []types.StringAndRelatedness) []float64 {
+	normalized := make([]float64, len(hits))
+	if len(hits) == 0 {
+		return normalized
+	}
+
+	lowest, highest := hits[0].Relatedness, hits[0].Relatedness
+	for _, hit := range hits {
+		lowest = math.Min(lowest, hit.Relatedness)
+		highest = math.Max(highest, hit.Relatedness)
+	}
+
+	for i, hit := range hits {
+		if highest == lowest {
+			normalized[i] = 1
+			continue
+		}
+
+		normalized[i] = (hit.Relatedness - lowest) / (highest - lowest)
+	}
+
+	return normalized
+}
+
+// hitKey identifies a record across sources, its Key or else its Postgres
+// row ID.
+func hitKey(hit types.StringAndRelatedness) string {
+	if hit.Key != "" {
+		return hit.Key
+	}
+
+	return strconv.FormatUint(uint64(hit.ID), 10)
+}
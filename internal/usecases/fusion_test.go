@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/types"
+)
+
+func hits(ids ...string) []types.StringAndRelatedness {
+	ret := make([]types.StringAndRelatedness, 0, len(ids))
+	for i, id := range ids {
+		ret = append(ret, types.StringAndRelatedness{
+			Key:         id,
+			Text:        id,
+			Relatedness: float64(len(ids) - i),
+		})
+	}
+
+	return ret
+}
+
+func keys(results []types.StringAndRelatedness) []string {
+	ret := make([]string, 0, len(results))
+	for _, result := range results {
+		ret = append(ret, hitKey(result))
+	}
+
+	return ret
+}
+
+func TestFuse(t *testing.T) {
+	type test struct {
+		config    FusionConfig
+		lists     []rankedList
+		wantOrder []string
+		wantRanks map[string]int
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given two lists, When fusing with RRF, Return hits found by both first": func(t *testing.T) test {
+			return test{
+				config: FusionConfig{Method: FusionRRF},
+				lists: []rankedList{
+					{source: sourceVector, hits: hits("a", "b", "c")},
+					{source: sourceKeyword, hits: hits("c", "d")},
+				},
+				wantOrder: []string{"c", "a", "b", "d"},
+				wantRanks: map[string]int{sourceVector: 3, sourceKeyword: 1},
+			}
+		},
+		"Given a heavier keyword weight, When fusing with RRF, Return keyword hits first": func(t *testing.T) test {
+			return test{
+				config: FusionConfig{Method: FusionRRF, Weights: map[string]float64{sourceKeyword: 2}},
+				lists: []rankedList{
+					{source: sourceVector, hits: hits("a", "b")},
+					{source: sourceKeyword, hits: hits("b", "c")},
+				},
+				wantOrder: []string{"b", "c", "a"},
+				wantRanks: map[string]int{sourceVector: 2, sourceKeyword: 1},
+			}
+		},
+		"Given weighted scores, When fusing, Return hits by normalized score": func(t *testing.T) test {
+			return test{
+				config: FusionConfig{Method: FusionWeighted, Weights: map[string]float64{sourceVector: 0.7, sourceKeyword: 0.3}},
+				lists: []rankedList{
+					{source: sourceVector, hits: hits("a", "b", "c")},
+					{source: sourceKeyword, hits: hits("b", "a")},
+				},
+				wantOrder: []string{"a", "b", "c"},
+				wantRanks: map[string]int{sourceVector: 1, sourceKeyword: 2},
+			}
+		},
+		"Given more hits than TopN, When fusing, Return TopN hits": func(t *testing.T) test {
+			return test{
+				config: FusionConfig{TopN: 2},
+				lists: []rankedList{
+					{source: sourceVector, hits: hits("a", "b", "c")},
+				},
+				wantOrder: []string{"a", "b"},
+				wantRanks: map[string]int{sourceVector: 1},
+			}
+		},
+		"Given a duplicated hit in one list, When fusing, Return its best rank only": func(t *testing.T) test {
+			return test{
+				config: FusionConfig{},
+				lists: []rankedList{
+					{source: sourceKeyword, hits: hits("a", "b", "a")},
+				},
+				wantOrder: []string{"a", "b"},
+				wantRanks: map[string]int{sourceKeyword: 1},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			got := fuse(tt.config, tt.lists...)
+
+			assert.Equal(t, tt.wantOrder, keys(got))
+			assert.Equal(t, tt.wantRanks, got[0].Ranks)
+		})
+	}
+}
+
+func TestFusionConfig_Validate(t *testing.T) {
+	assert.NoError(t, FusionConfig{}.Validate())
+	assert.NoError(t, FusionConfig{Method: FusionWeighted, Weights: map[string]float64{sourceVector: 0.5}}.Validate())
+	assert.Error(t, FusionConfig{Method: "borda"}.Validate())
+	assert.Error(t, FusionConfig{Weights: map[string]float64{sourceKeyword: -1}}.Validate())
+}
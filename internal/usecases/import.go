@@ -1,309 +1,251 @@
 package usecases
 
 import (
-	"bytes"
 	"context"
 	"crypto/md5"
-	"encoding/csv"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
 	pb "github.com/qdrant/go-client/qdrant"
 	"github.com/yonisaka/similarity/internal/entities/repository"
-	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/pkg/elasticsearch"
+	"github.com/yonisaka/similarity/pkg/embedder"
+	"github.com/yonisaka/similarity/pkg/tabular"
 	"io"
-	"io/ioutil"
-	"log"
 	"mime/multipart"
-	"net/http"
 	"os"
-	"strings"
 )
 
-const (
-	maxTokens = 2000
-)
-
-var errGetEmbedding = errors.New("error getting embedding")
+// Import embeds every row of the file and stores it under the scope, the file
+// name when empty. Rows are sent in batches within the configured rate limits. The
+// number of embeddings already stored for the scope is the checkpoint, so an
+// interrupted import resumes where it stopped on the next call.
+func (u *importUsecase) Import(ctx context.Context, fileHeader *multipart.FileHeader, filename, scope string) error {
+	job, rows, err := u.readImport(ctx, fileHeader, filename, scope)
+	if err != nil {
+		return err
+	}
 
-func (u *importUsecase) Import(ctx context.Context, fileHeader *multipart.FileHeader, filename string) error {
-	var combined []string
-	var rawVectors []string
-	var err error
+	return u.runImport(ctx, job, rows, nil)
+}
 
+// readImport registers the scope, reads the rows to import and returns a job
+// describing them.
+func (u *importUsecase) readImport(
+	ctx context.Context,
+	fileHeader *multipart.FileHeader,
+	filename, scope string,
+) (*repository.ImportJob, *importRows, error) {
 	if fileHeader != nil {
-		combined, rawVectors, err = u.ReadUploadedCSV(fileHeader)
 		filename = fileHeader.Filename
-	} else {
-		combined, rawVectors, err = u.ReadCSV(filename)
-	}
-	if err != nil {
-		return err
 	}
 
-	offset, err := u.embeddingRepo.CountEmbeddingByScope(ctx, filename)
-	if err != nil {
-		return err
+	if scope == "" {
+		scope = filename
 	}
 
-	u.logger.Info(fmt.Sprintf("offset: %d", offset))
-
-	tokens := 0
-	countRequest := 0
-	for i, rawVector := range rawVectors {
-		if countRequest > 1 {
-			break
-		}
+	if err := ValidateScope(scope); err != nil {
+		return nil, nil, err
+	}
 
-		if i < offset {
-			continue
-		}
+	var rows *importRows
+	var err error
 
-		if tokens > maxTokens {
-			break
-		}
+	if fileHeader != nil {
+		rows, err = u.readUploadedFile(fileHeader, scope)
+	} else {
+		rows, err = u.readDataFile(filename, scope)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
 
-		// Get the embedding for the combine
-		embedding, nTokens, err := u.GetEmbedding(rawVector)
-		if err != nil {
-			if errors.Is(err, errGetEmbedding) {
-				continue
-			}
-			return err
-		}
-		countRequest += 1
+	if err := u.embeddingRepo.CreateScope(ctx, &repository.Scope{Name: scope}); err != nil &&
+		!errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, nil, err
+	}
 
-		// Save the embedding to the database
-		if err := u.embeddingRepo.CreateEmbedding(ctx, &repository.Embedding{
-			Scope:     filename,
-			Combined:  combined[i],
-			Embedding: embedding,
-			NTokens:   nTokens,
-		}); err != nil {
-			return err
-		}
+	job := &repository.ImportJob{
+		Scope:     scope,
+		Status:    repository.ImportJobPending,
+		RowsTotal: len(rows.rawVectors) + len(rows.skipped),
+	}
 
-		tokens += nTokens
+	// empty rows are never stored, drop them so the checkpoint stays aligned
+	rows.dropEmpty()
 
-		u.logger.Info(fmt.Sprintf("usage tokens: %d", tokens))
-	}
+	job.RowsSkipped = len(rows.skipped)
+	job.SkippedLines = rows.skipped
 
-	return nil
+	return job, rows, nil
 }
 
-func (u *importUsecase) ReadUploadedCSV(fileHeader *multipart.FileHeader) ([]string, []string, error) {
-	// Open the uploaded file
-	csvFile, err := fileHeader.Open()
+// runImport embeds and stores the rows of the job, keeping its counters up to
+// date. onBatch, when set, is called after every batch.
+func (u *importUsecase) runImport(
+	ctx context.Context,
+	job *repository.ImportJob,
+	rows *importRows,
+	onBatch func(job *repository.ImportJob),
+) error {
+	combined, rawVectors := rows.combined, rows.rawVectors
+
+	offset, err := u.embeddingRepo.CountEmbeddingByScope(ctx, job.Scope)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
-	defer csvFile.Close()
-
-	// Parse the CSV file
-	reader := csv.NewReader(csvFile)
-	reader.Comma = ';'
-	reader.LazyQuotes = true
 
-	// Assuming the first line is headers
-	headers, err := reader.Read()
-	if err != nil {
-		return nil, nil, err
+	if offset > len(rawVectors) {
+		offset = len(rawVectors)
 	}
+	// stored by an earlier interrupted run, neither embedded again nor skipped
+	job.RowsResumed = offset
+
+	u.logger.Info(fmt.Sprintf("offset: %d of %d rows", offset, len(rawVectors)))
 
-	var records [][]string
-	for {
-		record, err := reader.Read()
+	for _, batch := range batchRows(rawVectors, offset, u.config.BatchSize, u.config.BatchTokens) {
+		stored, err := u.importBatch(ctx, job, batch, combined, rawVectors)
 		if err != nil {
-			if errors.Is(err, csv.ErrFieldCount) {
-				log.Println("Warning: Skipping a malformed line")
-				continue
-			} else if err == io.EOF {
-				// End of file is reached
-				break
-			}
-
-			break
+			job.RowsFailed += len(batch) - stored
+			return err
 		}
 
-		records = append(records, record)
-	}
+		u.logger.Info(fmt.Sprintf("batch of %d rows, usage tokens: %d", len(batch), job.TokensUsed))
 
-	var combined []string
-	var rawVectors []string
-	for _, record := range records {
-		combine := ""
-		rawVector := ""
-		for i, field := range record {
-			if field == "" {
-				continue
-			}
-
-			if i == 0 {
-				combine = fmt.Sprintf("%s: %s", headers[i], field)
-				rawVector = field
-			} else {
-				combine = fmt.Sprintf("%s; %s: %s", combine, headers[i], field)
-				rawVector = fmt.Sprintf("%s %s", rawVector, field)
-			}
+		if onBatch != nil {
+			onBatch(job)
 		}
-
-		combined = append(combined, combine)
-		rawVectors = append(rawVectors, rawVector)
 	}
 
-	return combined, rawVectors, nil
+	return nil
 }
 
-func (u *importUsecase) ReadCSV(filename string) ([]string, []string, error) {
-	// Open the CSV file
-	file, err := os.Open(fmt.Sprintf("../data/%s", filename))
+// importBatch embeds one batch in a single request and stores every row,
+// returning the number of rows stored.
+func (u *importUsecase) importBatch(
+	ctx context.Context,
+	job *repository.ImportJob,
+	batch []int,
+	combined, rawVectors []string,
+) (int, error) {
+	inputs := make([]string, 0, len(batch))
+	for _, i := range batch {
+		inputs = append(inputs, rawVectors[i])
+	}
+
+	// Get the embeddings for the whole batch in one request
+	embeddings, nTokens, err := embedWithRetry(
+		ctx, u.embedder, u.limiter, u.logger, inputs, u.config.MaxRetries, u.config.RetryBackoff,
+	)
 	if err != nil {
-		log.Fatal(err)
+		return 0, err
 	}
-	defer file.Close()
+	job.TokensUsed += nTokens
 
-	// Create a new CSV reader reading from the opened file
-	reader := csv.NewReader(file)
-	reader.Comma = ';'
-	reader.LazyQuotes = true
-
-	// Assuming the first line is headers
-	headers, err := reader.Read()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// Read the rest of the data
-	var records [][]string
-	for {
-		record, err := reader.Read()
-		if err != nil {
-			if errors.Is(err, csv.ErrFieldCount) {
-				log.Println("Warning: Skipping a malformed line")
-				continue
-			} else if err == io.EOF {
-				// End of file is reached
-				break
-			}
-
-			break
+	rowTokens := splitTokens(nTokens, inputs)
+	for j, i := range batch {
+		if embeddings[j] == nil {
+			return j, fmt.Errorf("row %d: %w", i, embedder.ErrEmptyEmbedding)
 		}
 
-		records = append(records, record)
-	}
-
-	var combined []string
-	var rawVectors []string
-	for _, record := range records {
-		combine := ""
-		rawVector := ""
-		for i, field := range record {
-			if field == "" {
-				continue
-			}
-
-			if i == 0 {
-				combine = fmt.Sprintf("%s: %s", headers[i], field)
-				rawVector = field
-			} else {
-				combine = fmt.Sprintf("%s; %s: %s", combine, headers[i], field)
-				rawVector = fmt.Sprintf("%s %s", rawVector, field)
-			}
+		// Save the embedding to the database
+		if err := u.embeddingRepo.CreateEmbedding(ctx, &repository.Embedding{
+			Scope:     job.Scope,
+			Combined:  combined[i],
+			Embedding: embeddings[j],
+			NTokens:   rowTokens[j],
+		}); err != nil {
+			return j, err
 		}
 
-		combined = append(combined, combine)
-		rawVectors = append(rawVectors, rawVector)
+		job.RowsEmbedded++
 	}
 
-	return combined, rawVectors, nil
+	return len(batch), nil
 }
 
-func (u *importUsecase) GetEmbedding(query string) ([]float64, int, error) {
-	// Construct the request body
-	requestBody, err := json.Marshal(map[string]string{
-		"input": query,
-		"model": os.Getenv("OPENAI_EMBEDDING_MODEL"),
-	})
+// ReadUploadedFile reads an uploaded CSV, JSON, JSON Lines or XLSX file and
+// returns the combined text to store and the text to embed of each row.
+func (u *importUsecase) ReadUploadedFile(fileHeader *multipart.FileHeader) ([]string, []string, error) {
+	rows, err := u.readUploadedFile(fileHeader, fileHeader.Filename)
 	if err != nil {
-		u.logger.Warn(fmt.Sprintf("Error occurred while marshaling. %s", err))
-		return nil, 0, err
+		return nil, nil, err
 	}
 
-	// Create an HTTP request
-	req, err := http.NewRequest("POST", embeddingURL, bytes.NewBuffer(requestBody))
+	return rows.combined, rows.rawVectors, nil
+}
+
+// ReadFile reads a file of the data directory like ReadUploadedFile.
+func (u *importUsecase) ReadFile(filename string) ([]string, []string, error) {
+	rows, err := u.readDataFile(filename, filename)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", os.Getenv("OPENAI_API_KEY")))
+	return rows.combined, rows.rawVectors, nil
+}
 
-	// Make the request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+func (u *importUsecase) readUploadedFile(fileHeader *multipart.FileHeader, scope string) (*importRows, error) {
+	// Open the uploaded file
+	file, err := fileHeader.Open()
 	if err != nil {
-		u.logger.Warn(fmt.Sprintf("Error occurred while making HTTP request. %s", err))
-		return nil, 0, err
+		return nil, err
 	}
-	defer resp.Body.Close()
+	defer file.Close()
 
-	// Read the response
-	body, err := ioutil.ReadAll(resp.Body)
+	return u.readSource(file, fileHeader.Filename, scope, fileHeader.Header.Get("Content-Type"))
+}
+
+func (u *importUsecase) readDataFile(filename, scope string) (*importRows, error) {
+	// Open the data file
+	file, err := os.Open(fmt.Sprintf("../data/%s", filename))
 	if err != nil {
-		log.Fatalf("Error occurred while reading response body. %s", err)
+		return nil, err
 	}
+	defer file.Close()
 
-	// Unmarshal the response into the EmbeddingResponse struct
-	var embeddingResponse *types.EmbeddingResponse
-	if err := json.Unmarshal(body, &embeddingResponse); err != nil {
-		return nil, 0, err
-	}
+	return u.readSource(file, filename, scope, "")
+}
 
-	// Return the embedding
-	if len(embeddingResponse.Data) > 0 {
-		return embeddingResponse.Data[0].Embedding, embeddingResponse.Usage.PromptTokens, nil
+// readSource reads the file with the reader of its format and maps every
+// record through the schema of the scope.
+func (u *importUsecase) readSource(r io.Reader, filename, scope, contentType string) (*importRows, error) {
+	format, err := tabular.DetectFormat(filename, contentType)
+	if err != nil {
+		return nil, err
 	}
 
-	var errorResponse *types.ErrorResponse
-	if err := json.Unmarshal(body, &errorResponse); err != nil {
-		return nil, 0, err
+	s, err := u.schemas.Load(scope)
+	if err != nil {
+		return nil, err
 	}
 
-	if errorResponse != nil {
-		return nil, 0, errors.New(errorResponse.Error.Message)
+	reader, err := tabular.NewReader(format, s.CSV)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, 0, errGetEmbedding
-}
-
-func (u *importUsecase) MigrateToQdrant(ctx context.Context) error {
-	records, err := u.embeddingRepo.ListEmbeddingByScope(ctx, "sample_lelang.csv")
+	table, err := reader.Read(r)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := u.qdrantClient.DeleteCollection(u.qdrantClient.GetCollectionName()); err != nil {
-		return err
+	rows := &importRows{}
+	for _, skipped := range table.Skipped {
+		u.logger.Warn(fmt.Sprintf("skipping line %d of %s: %s", skipped.Line, scope, skipped.Reason))
+		rows.skip(skipped.Line, skipped.Reason)
 	}
 
-	if err := u.qdrantClient.CreateCollection(
-		u.qdrantClient.GetCollectionName(),
-		u.qdrantClient.GetVectorSize(),
-	); err != nil {
-		return err
+	for i, record := range table.Records {
+		row := s.Apply(table.Headers, record)
+		rows.add(table.Lines[i], row.Combined, row.Embed)
 	}
 
-	var points []*pb.PointStruct
-	for _, record := range records {
-		point := u.buildPoint(record.Combined, convertToFloat32(record.Embedding))
-		points = append(points, point)
-	}
-
-	return u.qdrantClient.CreatePoints(points)
+	return rows, nil
 }
 
-func (u *importUsecase) buildPoint(combined string, embedding []float32) *pb.PointStruct {
+func (u *importUsecase) buildPoint(combined string, row schema.Row, embedding []float32) *pb.PointStruct {
 	point := &pb.PointStruct{}
 
 	point.Id = &pb.PointId{
@@ -324,43 +266,87 @@ func (u *importUsecase) buildPoint(combined string, embedding []float32) *pb.Poi
 	// payload
 	ret := make(map[string]*pb.Value)
 	ret["combined"] = &pb.Value{Kind: &pb.Value_StringValue{StringValue: combined}}
-	ret["raw"] = &pb.Value{Kind: &pb.Value_StringValue{StringValue: getRawVector(combined)}}
+	ret["raw"] = &pb.Value{Kind: &pb.Value_StringValue{StringValue: row.Keywords}}
+
+	fields := make(map[string]*pb.Value, len(row.Fields))
+	for name, value := range row.Fields {
+		fields[name] = &pb.Value{Kind: &pb.Value_StringValue{StringValue: value}}
+	}
+	ret["fields"] = &pb.Value{Kind: &pb.Value_StructValue{StructValue: &pb.Struct{Fields: fields}}}
 	point.Payload = ret
 	return point
 }
 
-func (u *importUsecase) MigrateToElasticsearch(ctx context.Context) error {
-	records, err := u.embeddingRepo.ListEmbeddingByScope(ctx, "sample_lelang.csv")
+// MigrateToElasticsearch indexes every record of the scope into its
+// Elasticsearch index through the bulk API, creating the index when missing.
+// Documents are keyed by the md5 of their combined text, so running it again
+// overwrites them instead of adding duplicates.
+func (u *importUsecase) MigrateToElasticsearch(ctx context.Context, scope string) error {
+	records, err := u.embeddingRepo.ListEmbeddingByScope(ctx, scope)
 	if err != nil {
 		return err
 	}
-	// Set the index
-	u.esClient.SetIndex("research")
 
-	// Delete the index
-	if err := u.esClient.DeleteIndex(); err != nil {
+	s, err := u.schemas.Load(scope)
+	if err != nil {
 		return err
 	}
 
-	// Create the index
-	if err := u.esClient.CreateIndex(); err != nil {
+	// The index of the scope
+	index := scopeIndex(&u.esClient, scope)
+
+	exists, err := index.IndexExists(ctx)
+	if err != nil {
 		return err
 	}
 
-	for _, record := range records {
-		document := map[string]interface{}{
-			"combined":  record.Combined,
-			"raw":       getRawVector(record.Combined),
-			"embedding": record.Embedding,
-		}
-
-		if err := u.esClient.IndexDocument(document); err != nil {
+	// Create the index
+	if !exists {
+		if err := index.CreateIndex(); err != nil {
 			return err
 		}
 	}
 
+	documents := make([]elasticsearch.BulkDocument, 0, len(records))
+	for _, record := range records {
+		row := s.Parse(record.Combined)
+		documents = append(documents, elasticsearch.BulkDocument{
+			ID: md5str(record.Combined),
+			Source: map[string]interface{}{
+				"combined":  record.Combined,
+				"raw":       row.Keywords,
+				"fields":    row.Fields,
+				"embedding": record.Embedding,
+			},
+		})
+	}
+
+	summary, err := index.BulkIndex(ctx, documents, elasticsearch.BulkOptions{
+		FlushDocuments: u.config.BulkSize,
+		FlushBytes:     u.config.BulkBytes,
+	})
+	if err != nil {
+		return err
+	}
+
+	u.logger.Info(fmt.Sprintf(
+		"indexed scope %s into %s: %d indexed, %d failed in %d requests",
+		scope, index.GetIndex(), summary.Indexed, summary.Failed, summary.Requests,
+	))
+
+	for _, itemErr := range summary.Errors {
+		u.logger.Warn(fmt.Sprintf("document %s: %s: %s", itemErr.ID, itemErr.Type, itemErr.Reason))
+	}
+
+	if summary.Failed > 0 {
+		first := summary.Errors[0]
+		return fmt.Errorf("%d of %d documents failed, first %s: %s: %s",
+			summary.Failed, len(documents), first.ID, first.Type, first.Reason)
+	}
+
 	return nil
 }
+
 func md5str(s string) string {
 	h := md5.New()
 	h.Write([]byte(s))
@@ -374,84 +360,3 @@ func convertToFloat32(embedding []float64) []float32 {
 	}
 	return ret
 }
-
-func getRawVector(combined string) string {
-	// note value may contain duplicate words to another sample data
-	// and will reduce the accuracy of the embedding
-	indexNote1 := strings.Index(combined, "note1:")
-	if indexNote1 > 0 {
-		combined = combined[:indexNote1]
-	}
-
-	raw := strings.ReplaceAll(combined, "stock_no:", "")
-	raw = strings.ReplaceAll(raw, "id_lelang:", "")
-	raw = strings.ReplaceAll(raw, "cabang:", "")
-	raw = strings.ReplaceAll(raw, "tanggal:", "")
-	raw = strings.ReplaceAll(raw, "bulan:", "")
-	raw = strings.ReplaceAll(raw, "jalur:", "")
-	raw = strings.ReplaceAll(raw, "lot:", "")
-	raw = strings.ReplaceAll(raw, "seller_no:", "")
-	raw = strings.ReplaceAll(raw, "seller_name:", "")
-	raw = strings.ReplaceAll(raw, "seller_kategori:", "")
-	raw = strings.ReplaceAll(raw, "npwp_penjual:", "")
-	raw = strings.ReplaceAll(raw, "alamat_penjual:", "")
-	raw = strings.ReplaceAll(raw, "nomor_kontrak:", "")
-	raw = strings.ReplaceAll(raw, "nama:", "")
-	raw = strings.ReplaceAll(raw, "plat_no:", "")
-	raw = strings.ReplaceAll(raw, "pabrikan:", "")
-	raw = strings.ReplaceAll(raw, "model:", "")
-	raw = strings.ReplaceAll(raw, "type:", "")
-	raw = strings.ReplaceAll(raw, "tahun:", "")
-	raw = strings.ReplaceAll(raw, "transmisi:", "")
-	raw = strings.ReplaceAll(raw, "warna:", "")
-	raw = strings.ReplaceAll(raw, "harga_awal:", "")
-	raw = strings.ReplaceAll(raw, "harga_terbentuk:", "")
-	raw = strings.ReplaceAll(raw, "dpp:", "")
-	raw = strings.ReplaceAll(raw, "ppn_1,1%:", "")
-	raw = strings.ReplaceAll(raw, "status:", "")
-	raw = strings.ReplaceAll(raw, "segment:", "")
-	raw = strings.ReplaceAll(raw, "kapasitas_mesin:", "")
-	raw = strings.ReplaceAll(raw, "tipe_bahan_bakar:", "")
-	raw = strings.ReplaceAll(raw, "odometer:", "")
-	raw = strings.ReplaceAll(raw, " grade:", "")
-	raw = strings.ReplaceAll(raw, "no_mesin:", "")
-	raw = strings.ReplaceAll(raw, "no_rangka:", "")
-	raw = strings.ReplaceAll(raw, "status_bpkb:", "")
-	raw = strings.ReplaceAll(raw, "no_bpkb:", "")
-	raw = strings.ReplaceAll(raw, "nama_bpkb:", "")
-	raw = strings.ReplaceAll(raw, "status_stnk:", "")
-	raw = strings.ReplaceAll(raw, "no_stnk:", "")
-	raw = strings.ReplaceAll(raw, "nama_stnk:", "")
-	raw = strings.ReplaceAll(raw, "stnk_exp_date:", "")
-	raw = strings.ReplaceAll(raw, "faktur:", "")
-	raw = strings.ReplaceAll(raw, "kwitansi_blank:", "")
-	raw = strings.ReplaceAll(raw, "fc_ktp:", "")
-	raw = strings.ReplaceAll(raw, "form_a:", "")
-	raw = strings.ReplaceAll(raw, "status_keur:", "")
-	raw = strings.ReplaceAll(raw, "masa_berlaku_keur:", "")
-	raw = strings.ReplaceAll(raw, "nopol_nipl:", "")
-	raw = strings.ReplaceAll(raw, "no_pembeli:", "")
-	raw = strings.ReplaceAll(raw, "eksterior_grade:", "")
-	raw = strings.ReplaceAll(raw, "interior_grade:", "")
-	raw = strings.ReplaceAll(raw, "mesin_grade:", "")
-	raw = strings.ReplaceAll(raw, "note1:", "")
-	raw = strings.ReplaceAll(raw, "note2:", "")
-	raw = strings.ReplaceAll(raw, "rongsokan:", "")
-	raw = strings.ReplaceAll(raw, "time_closed:", "")
-	raw = strings.ReplaceAll(raw, "va_payment:", "")
-	raw = strings.ReplaceAll(raw, "nomor_telepon_penjual:", "")
-	raw = strings.ReplaceAll(raw, "nama_pembeli:", "")
-	raw = strings.ReplaceAll(raw, "alamat_pembeli:", "")
-	raw = strings.ReplaceAll(raw, "nomor_handphone:", "")
-	raw = strings.ReplaceAll(raw, "no_ktp_atau_passport:", "")
-	raw = strings.ReplaceAll(raw, "npwp_pembeli:", "")
-	raw = strings.ReplaceAll(raw, "eksterior_grade:", "")
-	raw = strings.ReplaceAll(raw, "interior_grade:", "")
-	raw = strings.ReplaceAll(raw, "mesin_grade:", "")
-	raw = strings.ReplaceAll(raw, " ; ", "")
-	raw = strings.ReplaceAll(raw, "-; ", "")
-	raw = strings.ReplaceAll(raw, "null; ", "")
-	raw = strings.ReplaceAll(raw, "; ", "")
-
-	return raw
-}
@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"sync"
+	"time"
+
+	"github.com/yonisaka/similarity/internal/entities/repository"
+)
+
+// ErrImportJobFinished is returned when canceling a job that already ended.
+var ErrImportJobFinished = errors.New("import job already finished")
+
+// jobRegistry keeps the cancel function of every import job running in this process.
+type jobRegistry struct {
+	mu      sync.Mutex
+	cancels map[uint]context.CancelFunc
+}
+
+func newJobRegistry() *jobRegistry {
+	return &jobRegistry{
+		cancels: make(map[uint]context.CancelFunc),
+	}
+}
+
+func (r *jobRegistry) add(id uint, cancel context.CancelFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cancels[id] = cancel
+}
+
+func (r *jobRegistry) remove(id uint) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.cancels, id)
+}
+
+func (r *jobRegistry) cancel(id uint) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cancel, ok := r.cancels[id]
+	if ok {
+		cancel()
+	}
+
+	return ok
+}
+
+// StartImport reads the uploaded file, records a new import job and runs it
+// in the background. The returned job is a snapshot taken before it started.
+func (u *importUsecase) StartImport(ctx context.Context, fileHeader *multipart.FileHeader, scope string) (*repository.ImportJob, error) {
+	// the upload is removed once the request ends, so read it now
+	job, rows, err := u.readImport(ctx, fileHeader, "", scope)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Status = repository.ImportJobRunning
+	if err := u.importJobRepo.CreateImportJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	snapshot := *job
+
+	jobCtx, cancel := context.WithCancel(context.Background())
+	u.jobs.add(job.ID, cancel)
+
+	go func() {
+		defer cancel()
+		defer u.jobs.remove(job.ID)
+
+		err := u.runImport(jobCtx, job, rows, func(job *repository.ImportJob) {
+			if err := u.importJobRepo.UpdateImportJob(context.Background(), job); err != nil {
+				u.logger.Warn(fmt.Sprintf("failed to update import job %d: %s", job.ID, err))
+			}
+		})
+
+		u.finishImportJob(job, err)
+	}()
+
+	return &snapshot, nil
+}
+
+func (u *importUsecase) finishImportJob(job *repository.ImportJob, err error) {
+	now := time.Now()
+	job.FinishedAt = &now
+
+	switch {
+	case err == nil:
+		job.Status = repository.ImportJobCompleted
+	case errors.Is(err, context.Canceled):
+		job.Status = repository.ImportJobCanceled
+	default:
+		job.Status = repository.ImportJobFailed
+		job.Error = err.Error()
+		u.logger.Error(fmt.Sprintf("import job %d failed: %s", job.ID, err))
+	}
+
+	// the job context may be canceled already, use a fresh one to persist the result
+	if err := u.importJobRepo.UpdateImportJob(context.Background(), job); err != nil {
+		u.logger.Error(fmt.Sprintf("failed to finish import job %d: %s", job.ID, err))
+	}
+}
+
+// GetImportJob returns the progress of an import job.
+func (u *importUsecase) GetImportJob(ctx context.Context, id uint) (*repository.ImportJob, error) {
+	return u.importJobRepo.GetImportJob(ctx, id)
+}
+
+// CancelImportJob stops a running import job. Rows stored so far are kept,
+// so importing the same file again resumes from there.
+func (u *importUsecase) CancelImportJob(ctx context.Context, id uint) error {
+	if u.jobs.cancel(id) {
+		return nil
+	}
+
+	job, err := u.importJobRepo.GetImportJob(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if job.IsFinished() {
+		return ErrImportJobFinished
+	}
+
+	// the job is not running in this process anymore, e.g. after a restart
+	now := time.Now()
+	job.Status = repository.ImportJobCanceled
+	job.FinishedAt = &now
+
+	return u.importJobRepo.UpdateImportJob(ctx, job)
+}
@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"strings"
+
+	"github.com/yonisaka/similarity/internal/entities/repository"
+)
+
+// importRows holds the rows read from a source, mapped through the schema of
+// its scope, and the source lines left out.
+type importRows struct {
+	combined   []string
+	rawVectors []string
+	lines      []int
+	skipped    []repository.SkippedLine
+}
+
+func (r *importRows) add(line int, combined, rawVector string) {
+	r.combined = append(r.combined, combined)
+	r.rawVectors = append(r.rawVectors, rawVector)
+	r.lines = append(r.lines, line)
+}
+
+func (r *importRows) skip(line int, reason string) {
+	r.skipped = append(r.skipped, repository.SkippedLine{Line: line, Reason: reason})
+}
+
+// dropEmpty removes the rows without any text to embed.
+func (r *importRows) dropEmpty() {
+	kept := &importRows{skipped: r.skipped}
+	for i, rawVector := range r.rawVectors {
+		if strings.TrimSpace(rawVector) == "" {
+			kept.skip(r.lines[i], "no value to embed")
+			continue
+		}
+
+		kept.add(r.lines[i], r.combined[i], rawVector)
+	}
+
+	*r = *kept
+}
@@ -4,40 +4,135 @@ import (
 	"context"
 	"github.com/sashabaranov/go-openai"
 	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/schema"
 	"github.com/yonisaka/similarity/pkg/elasticsearch"
+	"github.com/yonisaka/similarity/pkg/embedder"
 	"github.com/yonisaka/similarity/pkg/logger"
 	"github.com/yonisaka/similarity/pkg/qdrant"
 	"mime/multipart"
+	"time"
 )
 
+const (
+	defaultBatchSize   = 100
+	defaultBatchTokens = 8000
+	defaultSyncBatch   = 256
+	defaultRetention   = time.Hour
+)
+
+// ImportConfig holds the tuning of the import use case.
+type ImportConfig struct {
+	// BatchSize is the maximum number of rows sent in one embeddings request,
+	// IMPORT_BATCH_SIZE.
+	BatchSize int
+	// BatchTokens is the maximum estimated number of tokens in one embeddings
+	// request, IMPORT_BATCH_TOKENS.
+	BatchTokens int
+	// RequestsPerMinute is the embeddings request quota,
+	// IMPORT_REQUESTS_PER_MINUTE. Lower it on a free trial key, limited to 3.
+	RequestsPerMinute int
+	// TokensPerMinute is the embeddings token quota, IMPORT_TOKENS_PER_MINUTE.
+	TokensPerMinute int
+	// MaxRetries is how many times a batch is retried on 429 and 5xx responses.
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry, doubled on every attempt.
+	RetryBackoff time.Duration
+	// SyncBatchSize is the maximum number of points sent to Qdrant in one request.
+	SyncBatchSize int
+	// ReindexRetention is how long the previous Qdrant version is kept after a reindex.
+	ReindexRetention time.Duration
+	// BulkSize is the maximum number of documents in one Elasticsearch bulk request.
+	BulkSize int
+	// BulkBytes is the maximum body size of one Elasticsearch bulk request.
+	BulkBytes int
+}
+
+func (c ImportConfig) withDefaults() ImportConfig {
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaultBatchSize
+	}
+
+	if c.BatchTokens <= 0 {
+		c.BatchTokens = defaultBatchTokens
+	}
+
+	if c.RequestsPerMinute <= 0 {
+		c.RequestsPerMinute = defaultRequestsPerMinute
+	}
+
+	if c.TokensPerMinute <= 0 {
+		c.TokensPerMinute = defaultTokensPerMinute
+	}
+
+	if c.MaxRetries <= 0 {
+		c.MaxRetries = defaultMaxRetries
+	}
+
+	if c.RetryBackoff <= 0 {
+		c.RetryBackoff = defaultRetryBackoff
+	}
+
+	if c.SyncBatchSize <= 0 {
+		c.SyncBatchSize = defaultSyncBatch
+	}
+
+	if c.ReindexRetention <= 0 {
+		c.ReindexRetention = defaultRetention
+	}
+
+	return c
+}
+
 type importUsecase struct {
 	client        openai.Client
+	embedder      embedder.Embedder
 	qdrantClient  qdrant.QdrantClient
 	embeddingRepo repository.EmbeddingRepo
+	importJobRepo repository.ImportJobRepo
 	esClient      elasticsearch.ESClient
+	schemas       *schema.Loader
 	logger        logger.Logger
+	config        ImportConfig
+	limiter       *rateLimiter
+	jobs          *jobRegistry
 }
 
 func NewImportUsecase(
 	client openai.Client,
+	embedder embedder.Embedder,
 	qdrantClient qdrant.QdrantClient,
 	embeddingRepo repository.EmbeddingRepo,
+	importJobRepo repository.ImportJobRepo,
 	esClient elasticsearch.ESClient,
+	schemas *schema.Loader,
 	logger logger.Logger,
+	config ImportConfig,
 ) ImportUsecase {
+	config = config.withDefaults()
+
 	return &importUsecase{
 		client:        client,
+		embedder:      embedder,
 		qdrantClient:  qdrantClient,
 		embeddingRepo: embeddingRepo,
+		importJobRepo: importJobRepo,
 		esClient:      esClient,
+		schemas:       schemas,
 		logger:        logger,
+		config:        config,
+		limiter:       newRateLimiter(config.RequestsPerMinute, config.TokensPerMinute),
+		jobs:          newJobRegistry(),
 	}
 }
 
 type ImportUsecase interface {
-	Import(ctx context.Context, fileHeader *multipart.FileHeader, filename string) error
-	MigrateToQdrant(ctx context.Context) error
-	MigrateToElasticsearch(ctx context.Context) error
-	ReadUploadedCSV(fileHeader *multipart.FileHeader) ([]string, []string, error)
-	ReadCSV(filename string) ([]string, []string, error)
+	Import(ctx context.Context, fileHeader *multipart.FileHeader, filename, scope string) error
+	StartImport(ctx context.Context, fileHeader *multipart.FileHeader, scope string) (*repository.ImportJob, error)
+	GetImportJob(ctx context.Context, id uint) (*repository.ImportJob, error)
+	CancelImportJob(ctx context.Context, id uint) error
+	MigrateToQdrant(ctx context.Context, scope string) error
+	ReindexQdrant(ctx context.Context, scope string) (string, error)
+	MigrateToElasticsearch(ctx context.Context, scope string) error
+	ReadUploadedFile(fileHeader *multipart.FileHeader) ([]string, []string, error)
+	ReadFile(filename string) ([]string, []string, error)
 }
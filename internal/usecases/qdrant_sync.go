@@ -0,0 +1,186 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/qdrant/go-client/qdrant"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/pkg/qdrant"
+)
+
+// MigrateToQdrant syncs the Qdrant collection of the scope with Postgres.
+// Points are keyed by the md5 of their combined text, so only rows missing
+// from the collection are upserted and points of rows gone from Postgres are
+// deleted. The collection stays searchable during the sync.
+func (u *importUsecase) MigrateToQdrant(ctx context.Context, scope string) error {
+	if err := ValidateScope(scope); err != nil {
+		return err
+	}
+
+	records, err := u.embeddingRepo.ListEmbeddingByScope(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	s, err := u.schemas.Load(scope)
+	if err != nil {
+		return err
+	}
+
+	collection := scopeCollection(&u.qdrantClient, scope)
+
+	// no-op when the alias or a plain collection exists
+	if err := collection.EnsureAlias(ctx); err != nil {
+		return err
+	}
+
+	if err := indexIdentifiers(ctx, collection, s); err != nil {
+		return err
+	}
+
+	existing, err := collection.PointIDs(ctx, uint32(u.config.SyncBatchSize))
+	if err != nil {
+		return err
+	}
+
+	upserts, deletes := planQdrantSync(existing, records)
+
+	// upsert first, the collection never misses a row that is still stored
+	if err := u.upsertRecords(ctx, collection, s, records, upserts); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(deletes); start += u.config.SyncBatchSize {
+		end := min(start+u.config.SyncBatchSize, len(deletes))
+
+		if err := collection.DeletePoints(ctx, deletes[start:end]); err != nil {
+			return err
+		}
+	}
+
+	u.logger.Info(fmt.Sprintf(
+		"synced scope %s to %s: %d upserted, %d deleted, %d unchanged",
+		scope, collection.GetCollectionName(), len(upserts), len(deletes), len(existing)-len(deletes),
+	))
+
+	return nil
+}
+
+// ReindexQdrant rebuilds the Qdrant collection of the scope from Postgres as
+// a new version with the current collection settings, then moves the alias
+// searches go through to it. It returns the name of the new version.
+func (u *importUsecase) ReindexQdrant(ctx context.Context, scope string) (string, error) {
+	if err := ValidateScope(scope); err != nil {
+		return "", err
+	}
+
+	records, err := u.embeddingRepo.ListEmbeddingByScope(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+
+	s, err := u.schemas.Load(scope)
+	if err != nil {
+		return "", err
+	}
+
+	all := make([]int, 0, len(records))
+	seen := make(map[string]bool, len(records))
+	for i, record := range records {
+		id := md5str(record.Combined)
+		if !seen[id] {
+			seen[id] = true
+			all = append(all, i)
+		}
+	}
+
+	collection := scopeCollection(&u.qdrantClient, scope)
+
+	version, err := collection.Reindex(ctx, func(ctx context.Context, target *qdrant.QdrantClient) error {
+		if err := indexIdentifiers(ctx, target, s); err != nil {
+			return err
+		}
+
+		return u.upsertRecords(ctx, target, s, records, all)
+	}, u.config.ReindexRetention)
+	if err != nil {
+		return "", err
+	}
+
+	u.logger.Info(fmt.Sprintf("reindexed scope %s into %s with %d points", scope, version, len(all)))
+
+	return version, nil
+}
+
+// indexIdentifiers creates a keyword payload index on the field of every
+// identifier column of the schema, so exact lookups do not scan the points.
+func indexIdentifiers(ctx context.Context, collection *qdrant.QdrantClient, s *schema.Schema) error {
+	for _, column := range s.IdentifierColumns() {
+		if err := collection.CreateKeywordIndex(ctx, payloadField(column)); err != nil {
+			return fmt.Errorf("index %s: %w", payloadField(column), err)
+		}
+	}
+
+	return nil
+}
+
+// upsertRecords writes the records at the given indices in batches.
+func (u *importUsecase) upsertRecords(
+	ctx context.Context,
+	collection *qdrant.QdrantClient,
+	s *schema.Schema,
+	records []repository.Embedding,
+	indices []int,
+) error {
+	for start := 0; start < len(indices); start += u.config.SyncBatchSize {
+		end := min(start+u.config.SyncBatchSize, len(indices))
+
+		points := make([]*pb.PointStruct, 0, end-start)
+		for _, i := range indices[start:end] {
+			record := records[i]
+			points = append(points, u.buildPoint(record.Combined, s.Parse(record.Combined), convertToFloat32(record.Embedding)))
+		}
+
+		if err := collection.UpsertPoints(ctx, points); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// planQdrantSync returns the indices of the records missing from the
+// collection and the IDs of the points no record maps to anymore.
+func planQdrantSync(existing []string, records []repository.Embedding) ([]int, []string) {
+	// Qdrant returns UUIDs hyphenated, md5str does not
+	stored := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		stored[strings.ReplaceAll(id, "-", "")] = true
+	}
+
+	wanted := make(map[string]bool, len(records))
+	var upserts []int
+	for i, record := range records {
+		id := md5str(record.Combined)
+		if wanted[id] {
+			continue
+		}
+		wanted[id] = true
+
+		if !stored[id] {
+			upserts = append(upserts, i)
+		}
+	}
+
+	var deletes []string
+	for _, id := range existing {
+		if !wanted[strings.ReplaceAll(id, "-", "")] {
+			deletes = append(deletes, id)
+		}
+	}
+
+	return upserts, deletes
+}
@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+)
+
+// hyphenate formats an md5 hex string the way Qdrant returns UUIDs.
+func hyphenate(id string) string {
+	return id[:8] + "-" + id[8:12] + "-" + id[12:16] + "-" + id[16:20] + "-" + id[20:]
+}
+
+func TestPlanQdrantSync(t *testing.T) {
+	records := []repository.Embedding{
+		{Combined: "Stock No: BA1"},
+		{Combined: "Stock No: BA2"},
+		{Combined: "Stock No: BA3"},
+		{Combined: "Stock No: BA2"},
+	}
+
+	type test struct {
+		existing    []string
+		wantUpserts []int
+		wantDeletes []string
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given an empty collection, When planning, Return every distinct record": func(t *testing.T) test {
+			return test{
+				wantUpserts: []int{0, 1, 2},
+			}
+		},
+		"Given a synced collection, When planning, Return nothing to do": func(t *testing.T) test {
+			return test{
+				existing: []string{
+					hyphenate(md5str("Stock No: BA1")),
+					hyphenate(md5str("Stock No: BA2")),
+					hyphenate(md5str("Stock No: BA3")),
+				},
+			}
+		},
+		"Given a changed and a removed row, When planning, Return the new row and the stale points": func(t *testing.T) test {
+			stale := hyphenate(md5str("Stock No: BA3 old"))
+			removed := hyphenate(md5str("Stock No: BA4"))
+
+			return test{
+				existing: []string{
+					hyphenate(md5str("Stock No: BA1")),
+					hyphenate(md5str("Stock No: BA2")),
+					stale,
+					removed,
+				},
+				wantUpserts: []int{2},
+				wantDeletes: []string{stale, removed},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			upserts, deletes := planQdrantSync(tt.existing, records)
+
+			assert.Equal(t, tt.wantUpserts, upserts)
+			assert.Equal(t, tt.wantDeletes, deletes)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yonisaka/similarity/pkg/embedder"
+	"github.com/yonisaka/similarity/pkg/logger"
+	"golang.org/x/time/rate"
+)
+
+// The default quotas are those of the first paid OpenAI usage tier for the
+// embedding models.
+const (
+	defaultRequestsPerMinute = 3000
+	defaultTokensPerMinute   = 1000000
+	defaultMaxRetries        = 5
+	defaultRetryBackoff      = time.Second
+	maxRetryBackoff          = time.Minute
+)
+
+// rateLimiter keeps embeddings requests within a requests-per-minute and a
+// tokens-per-minute quota.
+type rateLimiter struct {
+	requests *rate.Limiter
+	tokens   *rate.Limiter
+}
+
+func newRateLimiter(requestsPerMinute, tokensPerMinute int) *rateLimiter {
+	return &rateLimiter{
+		requests: rate.NewLimiter(rate.Every(time.Minute/time.Duration(requestsPerMinute)), 1),
+		tokens:   rate.NewLimiter(rate.Limit(float64(tokensPerMinute)/60), tokensPerMinute),
+	}
+}
+
+// Wait blocks until one request of nTokens fits in both quotas.
+func (l *rateLimiter) Wait(ctx context.Context, nTokens int) error {
+	if err := l.requests.Wait(ctx); err != nil {
+		return err
+	}
+
+	if nTokens > l.tokens.Burst() {
+		nTokens = l.tokens.Burst()
+	}
+
+	return l.tokens.WaitN(ctx, nTokens)
+}
+
+// embedWithRetry embeds a batch within the rate limit, retrying with
+// exponential backoff while the provider answers 429 or 5xx.
+func embedWithRetry(
+	ctx context.Context,
+	e embedder.Embedder,
+	limiter *rateLimiter,
+	log logger.Logger,
+	inputs []string,
+	maxRetries int,
+	backoff time.Duration,
+) ([][]float64, int, error) {
+	estimated := 0
+	for _, input := range inputs {
+		estimated += estimateTokens(input)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := limiter.Wait(ctx, estimated); err != nil {
+			return nil, 0, err
+		}
+
+		vectors, nTokens, err := e.EmbedBatch(ctx, inputs)
+		if err == nil || !embedder.IsRetryable(err) || attempt >= maxRetries {
+			return vectors, nTokens, err
+		}
+
+		log.Warn(fmt.Sprintf("embedding attempt %d failed, retrying in %s: %s", attempt+1, backoff, err))
+
+		select {
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
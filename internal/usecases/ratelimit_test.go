@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/pkg/embedder"
+	"github.com/yonisaka/similarity/pkg/logger"
+)
+
+type flakyEmbedder struct {
+	embedder.Embedder
+	errs  []error
+	calls int
+}
+
+func (e *flakyEmbedder) EmbedBatch(ctx context.Context, inputs []string) ([][]float64, int, error) {
+	e.calls++
+	if len(e.errs) > 0 {
+		err := e.errs[0]
+		e.errs = e.errs[1:]
+		return nil, 0, err
+	}
+
+	return e.Embedder.EmbedBatch(ctx, inputs)
+}
+
+func TestEmbedWithRetry(t *testing.T) {
+	type test struct {
+		errs      []error
+		wantCalls int
+		wantErr   bool
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a 429 then success, When embedding, Return vectors after one retry": func(t *testing.T) test {
+			return test{
+				errs:      []error{&openai.APIError{HTTPStatusCode: 429}},
+				wantCalls: 2,
+			}
+		},
+		"Given a 400, When embedding, Return the error without retry": func(t *testing.T) test {
+			return test{
+				errs:      []error{&openai.APIError{HTTPStatusCode: 400}},
+				wantCalls: 1,
+				wantErr:   true,
+			}
+		},
+		"Given persistent 500s, When embedding, Return the error after max retries": func(t *testing.T) test {
+			err := &openai.APIError{HTTPStatusCode: 500}
+			return test{
+				errs:      []error{err, err, err, err},
+				wantCalls: 3,
+				wantErr:   true,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			l, err := logger.NewLogger()
+			assert.NoError(t, err)
+
+			e := &flakyEmbedder{Embedder: embedder.NewHashEmbedder(8), errs: tt.errs}
+			limiter := newRateLimiter(6000, 1000000)
+
+			got, _, err := embedWithRetry(context.Background(), e, limiter, l, []string{"a b", "c d"}, 2, time.Millisecond)
+
+			assert.Equal(t, tt.wantCalls, e.calls)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got, 2)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/pkg/embedder"
+	"github.com/yonisaka/similarity/pkg/logger"
+)
+
+const (
+	MethodPostgresql = "postgresql"
+	MethodQdrant     = "qdrant"
+	MethodElastic    = "elastic"
+)
+
+// ErrUnknownMethod is returned when no retriever is registered for the method.
+var ErrUnknownMethod = errors.New("unknown search method")
+
+// Retriever finds the records of a scope related to a query, best first.
+type Retriever interface {
+	Retrieve(ctx context.Context, scope, query string) ([]types.StringAndRelatedness, error)
+}
+
+// Retrievers maps a search method to its retriever.
+type Retrievers map[string]Retriever
+
+// Get returns the retriever of the method.
+func (r Retrievers) Get(method string) (Retriever, error) {
+	retriever, ok := r[method]
+	if !ok {
+		return nil, fmt.Errorf("%w %q, expected one of %s", ErrUnknownMethod, method, strings.Join(r.Methods(), ", "))
+	}
+
+	return retriever, nil
+}
+
+// Methods returns the registered methods, sorted.
+func (r Retrievers) Methods() []string {
+	methods := make([]string, 0, len(r))
+	for method := range r {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	return methods
+}
+
+// embedQuery returns the embedding of the query.
+func embedQuery(ctx context.Context, e embedder.Embedder, query string) ([]float64, error) {
+	embedding, _, err := e.Embed(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return embedding, nil
+}
+
+// fuseHits merges the lists with the fusion config and logs the result.
+func fuseHits(l logger.Logger, config FusionConfig, lists ...rankedList) []types.StringAndRelatedness {
+	results := fuse(config, lists...)
+
+	for _, result := range results {
+		l.Info(fmt.Sprintf("record id: %s score: %f ranks: %v", hitKey(result), result.Relatedness, result.Ranks))
+	}
+
+	return results
+}
@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/pkg/elasticsearch"
+	"github.com/yonisaka/similarity/pkg/embedder"
+	"github.com/yonisaka/similarity/pkg/logger"
+)
+
+type elasticRetriever struct {
+	embedder embedder.Embedder
+	esClient elasticsearch.ESClient
+	fusion   FusionConfig
+	logger   logger.Logger
+}
+
+// NewElasticRetriever returns a retriever fusing a kNN search on the
+// embedding with a full text search of the query in the index of the scope.
+func NewElasticRetriever(embedder embedder.Embedder, esClient elasticsearch.ESClient, fusion FusionConfig, logger logger.Logger) ExactRetriever {
+	return &elasticRetriever{
+		embedder: embedder,
+		esClient: esClient,
+		fusion:   fusion.withDefaults(),
+		logger:   logger,
+	}
+}
+
+func (r *elasticRetriever) Retrieve(ctx context.Context, scope, query string) ([]types.StringAndRelatedness, error) {
+	queryEmbedding, err := embedQuery(ctx, r.embedder, query)
+	if err != nil {
+		return nil, err
+	}
+
+	index := scopeIndex(&r.esClient, scope)
+
+	vectorResponse, err := index.VectorSearch(queryEmbedding, r.fusion.Candidates)
+	if err != nil {
+		return nil, elasticsearchError(scope, err)
+	}
+
+	textResponse, err := index.IndexSearch(query, r.fusion.Candidates)
+	if err != nil {
+		return nil, elasticsearchError(scope, err)
+	}
+
+	return fuseHits(r.logger, r.fusion,
+		rankedList{source: sourceVector, hits: esHits(vectorResponse)},
+		rankedList{source: sourceKeyword, hits: esHits(textResponse)},
+	), nil
+}
+
+// Lookup returns the documents whose keyword field of a match column holds
+// its value, ignoring case.
+func (r *elasticRetriever) Lookup(ctx context.Context, scope string, matches []schema.Match) ([]types.StringAndRelatedness, error) {
+	index := scopeIndex(&r.esClient, scope)
+
+	var hits []types.StringAndRelatedness
+	for _, match := range matches {
+		response, err := index.TermsSearch(elasticsearch.KeywordField(match.Column), []string{match.Value}, r.fusion.TopN)
+		if err != nil {
+			return nil, elasticsearchError(scope, err)
+		}
+
+		hits = append(hits, esHits(response)...)
+	}
+
+	return hits, nil
+}
+
+func esHits(response *elasticsearch.ESSearchResponse) []types.StringAndRelatedness {
+	hits := make([]types.StringAndRelatedness, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		hits = append(hits, types.StringAndRelatedness{
+			Key:         hit.ID,
+			Text:        hit.Source.Combined,
+			Relatedness: hit.Score,
+		})
+	}
+
+	return hits
+}
+
+func elasticsearchError(scope string, err error) error {
+	if errors.Is(err, elasticsearch.ErrIndexNotFound) {
+		return fmt.Errorf("scope %s is not migrated to elasticsearch yet: %w", scope, err)
+	}
+
+	return err
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/pkg/embedder"
+	"github.com/yonisaka/similarity/pkg/logger"
+)
+
+type postgresRetriever struct {
+	embedder      embedder.Embedder
+	embeddingRepo repository.EmbeddingRepo
+	fusion        FusionConfig
+	logger        logger.Logger
+}
+
+// NewPostgresRetriever returns a retriever ranking the stored embeddings
+// against the query inside Postgres.
+func NewPostgresRetriever(embedder embedder.Embedder, embeddingRepo repository.EmbeddingRepo, fusion FusionConfig, logger logger.Logger) ExactRetriever {
+	return &postgresRetriever{
+		embedder:      embedder,
+		embeddingRepo: embeddingRepo,
+		fusion:        fusion.withDefaults(),
+		logger:        logger,
+	}
+}
+
+func (r *postgresRetriever) Retrieve(ctx context.Context, scope, query string) ([]types.StringAndRelatedness, error) {
+	queryEmbedding, err := embedQuery(ctx, r.embedder, query)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := r.embeddingRepo.NearestByScope(ctx, scope, queryEmbedding, r.fusion.Candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	vectorHits := make([]types.StringAndRelatedness, 0, len(records))
+	for _, record := range records {
+		vectorHits = append(vectorHits, types.StringAndRelatedness{
+			ID:          record.ID,
+			Text:        record.Combined,
+			Relatedness: record.Relatedness,
+		})
+	}
+
+	return fuseHits(r.logger, r.fusion, rankedList{source: sourceVector, hits: vectorHits}), nil
+}
+
+// Lookup returns the records whose column holds a value of the matches,
+// compared on the combined text.
+func (r *postgresRetriever) Lookup(ctx context.Context, scope string, matches []schema.Match) ([]types.StringAndRelatedness, error) {
+	var hits []types.StringAndRelatedness
+	for _, match := range matches {
+		records, err := r.embeddingRepo.FindByField(ctx, scope, match.Column, match.Value, r.fusion.TopN)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range records {
+			hits = append(hits, types.StringAndRelatedness{
+				ID:   record.ID,
+				Text: record.Combined,
+			})
+		}
+	}
+
+	return hits, nil
+}
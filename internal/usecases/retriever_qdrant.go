@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/pkg/embedder"
+	"github.com/yonisaka/similarity/pkg/logger"
+	"github.com/yonisaka/similarity/pkg/qdrant"
+)
+
+type qdrantRetriever struct {
+	embedder     embedder.Embedder
+	qdrantClient qdrant.QdrantClient
+	fusion       FusionConfig
+	logger       logger.Logger
+	scroll       bool
+}
+
+// NewQdrantRetriever returns a retriever searching the collection of the
+// scope. With scroll, the points whose payload matches a word of the query
+// are fused with the vector hits.
+func NewQdrantRetriever(embedder embedder.Embedder, qdrantClient qdrant.QdrantClient, fusion FusionConfig, logger logger.Logger, scroll bool) ExactRetriever {
+	return &qdrantRetriever{
+		embedder:     embedder,
+		qdrantClient: qdrantClient,
+		fusion:       fusion.withDefaults(),
+		logger:       logger,
+		scroll:       scroll,
+	}
+}
+
+func (r *qdrantRetriever) Retrieve(ctx context.Context, scope, query string) ([]types.StringAndRelatedness, error) {
+	queryEmbedding, err := embedQuery(ctx, r.embedder, query)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := scopeCollection(&r.qdrantClient, scope)
+
+	points, err := collection.Search(ctx, convertToFloat32(queryEmbedding), uint64(r.fusion.Candidates))
+	if err != nil {
+		return nil, err
+	}
+
+	vectorHits := make([]types.StringAndRelatedness, 0, len(points))
+	for _, point := range points {
+		vectorHits = append(vectorHits, types.StringAndRelatedness{
+			QdrantID:    point.Id.GetUuid(),
+			Key:         point.Id.GetUuid(),
+			Text:        point.Payload["combined"].GetStringValue(),
+			Relatedness: float64(point.Score),
+		})
+	}
+
+	// using scroll
+	// to get specific record by user prompt input
+	// must match one of the word in the query
+	var keywordHits []types.StringAndRelatedness
+	if r.scroll {
+		scrolls, err := collection.MultiScroll(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		// scroll matches carry no score, their order is their rank
+		for _, scroll := range scrolls {
+			keywordHits = append(keywordHits, types.StringAndRelatedness{
+				QdrantID: scroll.Id.GetUuid(),
+				Key:      scroll.Id.GetUuid(),
+				Text:     scroll.Payload["combined"].GetStringValue(),
+			})
+		}
+	}
+
+	return fuseHits(r.logger, r.fusion,
+		rankedList{source: sourceVector, hits: vectorHits},
+		rankedList{source: sourceKeyword, hits: keywordHits},
+	), nil
+}
+
+// Lookup returns the points whose payload field of a match column holds its
+// value. Keyword matches are case sensitive, so the value is also tried in
+// upper case, the way identifiers are stored.
+func (r *qdrantRetriever) Lookup(ctx context.Context, scope string, matches []schema.Match) ([]types.StringAndRelatedness, error) {
+	collection := scopeCollection(&r.qdrantClient, scope)
+
+	var hits []types.StringAndRelatedness
+	for _, match := range matches {
+		keywords := []string{match.Value}
+		if upper := strings.ToUpper(match.Value); upper != match.Value {
+			keywords = append(keywords, upper)
+		}
+
+		points, err := collection.MatchKeywords(ctx, payloadField(match.Column), keywords, uint32(r.fusion.TopN))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, point := range points {
+			hits = append(hits, types.StringAndRelatedness{
+				QdrantID: point.Id.GetUuid(),
+				Key:      point.Id.GetUuid(),
+				Text:     point.Payload["combined"].GetStringValue(),
+			})
+		}
+	}
+
+	return hits, nil
+}
+
+// payloadField returns the payload key of a column of the row.
+func payloadField(column string) string {
+	return "fields." + column
+}
@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+)
+
+type fakeRetriever struct {
+	hits []types.StringAndRelatedness
+}
+
+func (r fakeRetriever) Retrieve(ctx context.Context, scope, query string) ([]types.StringAndRelatedness, error) {
+	return r.hits, nil
+}
+
+type fakeScopeRepo struct {
+	repository.EmbeddingRepo
+}
+
+func (fakeScopeRepo) GetScope(ctx context.Context, name string) (*repository.Scope, error) {
+	return &repository.Scope{Name: name}, nil
+}
+
+func TestRetrievers_Get(t *testing.T) {
+	type test struct {
+		method  string
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a registered method, When getting its retriever, Return it": func(t *testing.T) test {
+			return test{method: MethodQdrant}
+		},
+		"Given an unknown method, When getting its retriever, Return ErrUnknownMethod": func(t *testing.T) test {
+			return test{method: "bm25", wantErr: ErrUnknownMethod}
+		},
+		"Given an empty method, When getting its retriever, Return ErrUnknownMethod": func(t *testing.T) test {
+			return test{method: "", wantErr: ErrUnknownMethod}
+		},
+	}
+
+	retrievers := Retrievers{
+		MethodPostgresql: fakeRetriever{},
+		MethodQdrant:     fakeRetriever{},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			got, err := retrievers.Get(tt.method)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, got)
+		})
+	}
+}
+
+func TestSearch_UnknownMethod(t *testing.T) {
+	u := &searchUsecase{
+		retrievers:    Retrievers{MethodPostgresql: fakeRetriever{}},
+		defaultMethod: MethodPostgresql,
+	}
+
+	_, err := u.Search(context.Background(), types.SearchRequest{Query: "harga", Method: MethodElastic})
+
+	assert.ErrorIs(t, err, ErrUnknownMethod)
+}
+
+func TestSearch_RetrievalOnly(t *testing.T) {
+	hit := types.StringAndRelatedness{
+		QdrantID:    "4b1f",
+		Key:         "4b1f",
+		Text:        "nopol: B1207KDZ; merk: TOYOTA",
+		Relatedness: 0.8,
+		Ranks:       map[string]int{sourceVector: 1},
+	}
+
+	u := &searchUsecase{
+		embeddingRepo: fakeScopeRepo{},
+		retrievers:    Retrievers{MethodQdrant: fakeRetriever{hits: []types.StringAndRelatedness{hit}}},
+		defaultMethod: MethodQdrant,
+		schemas:       schema.NewLoader(t.TempDir()),
+		prompts:       prompt.NewLoader(t.TempDir()),
+	}
+
+	got, err := u.Search(context.Background(), types.SearchRequest{
+		Query:         "plat B1207KDZ",
+		Scope:         "lelang",
+		RetrievalOnly: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, got.Answer)
+	assert.Equal(t, []types.Source{{
+		ID:        "4b1f",
+		Scope:     "lelang",
+		Score:     0.8,
+		Retriever: MethodQdrant,
+		Ranks:     map[string]int{sourceVector: 1},
+		Text:      hit.Text,
+		Fields:    map[string]string{"nopol": "B1207KDZ", "merk": "TOYOTA"},
+	}}, got.Sources)
+}
@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/pkg/elasticsearch"
+	"github.com/yonisaka/similarity/pkg/qdrant"
+)
+
+// defaultScope is searched when a request names no scope, the one of the
+// sample data of migrations/seeds.
+const defaultScope = "lelang"
+
+// ErrInvalidScope is returned for a scope name that cannot be used as a
+// collection or index name.
+var ErrInvalidScope = errors.New("scope must be 1 to 50 lowercase letters, digits, '.', '_' or '-', not ending in _v<N>")
+
+// scopePattern keeps names valid for Postgres, Qdrant collections and
+// Elasticsearch indices alike.
+var scopePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,49}$`)
+
+// versionSuffix ends the names of the Qdrant collection versions, see
+// qdrant.QdrantClient.CreateVersion. The collection of scope "a_v1" would be
+// version 1 of scope "a".
+var versionSuffix = regexp.MustCompile(`_v\d+$`)
+
+// ValidateScope checks that the name can be used as a scope.
+func ValidateScope(name string) error {
+	if !scopePattern.MatchString(name) || versionSuffix.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidScope, name)
+	}
+
+	return nil
+}
+
+// scopeCollection returns the client of the Qdrant collection of the scope.
+func scopeCollection(qc *qdrant.QdrantClient, scope string) *qdrant.QdrantClient {
+	return qc.WithCollection(qc.GetCollectionName() + "_" + scope)
+}
+
+// scopeIndex returns the client of the Elasticsearch index of the scope.
+func scopeIndex(es *elasticsearch.ESClient, scope string) *elasticsearch.ESClient {
+	return es.WithIndex(es.GetIndex() + "_" + scope)
+}
+
+// ListScopes returns every scope with its number of embeddings.
+func (u *scopeUsecase) ListScopes(ctx context.Context) ([]repository.Scope, error) {
+	return u.embeddingRepo.ListScopes(ctx)
+}
+
+// CreateScope registers an empty scope with its Qdrant collection and
+// Elasticsearch index. The scope is removed again when either fails, so the
+// creation can be retried.
+func (u *scopeUsecase) CreateScope(ctx context.Context, name string) (*repository.Scope, error) {
+	if err := ValidateScope(name); err != nil {
+		return nil, err
+	}
+
+	scope := &repository.Scope{Name: name}
+	if err := u.embeddingRepo.CreateScope(ctx, scope); err != nil {
+		return nil, err
+	}
+
+	if err := scopeCollection(&u.qdrantClient, name).EnsureAlias(ctx); err != nil {
+		u.undoCreateScope(ctx, name)
+		return nil, err
+	}
+
+	if err := scopeIndex(&u.esClient, name).CreateIndex(); err != nil && !errors.Is(err, elasticsearch.ErrIndexExists) {
+		u.undoCreateScope(ctx, name)
+		return nil, err
+	}
+
+	return scope, nil
+}
+
+// undoCreateScope removes the row of a scope whose creation failed. The
+// collection is left alone, creating the scope again reuses it.
+func (u *scopeUsecase) undoCreateScope(ctx context.Context, name string) {
+	// the request may be canceled, the row must go anyway
+	if err := u.embeddingRepo.DeleteScope(context.WithoutCancel(ctx), name); err != nil {
+		u.logger.Warn(fmt.Sprintf("failed to delete half created scope %s: %s", name, err))
+	}
+}
+
+// DeleteScope removes the scope, its embeddings, its Qdrant collection and
+// its Elasticsearch index.
+func (u *scopeUsecase) DeleteScope(ctx context.Context, name string) error {
+	if err := u.embeddingRepo.DeleteScope(ctx, name); err != nil {
+		return err
+	}
+
+	if err := scopeCollection(&u.qdrantClient, name).DropAll(ctx); err != nil {
+		u.logger.Warn(fmt.Sprintf("failed to delete collection of scope %s: %s", name, err))
+	}
+
+	if err := scopeIndex(&u.esClient, name).DeleteIndex(); err != nil && !errors.Is(err, elasticsearch.ErrIndexNotFound) {
+		u.logger.Warn(fmt.Sprintf("failed to delete index of scope %s: %s", name, err))
+	}
+
+	return nil
+}
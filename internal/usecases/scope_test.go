@@ -0,0 +1,137 @@
+package usecases
+
+import (
+	"context"
+	"io/fs"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/migrations"
+	"github.com/yonisaka/similarity/pkg/logger"
+	"github.com/yonisaka/similarity/pkg/qdrant"
+)
+
+func TestValidateScope(t *testing.T) {
+	tests := map[string]struct {
+		name    string
+		wantErr bool
+	}{
+		"Given a file name, Return no error":          {name: "sample_lelang.csv"},
+		"Given a short name, Return no error":         {name: "lelang"},
+		"Given an empty name, Return error":           {name: "", wantErr: true},
+		"Given uppercase letters, Return error":       {name: "Lelang", wantErr: true},
+		"Given a leading underscore, Return error":    {name: "_lelang", wantErr: true},
+		"Given a path separator, Return error":        {name: "data/lelang", wantErr: true},
+		"Given more than 50 characters, Return error": {name: "a123456789b123456789c123456789d123456789e123456789f", wantErr: true},
+		"Given a version suffix, Return error":        {name: "lelang_v12", wantErr: true},
+		"Given a v in the name, Return no error":      {name: "lelang_v2023.csv"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateScope(tt.name)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidScope)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateScope_VersionCollision(t *testing.T) {
+	qc := (&qdrant.QdrantClient{}).WithCollection("research")
+
+	// version 1 of scope a is the alias of scope a_v1
+	assert.Equal(t, scopeCollection(qc, "a").GetCollectionName()+"_v1", scopeCollection(qc, "a_v1").GetCollectionName())
+
+	assert.NoError(t, ValidateScope("a"))
+	assert.ErrorIs(t, ValidateScope("a_v1"), ErrInvalidScope)
+}
+
+type recordingScopeRepo struct {
+	repository.EmbeddingRepo
+	created []string
+	deleted []string
+}
+
+func (r *recordingScopeRepo) CreateScope(ctx context.Context, scope *repository.Scope) error {
+	r.created = append(r.created, scope.Name)
+	return nil
+}
+
+func (r *recordingScopeRepo) DeleteScope(ctx context.Context, name string) error {
+	r.deleted = append(r.deleted, name)
+	return nil
+}
+
+func TestCreateScope_Undo(t *testing.T) {
+	l, err := logger.NewLogger()
+	assert.NoError(t, err)
+
+	// nothing listens there, every Qdrant call fails
+	qc := qdrant.NewQdrantClient("127.0.0.1:1", "research", 4, 0, false, 16, 100)
+	t.Cleanup(qc.Close)
+
+	repo := &recordingScopeRepo{}
+	u := &scopeUsecase{embeddingRepo: repo, qdrantClient: *qc, logger: l}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = u.CreateScope(ctx, "lelang")
+
+	assert.Error(t, err)
+	assert.Equal(t, []string{"lelang"}, repo.created)
+	assert.Equal(t, []string{"lelang"}, repo.deleted)
+}
+
+// seededScopeRepo holds only the scope the seed migration inserts.
+type seededScopeRepo struct {
+	repository.EmbeddingRepo
+	name string
+}
+
+func (r seededScopeRepo) GetScope(ctx context.Context, name string) (*repository.Scope, error) {
+	if name != r.name {
+		return nil, repository.ErrNotFound
+	}
+
+	return &repository.Scope{Name: name}, nil
+}
+
+func TestSearch_DefaultScope(t *testing.T) {
+	seed, err := fs.ReadFile(migrations.Seeds(), "002_seed_lelang_scope.up.sql")
+	assert.NoError(t, err)
+
+	match := regexp.MustCompile(`VALUES \('([^']+)'`).FindSubmatch(seed)
+	if !assert.NotNil(t, match, "no scope in the seed") {
+		return
+	}
+
+	l, err := logger.NewLogger()
+	assert.NoError(t, err)
+
+	retriever := &recordingRetriever{}
+	u := &searchUsecase{
+		embeddingRepo: seededScopeRepo{name: string(match[1])},
+		retrievers:    Retrievers{MethodPostgresql: retriever},
+		defaultMethod: MethodPostgresql,
+		schemas:       schema.NewLoader(t.TempDir()),
+		prompts:       prompt.NewLoader(t.TempDir()),
+		logger:        l,
+	}
+
+	got, err := u.Search(context.Background(), types.SearchRequest{Query: "odometer B1207KDZ?", RetrievalOnly: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"odometer B1207KDZ?"}, retriever.queries)
+	assert.Len(t, got.Sources, 1)
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/pkg/elasticsearch"
+	"github.com/yonisaka/similarity/pkg/logger"
+	"github.com/yonisaka/similarity/pkg/qdrant"
+)
+
+type scopeUsecase struct {
+	embeddingRepo repository.EmbeddingRepo
+	qdrantClient  qdrant.QdrantClient
+	esClient      elasticsearch.ESClient
+	logger        logger.Logger
+}
+
+func NewScopeUsecase(
+	embeddingRepo repository.EmbeddingRepo,
+	qdrantClient qdrant.QdrantClient,
+	esClient elasticsearch.ESClient,
+	logger logger.Logger,
+) ScopeUsecase {
+	return &scopeUsecase{
+		embeddingRepo: embeddingRepo,
+		qdrantClient:  qdrantClient,
+		esClient:      esClient,
+		logger:        logger,
+	}
+}
+
+type ScopeUsecase interface {
+	ListScopes(ctx context.Context) ([]repository.Scope, error)
+	CreateScope(ctx context.Context, name string) (*repository.Scope, error)
+	DeleteScope(ctx context.Context, name string) error
+}
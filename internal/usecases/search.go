@@ -1,144 +1,258 @@
 package usecases
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/sashabaranov/go-openai"
 	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/schema"
 	"github.com/yonisaka/similarity/internal/types"
-	"io/ioutil"
-	"log"
-	"net/http"
 	"os"
 	"sort"
-	"strconv"
 	"strings"
 )
 
 const (
-	embeddingURL         = "https://api.openai.com/v1/embeddings"
-	roleUser             = "user"
-	similarityQdrant     = "qdrant"
-	similarityPostgresql = "postgresql"
-	similarityElastic    = "elastic"
-	topN                 = 5
-	tokenBudget          = 1000
-	introduction         = "Use the below sample data to answer the subsequent question. If the answer cannot be found in the data source, write \"I could not find an answer.\""
+	roleUser    = "user"
+	topN        = 5
+	tokenBudget = 1000
+	// minSectionTokens is the least of a record worth sending truncated.
+	minSectionTokens = 16
 )
 
-func (u *searchUsecase) Search(ctx context.Context, query string) (string, error) {
-	var recordsAndRelatedness []types.StringAndRelatedness
-	var err error
+// Search answers the query from the records of the scope, the default scope
+// when empty, retrieved with the method of the request or the default one.
+// The response lists the retrieved records as sources; with RetrievalOnly it
+// has no answer and GPT is not called. With a session, a follow-up is
+// rewritten into a standalone query and answered with the recent turns.
+// Records holding an identifier of the query, like a plate or stock number
+// matched by a pattern of the scope schema, rank ahead of the others.
+// In extract mode the answer is the value of one field of one record.
+func (u *searchUsecase) Search(ctx context.Context, request types.SearchRequest) (*types.SearchResponse, error) {
+	turn, err := u.retrieve(ctx, request)
+	if err != nil {
+		return nil, err
+	}
 
-	if os.Getenv("SIMILARITY_METHOD") == similarityQdrant {
-		recordsAndRelatedness, err = u.QdrantSearch(ctx, query)
-		if err != nil {
-			return "", err
-		}
-	} else if os.Getenv("SIMILARITY_METHOD") == similarityPostgresql {
-		records, err := u.embeddingRepo.ListEmbeddingByScope(ctx, "sample_lelang.csv")
-		if err != nil {
-			return "", err
-		}
+	if request.RetrievalOnly {
+		return turn.response, nil
+	}
+
+	// Ask a question using the top N strings
+	messages, err := u.usePrompt(turn)
+	if err != nil {
+		return nil, err
+	}
 
-		recordsAndRelatedness, err = u.StringsRankedByRelatedness(query, records, topN)
+	if turn.mode == AnswerExtract {
+		extraction, _, err := u.extract(ctx, messages, turn.response.Sources)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-	} else if os.Getenv("SIMILARITY_METHOD") == similarityElastic {
-		recordsAndRelatedness, err = u.ElasticSearch(ctx, query)
+
+		answerExtraction(turn.prompt, turn.response, extraction)
+	} else {
+		turn.response.Answer, err = u.complete(ctx, messages)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 	}
 
-	// Ask a question using the top N strings
-	answer, err := u.Ask(ctx, query, recordsAndRelatedness, tokenBudget) // Adjust the token budget as needed
-	if err != nil {
-		return "", err
-	}
+	u.remember(ctx, request.SessionID, request.Query, turn.response.Answer)
 
-	return answer, nil
+	return turn.response, nil
 }
 
-func (u *searchUsecase) LoadJSONDataSources(filepath string) ([]repository.Embedding, error) {
-	// Load and parse JSON data
-	file, err := os.Open(filepath) // Adjust the path to your JSON file
-	if err != nil {
+// searchTurn is a retrieved search waiting for its answer.
+type searchTurn struct {
+	scope    string
+	query    string
+	mode     string
+	prompt   *prompt.Prompt
+	response *types.SearchResponse
+	records  []types.StringAndRelatedness
+	history  []repository.Turn
+}
+
+// retrieve finds the records of the request and returns a response holding
+// them as sources.
+func (u *searchUsecase) retrieve(ctx context.Context, request types.SearchRequest) (*searchTurn, error) {
+	scope := request.Scope
+	if scope == "" {
+		scope = defaultScope
+	}
+
+	method := request.Method
+	if method == "" {
+		method = u.defaultMethod
+	}
+
+	if err := validateMode(request.Mode); err != nil {
 		return nil, err
 	}
-	defer file.Close()
 
-	var records []repository.Embedding
-	if err := json.NewDecoder(file).Decode(&records); err != nil {
+	retriever, err := u.retrievers.Get(method)
+	if err != nil {
 		return nil, err
 	}
 
-	return records, nil
-}
+	history, err := u.history(ctx, request.SessionID)
+	if err != nil {
+		return nil, err
+	}
 
-func (u *searchUsecase) EmbeddingQuery(query string) ([]float64, error) {
-	// Construct the request body
-	requestBody, err := json.Marshal(map[string]string{
-		"input": query,
-		"model": os.Getenv("OPENAI_EMBEDDING_MODEL"),
-	})
+	p, err := u.prompts.Load(request.Template, scope)
 	if err != nil {
-		log.Fatalf("Error occurred while marshaling. %s", err)
+		return nil, err
 	}
 
-	// Create an HTTP request
-	req, err := http.NewRequest("POST", embeddingURL, bytes.NewBuffer(requestBody))
+	if _, err := u.embeddingRepo.GetScope(ctx, scope); err != nil {
+		return nil, err
+	}
+
+	query, err := u.standaloneQuery(ctx, p, scope, history, request.Query)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", os.Getenv("OPENAI_API_KEY")))
+	s, err := u.schemas.Load(scope)
+	if err != nil {
+		return nil, err
+	}
 
-	// Make the request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	exact, err := u.exactHits(ctx, retriever, s, scope, query)
 	if err != nil {
-		log.Fatalf("Error occurred while making HTTP request. %s", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	// Read the response
-	body, err := ioutil.ReadAll(resp.Body)
+	recordsAndRelatedness, err := retriever.Retrieve(ctx, scope, query)
 	if err != nil {
-		log.Fatalf("Error occurred while reading response body. %s", err)
+		return nil, err
+	}
+
+	// records holding an identifier of the query are what it asks about
+	recordsAndRelatedness = rankExact(exact, recordsAndRelatedness)
+
+	sources := u.sources(s, scope, method, recordsAndRelatedness)
+
+	response := &types.SearchResponse{
+		Question:  request.Query,
+		SessionID: request.SessionID,
+		Sources:   sources,
 	}
+	if query != request.Query {
+		response.RetrievalQuery = query
+	}
+
+	return &searchTurn{
+		scope:    scope,
+		query:    query,
+		mode:     request.Mode,
+		prompt:   p,
+		response: response,
+		records:  recordsAndRelatedness,
+		history:  history,
+	}, nil
+}
+
+// usePrompt returns the messages sent to GPT: the system message, the recent
+// history and the user message with the records within what is left of the
+// token budget. It marks the sources they hold as used. In extract mode the
+// sections show the source IDs to cite.
+func (u *searchUsecase) usePrompt(turn *searchTurn) ([]openai.ChatCompletionMessage, error) {
+	data := prompt.Data{Scope: turn.scope, Question: turn.query}
 
-	// Unmarshal the response into the EmbeddingResponse struct
-	var embeddingResponse *types.EmbeddingResponse
-	if err := json.Unmarshal(body, &embeddingResponse); err != nil {
+	system, err := turn.prompt.System(data)
+	if err != nil {
 		return nil, err
 	}
 
-	// Return the embedding
-	if len(embeddingResponse.Data) > 0 {
-		return embeddingResponse.Data[0].Embedding, nil
+	history, historyTokens := u.recentHistory(turn.history, historyBudget)
+
+	// the bare query without records
+	message := packedMessage{text: turn.query}
+	if len(turn.records) > 0 {
+		sections := make([]prompt.Data, 0, len(turn.response.Sources))
+		for i, source := range turn.response.Sources {
+			section := prompt.Data{
+				Scope:  turn.scope,
+				Text:   source.Text,
+				Fields: source.Fields,
+				Index:  i + 1,
+				Score:  source.Score,
+			}
+			if turn.mode == AnswerExtract {
+				section.ID = source.ID
+			}
+
+			sections = append(sections, section)
+		}
+
+		message, err = u.queryMessage(turn.prompt, data, sections, tokenBudget-historyTokens) // Adjust the token budget as needed
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range turn.response.Sources[:message.used] {
+		turn.response.Sources[i].Used = true
 	}
+	if message.truncated {
+		turn.response.Sources[message.used-1].Truncated = true
+	}
+
+	messages := []openai.ChatCompletionMessage{{Role: roleSystem, Content: system}}
+	messages = append(messages, historyMessages(history)...)
 
-	var errorResponse *types.ErrorResponse
-	if err := json.Unmarshal(body, &errorResponse); err != nil {
+	return append(messages, openai.ChatCompletionMessage{Role: roleUser, Content: message.text}), nil
+}
+
+// sources describes the retrieved records with the fields of their scope
+// schema.
+func (u *searchUsecase) sources(s *schema.Schema, scope, method string, records []types.StringAndRelatedness) []types.Source {
+	sources := make([]types.Source, 0, len(records))
+	for _, record := range records {
+		sources = append(sources, types.Source{
+			ID:        hitKey(record),
+			Scope:     scope,
+			Score:     record.Relatedness,
+			Retriever: method,
+			Ranks:     record.Ranks,
+			Scores:    record.Scores,
+			Text:      record.Text,
+			Fields:    s.Parse(record.Text).Fields,
+		})
+	}
+
+	return sources
+}
+
+func (u *searchUsecase) LoadJSONDataSources(filepath string) ([]repository.Embedding, error) {
+	// Load and parse JSON data
+	file, err := os.Open(filepath) // Adjust the path to your JSON file
+	if err != nil {
 		return nil, err
 	}
+	defer file.Close()
 
-	if errorResponse != nil {
-		return nil, errors.New(errorResponse.Error.Message)
+	var records []repository.Embedding
+	if err := json.NewDecoder(file).Decode(&records); err != nil {
+		return nil, err
 	}
 
-	return nil, nil
+	return records, nil
+}
+
+// EmbeddingQuery returns the embedding of the query using the configured embedder.
+func (u *searchUsecase) EmbeddingQuery(ctx context.Context, query string) ([]float64, error) {
+	return embedQuery(ctx, u.embedder, query)
 }
 
 // StringsRankedByRelatedness finds strings ranked by their relatedness to a query.
-func (u *searchUsecase) StringsRankedByRelatedness(query string, records []repository.Embedding, topN int) ([]types.StringAndRelatedness, error) {
-	queryEmbedding, err := u.EmbeddingQuery(query)
+func (u *searchUsecase) StringsRankedByRelatedness(ctx context.Context, query string, records []repository.Embedding, topN int) ([]types.StringAndRelatedness, error) {
+	queryEmbedding, err := u.EmbeddingQuery(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -165,163 +279,120 @@ func (u *searchUsecase) StringsRankedByRelatedness(query string, records []repos
 	}
 
 	for _, result := range results[:topN] {
-		fmt.Println(fmt.Sprintf("record id: %d relatedness: %f", result.ID, result.Relatedness))
+		u.logger.Info(fmt.Sprintf("record id: %d relatedness: %f", result.ID, result.Relatedness))
 	}
 
 	return results[:topN], nil
 }
 
-func (u *searchUsecase) QdrantSearch(ctx context.Context, query string) ([]types.StringAndRelatedness, error) {
-	queryEmbedding, err := u.EmbeddingQuery(query)
-	if err != nil {
-		return nil, err
-	}
-
-	points, err := u.qdrantClient.Search(ctx, convertToFloat32(queryEmbedding))
-	if err != nil {
-		return nil, err
-	}
-
-	if len(points) == 0 {
-		return nil, errors.New("no result found")
+// NumTokens returns the number of tokens in a string with the encoding of
+// the GPT model, or approximates it by words without one.
+func (u *searchUsecase) NumTokens(text string) int {
+	if u.encoding != nil {
+		return u.encoding.Count(text)
 	}
 
-	results := make([]types.StringAndRelatedness, 0, len(points))
-	// best score from ascending order
-	for _, point := range points {
-		results = append(results, types.StringAndRelatedness{
-			QdrantID:    point.Id.GetUuid(),
-			Text:        point.Payload["combined"].GetStringValue(),
-			Relatedness: float64(point.Score),
-		})
+	// Simple tokenization by splitting on spaces and punctuation
+	return len(strings.Fields(text))
+}
 
-		u.logger.Info(fmt.Sprintf("record id: %s relatedness: %f", point.Id.GetUuid(), point.Score))
+// truncateTokens cuts the text to its first maxTokens tokens, counted like
+// NumTokens.
+func (u *searchUsecase) truncateTokens(text string, maxTokens int) string {
+	if u.encoding != nil {
+		return u.encoding.Truncate(text, maxTokens)
 	}
 
-	// using scroll
-	// to get specific record by user prompt input
-	// must match one of the word in the query
-	// if scroll result has been existed in results, skip it
-	usingScroll, err := strconv.ParseBool(os.Getenv("QDRANT_SCROLL"))
-	if err != nil {
-		return nil, err
+	words := strings.Fields(text)
+	if len(words) <= maxTokens {
+		return text
 	}
 
-	if usingScroll {
-		//scrolls, err := u.qdrantClient.Scroll(ctx, query)
-		scrolls, err := u.qdrantClient.MultiScroll(ctx, query)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, scroll := range scrolls {
-			existID := false
-			for _, result := range results {
-				if scroll.Id.GetUuid() == result.QdrantID {
-					existID = true
-					break
-				}
-			}
-
-			if existID {
-				continue
-			}
-
-			results = append(results, types.StringAndRelatedness{
-				QdrantID: scroll.Id.GetUuid(),
-				Text:     scroll.Payload["combined"].GetStringValue(),
-			})
-
-			u.logger.Info(fmt.Sprintf("record id: %s with indexing search", scroll.Id.GetUuid()))
-		}
-	}
+	return strings.Join(words[:max(maxTokens, 0)], " ")
+}
 
-	return results, nil
+// QueryMessage builds a message with relevant texts from the data.
+func (u *searchUsecase) QueryMessage(query string, records []types.StringAndRelatedness, tokenBudget int) string {
+	// the default prompt always renders
+	packed, _ := u.queryMessage(prompt.Default(), prompt.Data{Question: query}, recordSections(records), tokenBudget)
+	return packed.text
 }
 
-func (u *searchUsecase) ElasticSearch(ctx context.Context, query string) ([]types.StringAndRelatedness, error) {
-	results := make([]types.StringAndRelatedness, 0)
+// packedMessage is a user message holding the first used sections, the last
+// one truncated when truncated is set.
+type packedMessage struct {
+	text      string
+	used      int
+	truncated bool
+}
 
-	// using vector search
-	//to get specific record by user prompt input
-	queryEmbedding, err := u.EmbeddingQuery(query)
+// queryMessage builds the user message of the prompt with as many sections
+// as fit in the token budget. The first section over the budget is truncated
+// to what is left of it rather than dropped, unless too little is left.
+func (u *searchUsecase) queryMessage(p *prompt.Prompt, data prompt.Data, sections []prompt.Data, tokenBudget int) (packedMessage, error) {
+	introduction, err := p.Introduction(data)
 	if err != nil {
-		return nil, err
+		return packedMessage{}, err
 	}
 
-	//esResponse, err := u.esClient.VectorSearch(queryEmbedding)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//if esResponse == nil {
-	//	return nil, errors.New("no result found")
-	//}
-	//
-	////// best score from ascending order
-	//for _, hit := range esResponse.Hits.Hits {
-	//	results = append(results, entities.StringAndRelatedness{
-	//		QdrantID:    hit.ID,
-	//		Text:        hit.Source.Combined,
-	//		Relatedness: hit.Score,
-	//	})
-	//
-	//	u.logger.Info(fmt.Sprintf("record id: %s relatedness: %f", hit.ID, hit.Score))
-	//}
-
-	// using hybrid search
-	// to get specific record by user prompt input
-	// must match one of the word in the query
-	// if scroll result has been existed in results, skip it
-	hybridResponse, err := u.esClient.HybridSearch(queryEmbedding, query)
+	question, err := p.Question(data)
 	if err != nil {
-		return nil, err
+		return packedMessage{}, err
 	}
 
-	for _, hit := range hybridResponse.Hits.Hits {
-		existID := false
-		for _, result := range results {
-			if hit.ID == result.QdrantID {
-				existID = true
-				break
-			}
+	message := introduction
+	tokens := u.NumTokens(message) + u.NumTokens(question)
+	used := 0
+	for _, section := range sections {
+		nextPrompt, err := p.Section(section)
+		if err != nil {
+			return packedMessage{}, err
 		}
 
-		if existID {
+		n := u.NumTokens(nextPrompt)
+		if tokens+n <= tokenBudget {
+			message += nextPrompt
+			tokens += n
+			used++
 			continue
 		}
 
-		results = append(results, types.StringAndRelatedness{
-			QdrantID: hit.ID,
-			Text:     hit.Source.Combined,
-		})
+		text := section.Text
+		section.Text = ""
+		empty, err := p.Section(section)
+		if err != nil {
+			return packedMessage{}, err
+		}
 
-		u.logger.Info(fmt.Sprintf("record id: %s relatedness: %f", hit.ID, hit.Score))
-	}
+		left := tokenBudget - tokens - u.NumTokens(empty)
+		if left < minSectionTokens {
+			break
+		}
 
-	return results, nil
-}
+		section.Text = u.truncateTokens(text, left)
+		nextPrompt, err = p.Section(section)
+		if err != nil {
+			return packedMessage{}, err
+		}
 
-// NumTokens approximates the number of tokens in a string.
-func (u *searchUsecase) NumTokens(text string) int {
-	// Simple tokenization by splitting on spaces and punctuation
-	// Adjust this as needed for a more accurate count
-	return len(strings.Fields(text))
+		return packedMessage{text: message + nextPrompt + question, used: used + 1, truncated: true}, nil
+	}
+
+	return packedMessage{text: message + question, used: used}, nil
 }
 
-// QueryMessage builds a message with relevant texts from the data.
-func (u *searchUsecase) QueryMessage(query string, records []types.StringAndRelatedness, tokenBudget int) string {
-	question := "\n\nQuestion: " + query
-	message := introduction
-	for _, record := range records {
-		nextPrompt := "\n\nPrompt section:\n\"\"\"\n" + record.Text + "\n\"\"\""
-		if u.NumTokens(message+nextPrompt+question) > tokenBudget {
-			break
-		} else {
-			message += nextPrompt
-		}
+// recordSections returns the section data of the records.
+func recordSections(records []types.StringAndRelatedness) []prompt.Data {
+	sections := make([]prompt.Data, 0, len(records))
+	for i, record := range records {
+		sections = append(sections, prompt.Data{
+			Text:  record.Text,
+			Index: i + 1,
+			Score: record.Relatedness,
+		})
 	}
-	return message + question
+
+	return sections
 }
 
 // Ask answers a query using GPT and a slice of relevant texts and embeddings.
@@ -331,19 +402,24 @@ func (u *searchUsecase) Ask(ctx context.Context, query string, records []types.S
 		message = u.QueryMessage(query, records, tokenBudget)
 	}
 
-	resp, err := u.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
-		Model: os.Getenv("OPENAI_GPT_MODEL"), // Adjust the model as needed
-		Messages: []openai.ChatCompletionMessage{
-			{
-				Role:    roleUser,
-				Content: message,
-			},
-		},
-		Temperature: 0,
-	})
+	return u.complete(ctx, []openai.ChatCompletionMessage{{Role: roleUser, Content: message}})
+}
+
+// complete sends the messages to GPT and returns its reply.
+func (u *searchUsecase) complete(ctx context.Context, messages []openai.ChatCompletionMessage) (string, error) {
+	resp, err := u.client.CreateChatCompletion(ctx, chatRequest(messages))
 	if err != nil {
 		return "", err
 	}
 
 	return resp.Choices[0].Message.Content, nil
 }
+
+// chatRequest returns the completion request of the messages.
+func chatRequest(messages []openai.ChatCompletionMessage) openai.ChatCompletionRequest {
+	return openai.ChatCompletionRequest{
+		Model:       os.Getenv("OPENAI_GPT_MODEL"), // Adjust the model as needed
+		Messages:    messages,
+		Temperature: 0,
+	}
+}
@@ -0,0 +1,142 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/yonisaka/similarity/internal/types"
+)
+
+// SearchStream is a search whose sources are retrieved and whose answer is
+// yet to be streamed.
+type SearchStream struct {
+	u         *searchUsecase
+	request   types.SearchRequest
+	turn      *searchTurn
+	started   time.Time
+	retrieval time.Duration
+}
+
+// StreamSearch retrieves the sources of the request like Search. The answer
+// is only asked once the returned stream is sent, so errors of the retrieval
+// are returned before anything is written to the client.
+func (u *searchUsecase) StreamSearch(ctx context.Context, request types.SearchRequest) (*SearchStream, error) {
+	started := time.Now()
+
+	turn, err := u.retrieve(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchStream{
+		u:         u,
+		request:   request,
+		turn:      turn,
+		started:   started,
+		retrieval: time.Since(started),
+	}, nil
+}
+
+// Send emits the sources, then every token delta of the answer, or the
+// extraction in extract mode, then a done event with the usage and latency.
+// It stops at the first error of emit, e.g. once the client went away. The
+// stream API reports no usage, so the tokens are counted from the prompt and
+// the deltas. The whole answer is saved to the session of the request.
+func (s *SearchStream) Send(ctx context.Context, emit func(event types.SearchEvent) error) error {
+	done := types.SearchDone{
+		Latency: types.SearchLatency{
+			RetrievalMs: s.retrieval.Milliseconds(),
+		},
+	}
+
+	if s.request.RetrievalOnly {
+		if err := emit(types.SearchEvent{Event: types.SearchEventSources, Data: s.turn.response.Sources}); err != nil {
+			return err
+		}
+
+		done.Latency.TotalMs = time.Since(s.started).Milliseconds()
+		return emit(types.SearchEvent{Event: types.SearchEventDone, Data: done})
+	}
+
+	messages, err := s.u.usePrompt(s.turn)
+	if err != nil {
+		return err
+	}
+
+	if err := emit(types.SearchEvent{Event: types.SearchEventSources, Data: s.turn.response.Sources}); err != nil {
+		return err
+	}
+
+	if s.turn.mode == AnswerExtract {
+		return s.sendExtraction(ctx, messages, done, emit)
+	}
+
+	stream, err := s.u.client.CreateChatCompletionStream(ctx, chatRequest(messages))
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	var answer strings.Builder
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+
+		if done.Usage.CompletionTokens == 0 {
+			done.Latency.FirstTokenMs = time.Since(s.started).Milliseconds()
+		}
+		done.Usage.CompletionTokens++
+		answer.WriteString(chunk.Choices[0].Delta.Content)
+
+		delta := types.SearchDelta{Content: chunk.Choices[0].Delta.Content}
+		if err := emit(types.SearchEvent{Event: types.SearchEventDelta, Data: delta}); err != nil {
+			return err
+		}
+	}
+
+	s.u.remember(ctx, s.request.SessionID, s.request.Query, answer.String())
+
+	for _, message := range messages {
+		done.Usage.PromptTokens += s.u.NumTokens(message.Content)
+	}
+	done.Usage.TotalTokens = done.Usage.PromptTokens + done.Usage.CompletionTokens
+	done.Latency.TotalMs = time.Since(s.started).Milliseconds()
+
+	return emit(types.SearchEvent{Event: types.SearchEventDone, Data: done})
+}
+
+// sendExtraction emits the extraction of the answer in one event, the
+// function call arguments are not worth streaming. Its usage is the one
+// reported by the API.
+func (s *SearchStream) sendExtraction(ctx context.Context, messages []openai.ChatCompletionMessage, done types.SearchDone, emit func(event types.SearchEvent) error) error {
+	extraction, usage, err := s.u.extract(ctx, messages, s.turn.response.Sources)
+	if err != nil {
+		return err
+	}
+
+	done.Latency.FirstTokenMs = time.Since(s.started).Milliseconds()
+	if err := emit(types.SearchEvent{Event: types.SearchEventExtraction, Data: extraction}); err != nil {
+		return err
+	}
+
+	answerExtraction(s.turn.prompt, s.turn.response, extraction)
+	s.u.remember(ctx, s.request.SessionID, s.request.Query, s.turn.response.Answer)
+
+	done.Usage = usage
+	done.Latency.TotalMs = time.Since(s.started).Milliseconds()
+
+	return emit(types.SearchEvent{Event: types.SearchEventDone, Data: done})
+}
@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/schema"
+	"github.com/yonisaka/similarity/internal/types"
+)
+
+func newStreamingClient(t *testing.T, deltas ...string) openai.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, delta := range deltas {
+			fmt.Fprintf(w, "data: {\"choices\":[{\"index\":0,\"delta\":{\"content\":%q}}]}\n\n", delta)
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	t.Cleanup(server.Close)
+
+	config := openai.DefaultConfig("test")
+	config.BaseURL = server.URL + "/v1"
+
+	return *openai.NewClientWithConfig(config)
+}
+
+func TestSearchStream_Send(t *testing.T) {
+	type test struct {
+		request    types.SearchRequest
+		deltas     []string
+		wantEvents []string
+		wantAnswer string
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given an answer in pieces, When sending, Return sources, deltas then done": func(t *testing.T) test {
+			return test{
+				request:    types.SearchRequest{Query: "warna B1207KDZ?"},
+				deltas:     []string{"Hitam", " Metalic"},
+				wantEvents: []string{types.SearchEventSources, types.SearchEventDelta, types.SearchEventDelta, types.SearchEventDone},
+				wantAnswer: "Hitam Metalic",
+			}
+		},
+		"Given a retrieval only request, When sending, Return sources then done": func(t *testing.T) test {
+			return test{
+				request:    types.SearchRequest{Query: "warna B1207KDZ?", RetrievalOnly: true},
+				deltas:     []string{"never sent"},
+				wantEvents: []string{types.SearchEventSources, types.SearchEventDone},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			hit := types.StringAndRelatedness{QdrantID: "4b1f", Key: "4b1f", Text: "nopol: B1207KDZ; warna: Hitam Metalic"}
+			u := &searchUsecase{
+				client:        newStreamingClient(t, tt.deltas...),
+				embeddingRepo: fakeScopeRepo{},
+				retrievers:    Retrievers{MethodQdrant: fakeRetriever{hits: []types.StringAndRelatedness{hit}}},
+				defaultMethod: MethodQdrant,
+				schemas:       schema.NewLoader(t.TempDir()),
+				prompts:       prompt.NewLoader(t.TempDir()),
+			}
+
+			stream, err := u.StreamSearch(context.Background(), tt.request)
+			assert.NoError(t, err)
+
+			var events []string
+			var answer string
+			var done types.SearchDone
+			err = stream.Send(context.Background(), func(event types.SearchEvent) error {
+				events = append(events, event.Event)
+				switch data := event.Data.(type) {
+				case types.SearchDelta:
+					answer += data.Content
+				case types.SearchDone:
+					done = data
+				}
+				return nil
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, events)
+			assert.Equal(t, tt.wantAnswer, answer)
+			assert.Equal(t, len(tt.wantEvents)-2, done.Usage.CompletionTokens)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/types"
+	"github.com/yonisaka/similarity/pkg/tokenizer"
+)
+
+// byteEncoding counts every byte as a token.
+func byteEncoding(t *testing.T) *tokenizer.Encoding {
+	ranks := make(map[string]int)
+	for i := 0; i < 256; i++ {
+		ranks[string([]byte{byte(i)})] = i
+	}
+
+	e, err := tokenizer.NewEncoding(tokenizer.CL100KBase, ranks)
+	assert.NoError(t, err)
+
+	return e
+}
+
+func TestQueryMessage(t *testing.T) {
+	type test struct {
+		records       []types.StringAndRelatedness
+		budget        int
+		wantUsed      int
+		wantTruncated bool
+		wantContains  []string
+	}
+
+	p := prompt.Default()
+	introduction, _ := p.Introduction(prompt.Data{})
+	question, _ := p.Question(prompt.Data{Question: "warna?"})
+	empty, _ := p.Section(prompt.Data{})
+	base := len(introduction) + len(question)
+	section := len(empty)
+
+	tests := map[string]func(t *testing.T) test{
+		"Given records within the budget, When packing, Return all of them": func(t *testing.T) test {
+			return test{
+				records:      []types.StringAndRelatedness{{Text: "warna: Hitam"}, {Text: "warna: Putih"}},
+				budget:       base + 2*(section+12),
+				wantUsed:     2,
+				wantContains: []string{"warna: Hitam", "warna: Putih"},
+			}
+		},
+		"Given a record over the budget, When packing, Return it truncated": func(t *testing.T) test {
+			return test{
+				records:       []types.StringAndRelatedness{{Text: "warna: Hitam"}, {Text: strings.Repeat("x", 100)}},
+				budget:        base + 2*section + 12 + 20,
+				wantUsed:      2,
+				wantTruncated: true,
+				wantContains:  []string{"warna: Hitam", "\"\"\"\n" + strings.Repeat("x", 20) + "\n\"\"\""},
+			}
+		},
+		"Given too little budget left, When packing, Return the record dropped": func(t *testing.T) test {
+			return test{
+				records:      []types.StringAndRelatedness{{Text: "warna: Hitam"}, {Text: strings.Repeat("x", 100)}},
+				budget:       base + 2*section + 12 + minSectionTokens - 1,
+				wantUsed:     1,
+				wantContains: []string{"warna: Hitam"},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			u := &searchUsecase{encoding: byteEncoding(t)}
+
+			got, err := u.queryMessage(p, prompt.Data{Question: "warna?"}, recordSections(tt.records), tt.budget)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantUsed, got.used)
+			assert.Equal(t, tt.wantTruncated, got.truncated)
+			assert.LessOrEqual(t, u.NumTokens(got.text), tt.budget)
+			for _, want := range tt.wantContains {
+				assert.Contains(t, got.text, want)
+			}
+		})
+	}
+}
@@ -4,36 +4,50 @@ import (
 	"context"
 	"github.com/sashabaranov/go-openai"
 	"github.com/yonisaka/similarity/internal/entities/repository"
+	"github.com/yonisaka/similarity/internal/prompt"
+	"github.com/yonisaka/similarity/internal/schema"
 	"github.com/yonisaka/similarity/internal/types"
-	"github.com/yonisaka/similarity/pkg/elasticsearch"
+	"github.com/yonisaka/similarity/pkg/embedder"
 	"github.com/yonisaka/similarity/pkg/logger"
-	"github.com/yonisaka/similarity/pkg/qdrant"
+	"github.com/yonisaka/similarity/pkg/tokenizer"
 )
 
 type searchUsecase struct {
 	client        openai.Client
-	qdrantClient  qdrant.QdrantClient
+	embedder      embedder.Embedder
 	embeddingRepo repository.EmbeddingRepo
-	esClient      elasticsearch.ESClient
+	sessionRepo   repository.SessionRepo
+	retrievers    Retrievers
+	defaultMethod string
+	schemas       *schema.Loader
+	prompts       *prompt.Loader
+	encoding      *tokenizer.Encoding
 	logger        logger.Logger
 }
 
-func NewSearchUsecase(client openai.Client, qdrantClient qdrant.QdrantClient, embeddingRepo repository.EmbeddingRepo, esClient elasticsearch.ESClient, logger logger.Logger) SearchUsecase {
+func NewSearchUsecase(client openai.Client, embedder embedder.Embedder, embeddingRepo repository.EmbeddingRepo, sessionRepo repository.SessionRepo, retrievers Retrievers, defaultMethod string, schemas *schema.Loader, prompts *prompt.Loader, encoding *tokenizer.Encoding, logger logger.Logger) SearchUsecase {
 	return &searchUsecase{
 		client:        client,
-		qdrantClient:  qdrantClient,
+		embedder:      embedder,
 		embeddingRepo: embeddingRepo,
-		esClient:      esClient,
+		sessionRepo:   sessionRepo,
+		retrievers:    retrievers,
+		defaultMethod: defaultMethod,
+		schemas:       schemas,
+		prompts:       prompts,
+		encoding:      encoding,
 		logger:        logger,
 	}
 }
 
 type SearchUsecase interface {
-	Search(ctx context.Context, query string) (string, error)
-	StringsRankedByRelatedness(query string, records []repository.Embedding, topN int) ([]types.StringAndRelatedness, error)
-	EmbeddingQuery(query string) ([]float64, error)
+	Search(ctx context.Context, request types.SearchRequest) (*types.SearchResponse, error)
+	StreamSearch(ctx context.Context, request types.SearchRequest) (*SearchStream, error)
+	StringsRankedByRelatedness(ctx context.Context, query string, records []repository.Embedding, topN int) ([]types.StringAndRelatedness, error)
+	EmbeddingQuery(ctx context.Context, query string) ([]float64, error)
 	NumTokens(text string) int
 	QueryMessage(query string, records []types.StringAndRelatedness, tokenBudget int) string
 	Ask(ctx context.Context, query string, records []types.StringAndRelatedness, tokenBudget int) (string, error)
+	Extract(ctx context.Context, query string, records []types.StringAndRelatedness, tokenBudget int) (*types.Extraction, error)
 	LoadJSONDataSources(path string) ([]repository.Embedding, error)
 }
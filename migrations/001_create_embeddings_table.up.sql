@@ -1,8 +1,9 @@
-CREATE TABLE embeddings (
+-- databases set up before the migrations already have the table
+CREATE TABLE IF NOT EXISTS embeddings (
     id SERIAL PRIMARY KEY,
     scope VARCHAR(50),
     combined TEXT,
     embeddings TEXT,
     n_tokens INT,
     created_at TIMESTAMP
-);
\ No newline at end of file
+);
@@ -0,0 +1,16 @@
+-- every schema migration may run again on a database migrated by
+-- golang-migrate, see Upgrading in the README
+CREATE TABLE IF NOT EXISTS import_jobs (
+    id SERIAL PRIMARY KEY,
+    scope VARCHAR(50),
+    status VARCHAR(20),
+    rows_total INT DEFAULT 0,
+    rows_embedded INT DEFAULT 0,
+    rows_skipped INT DEFAULT 0,
+    rows_failed INT DEFAULT 0,
+    tokens_used INT DEFAULT 0,
+    error TEXT,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP,
+    finished_at TIMESTAMP
+);
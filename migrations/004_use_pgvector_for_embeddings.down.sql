@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS embeddings_scope_idx;
+DROP INDEX IF EXISTS embeddings_embeddings_hnsw_idx;
+
+ALTER TABLE embeddings
+    ALTER COLUMN embeddings TYPE TEXT USING embeddings::text;
@@ -0,0 +1,14 @@
+-- requires the pgvector extension to be available on the server, 0.8 or
+-- later for the iterative index scans of the searches.
+-- 1536 is the length of text-embedding-3-small and ada-002 vectors. Another
+-- embedder needs another column type, the API refuses to start until then,
+-- see Vector dimensions in the README.
+CREATE EXTENSION IF NOT EXISTS vector;
+
+ALTER TABLE embeddings
+    ALTER COLUMN embeddings TYPE vector(1536) USING embeddings::vector;
+
+CREATE INDEX IF NOT EXISTS embeddings_embeddings_hnsw_idx ON embeddings
+    USING hnsw (embeddings vector_cosine_ops);
+
+CREATE INDEX IF NOT EXISTS embeddings_scope_idx ON embeddings (scope);
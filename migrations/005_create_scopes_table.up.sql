@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS scopes (
+    name VARCHAR(50) PRIMARY KEY,
+    created_at TIMESTAMP
+);
+
+INSERT INTO scopes (name, created_at)
+SELECT scope, MIN(created_at) FROM embeddings WHERE scope IS NOT NULL GROUP BY scope
+ON CONFLICT (name) DO NOTHING;
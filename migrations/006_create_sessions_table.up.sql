@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS sessions (
+    id VARCHAR(64) PRIMARY KEY,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS sessions_updated_at_idx ON sessions (updated_at);
+
+CREATE TABLE IF NOT EXISTS session_turns (
+    id SERIAL PRIMARY KEY,
+    session_id VARCHAR(64) REFERENCES sessions (id) ON DELETE CASCADE,
+    role VARCHAR(20),
+    content TEXT,
+    created_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS session_turns_session_id_idx ON session_turns (session_id, id);
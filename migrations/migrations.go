@@ -0,0 +1,30 @@
+// Package migrations embeds the SQL migrations of the service. Schema
+// migrations live at the top level, data seeds in seeds/ so they can be
+// applied separately.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed *.sql
+var schemaFS embed.FS
+
+//go:embed seeds/*.sql
+var seedFS embed.FS
+
+// Schema returns the schema migrations.
+func Schema() fs.FS {
+	return schemaFS
+}
+
+// Seeds returns the seed migrations.
+func Seeds() fs.FS {
+	sub, err := fs.Sub(seedFS, "seeds")
+	if err != nil {
+		panic(err)
+	}
+
+	return sub
+}
@@ -0,0 +1 @@
+DELETE FROM embeddings WHERE scope = 'lelang' AND id <= 5;
@@ -1,3 +1,4 @@
+-- databases seeded before the migrations already hold these rows
 INSERT INTO embeddings (id, scope, combined, embeddings, n_tokens, created_at) VALUES (1, 'lelang', 'Stock No: BA00001023J09; ID Lelang: BEKASI-CAR-2311150404; Cabang: Caready Bekasi; Tanggal: 15/11/23; Bulan: November; Lot: 0    229
 1    228
 Name: lot, dtype: int64; Seller No: SC1900000026; Seller Name: PT Dipo Star Finance Karawang; NPWP Penjual: 0    1.234570e+14
@@ -15,7 +16,7 @@ Name: kapasitas_mesin, dtype: int64; Tipe Bahan Bakar: Diesel; Odometer: 108585;
 Name: stnk_exp_date, dtype: float64; Faktur: Tidak Ada; Kwitansi Blank: Tidak Ada; FC KTP: Tidak Ada; Form A: Tidak Ada; Status Keur: Tidak Ada; Note 1: T 8324 AP
 KM 108585
 
-Full body baret penyok, bak kanan kiri penyok,karat,tools dan dongkrak t.a; Note 2: UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI', '[-0.023839695379137993, 0.0029446871485561132, 0.0014853798784315586, -0.0015735671622678638, -0.03172900155186653, -0.016600674018263817, -0.051188476383686066, 0.030011268332600594, -0.0091407997533679, -0.06620638072490692, 0.06409601867198944, -0.045421797782182693, -0.0013987263664603233, -0.007564164698123932, 0.04252618923783302, 0.05825572460889816, 0.03327496349811554, -0.009944453835487366, -0.04674690589308739, -0.013373786583542824, 0.019753942266106606, 0.02564331702888012, -0.015066982246935368, -0.032440636307001114, 0.035189010202884674, -0.020097488537430763, -0.025913245975971222, -0.06272183358669281, 0.007361717522144318, -0.0735190212726593, -0.005831093993037939, -0.033962056040763855, 0.019459472969174385, -0.0015099189477041364, -0.031017370522022247, 0.028637081384658813, 0.06120041385293007, 0.0010850864928215742, -0.007619377691298723, -0.04105384647846222, -0.027532823383808136, -0.03521354869008064, 0.023471608757972717, 0.05673430487513542, 0.02450224943459034, 0.01425719354301691, -0.010778781957924366, -0.029373252764344215, 0.003803553991019726, 0.033348578959703445, 0.02412189543247223, 0.035336244851350784, 0.02099316380918026, 0.05693061649799347, -0.025741472840309143, 0.015692727640271187, -0.011803287081420422, 0.03435468301177025, 0.07366625219583511, 0.012159103527665138, -0.019484013319015503, -0.024551328271627426, -0.0026762911584228277, 0.05246450752019882, -0.020931817591190338, -0.0364895798265934, -0.0426979623734951, 0.027213815599679947, -0.049986064434051514, -0.008275797590613365, 0.006883205845952034, 0.05751955509185791, 0.006643950007855892, -0.002636415185406804, -0.011766478419303894, 0.015238755382597446, 0.022293735295534134, -0.03506631404161453, -0.013655985705554485, 0.0058034872636199, -0.011870769783854485, -0.018404293805360794, -0.04996152222156525, -0.02374153956770897, -0.002999899908900261, -0.05329883471131325, -0.08308925479650497, -0.0350908525288105, -0.04210902377963066, -0.04686960205435753, -0.02198699675500393, -0.022330543026328087, 0.0040274728089571, 0.04458747059106827, 0.016183508560061455, -0.007306504528969526, -0.043262358754873276, -0.02532430924475193, -0.012557863257825375, 0.03528716787695885, 0.0208459310233593, 0.05781402066349983, 0.0364895798265934, -0.012232720851898193, 0.012220451608300209, 0.024539059028029442, -0.002665555337443948, -0.03082105703651905, -0.031679924577474594, 0.02961864322423935, -0.05997345969080925, -0.006472176872193813, 0.014846130274236202, 0.06316353380680084, 0.007306504528969526, 0.010858533903956413, 0.021471675485372543, 0.01098122913390398, -0.018723301589488983, -0.057912178337574005, 0.003217684105038643, 0.03371666744351387, -0.00354896136559546, -0.08667195588350296, -0.036244191229343414, 0.02657580003142357, -0.011140733025968075, -0.01851472072303295, -0.01381548959761858, -0.007202213630080223, -0.0019723267760127783, -0.03332404047250748, 0.008441436104476452, 0.01318974420428276, -0.011576300486922264, -0.054574865847826004, -0.0182815995067358, -0.023226218298077583, -0.02763097919523716, -0.030183041468262672, 0.02096862532198429, -0.004202313721179962, 0.002754509449005127, -0.013545560650527477, -0.07528582960367203, 0.03099283203482628, -0.000889540882781148, 0.0007008968386799097, 0.021950187161564827, 0.006588737480342388, -0.020072950050234795, 0.022698629647493362, -0.056979693472385406, -0.07989917695522308, 0.011472010053694248, -0.009134664200246334, -0.0004129463341087103, -0.004628680180758238, -0.018355216830968857, 0.017447270452976227, -0.014159036800265312, -0.0010383089538663626, 0.013987263664603233, 0.01669882982969284, 0.005453805904835463, 0.0137418732047081, 0.0101898442953825, -0.015692727640271187, -0.07710172235965729, -0.013165204785764217, 0.029324175789952278, -0.007656186353415251, -0.037004899233579636, -0.05231727287173271, -0.028440769761800766, -0.01718961074948311, 0.027655519545078278, -0.021471675485372543, 0.005472210235893726, 0.01839202456176281, -0.0033894574735313654, -0.018490180373191833, 0.018895074725151062, 0.006193045061081648, -0.020539192482829094, -0.019226351752877235, -0.0026778248138725758, 0.05290621146559715, 0.010447503998875618, 0.025618776679039, -0.0009761944529600441, 0.03231794014573097, -0.004435434937477112, 0.026894807815551758, -0.0006092588300816715, -0.04728676751255989, 0.011294101364910603, 0.024674024432897568, -0.022625012323260307, -0.005778948310762644, 0.012386090122163296, -0.02213423140347004, 0.032121628522872925, 0.05614536628127098, -0.04498009383678436, 0.020539192482829094, -0.012281798757612705, 0.018821457400918007, 0.06826765835285187, -0.017520887777209282, 0.10011935979127884, 0.016674289479851723, -0.005150135140866041, 0.038845330476760864, -0.004263661336153746, 0.014870669692754745, -0.019876638427376747, 0.020600540563464165, -0.01225112471729517, 0.009404594078660011, -0.014870669692754745, -0.019410395994782448, 0.010429100133478642, -0.0011195945553481579, -0.01693195104598999, 0.01250265073031187, 0.03386390209197998, 0.015324641950428486, 0.016796985641121864, -0.04659967124462128, -0.030158502981066704, -0.02052692323923111, 0.03604787588119507, -0.017385922372341156, 0.002206981647759676, -0.0038372953422367573, 0.020011601969599724, 0.03280872106552124, 0.011042576283216476, -0.013545560650527477, -0.005254426039755344, 0.004211516119539738, -7.840996113372967e-05, 0.001779081765562296, 0.04932350665330887, 0.0015889040660113096, -0.002404827857390046, -0.012238855473697186, -0.008533457294106483, 0.03140999376773834, 0.004073483869433403, 0.024489980190992355, -0.022440969944000244, -0.019042309373617172, -0.003315840382128954, -0.000661787751596421, -0.025226151570677757, -0.01166832260787487, 0.015827693045139313, -0.03587610274553299, -0.028023604303598404, 0.009465942159295082, -0.022146500647068024, -0.023962391540408134, -0.017668122425675392, -0.03614603355526924, 0.027434667572379112, 0.01906684786081314, 0.0017208014614880085, -0.013987263664603233, -0.029716800898313522, -0.026502182707190514, 0.0592372864484787, 0.032268863171339035, -0.043556828051805496, -0.03096829168498516, 0.0227477066218853, 0.014907478354871273, 0.002648684661835432, 0.01456393115222454, -0.025741472840309143, -0.006999766454100609, 0.0037422063760459423, 0.01985209807753563, 0.007619377691298723, 0.012514919973909855, -0.005640916060656309, -0.01783989556133747, -0.036857664585113525, 0.037765610963106155, 0.02249004691839218, -0.022256925702095032, 0.027385588735342026, -0.024894874542951584, -0.007999733090400696, -0.01359463855624199, 0.017140531912446022, -0.04534818232059479, 0.0165761336684227, 0.019103657454252243, 0.06262367963790894, 0.003561230842024088, -0.01064381655305624, -0.016122162342071533, -0.013692794367671013, 0.015201946720480919, -0.003420131281018257, -0.037152133882045746, -0.029790416359901428, -0.03023212030529976, 0.009705197997391224, 0.016158970072865486, 0.007306504528969526, -0.051826491951942444, 0.05207188427448273, -0.001880305353552103, -0.006318807601928711, -0.012748041190207005, 0.05251358449459076, -0.04429300129413605, 0.02547154203057289, 0.0011518020182847977, -0.0010306404437869787, -0.004128696396946907, -0.01625712588429451, -0.016600674018263817, -0.0021379655227065086, -0.011447470635175705, 0.010797185823321342, -0.012919814325869083, -0.008134697563946247, -0.022772246971726418, 0.020539192482829094, -0.0051102591678500175, 0.0070549794472754, 0.01637982204556465, -0.028931550681591034, 0.01631847396492958, -0.006466041784733534, -0.02807268314063549, -0.050894007086753845, 0.004640949424356222, -0.0006939952727407217, 0.011999599635601044, -0.026281332597136497, -0.005607174709439278, 0.024723101407289505, 0.00976654514670372, 0.006128630135208368, -0.033029571175575256, -0.01795032061636448, -0.008165371604263783, -0.0005862534744665027, 0.041569165885448456, -0.01333697885274887, -0.02044103667140007, 0.008962891064584255, 0.01669882982969284, -0.028907010331749916, 0.04429300129413605, 0.010926015675067902, 0.005932317581027746, -0.006030473858118057, 0.025447003543376923, 0.015778614208102226, 0.03877171128988266, -0.0005613309913314879, 0.015987196937203407, 0.03253879025578499, 0.0023649518843740225, 0.06012069433927536, 0.01672336831688881, -0.010576333850622177, -0.007410795893520117, -0.0017560763517394662, -0.03756929934024811, 0.0350908525288105, 0.040955688804388046, -0.012048677541315556, 0.0019048444228246808, 0.07013262808322906, -0.05055046081542969, 0.01623258739709854, 0.01701783761382103, 0.006527389399707317, 0.019030040130019188, 0.02289494127035141, 0.07346994429826736, 0.014932016842067242, -0.06910198926925659, -0.0161098912358284, -0.004386356566101313, 0.01988890767097473, -0.038403626531362534, 0.012968892231583595, 0.012067082338035107, 0.049372587352991104, 0.022674091160297394, -0.0331522673368454, -0.0029170806519687176, -0.012232720851898193, -0.01456393115222454, -0.0029278164729475975, 0.00023733871057629585, 0.004867935553193092, 0.03970419615507126, 0.024575866758823395, 0.07508952170610428, 0.0037268695887178183, -0.04002320393919945, 0.049372587352991104, -0.04655059427022934, 0.02126309461891651, 0.015631379559636116, 0.005849498324096203, -0.0277536753565073, -0.030600206926465034, 0.07081972062587738, -0.021717067807912827, -0.004021338187158108, 0.034109290689229965, -0.007778881583362818, -0.018379755318164825, 0.01999933272600174, -0.04166731983423233, -0.005183876026421785, 0.017533157020807266, -0.012324742041528225, -0.001776014338247478, -0.02794998697936535, 0.007858633995056152, -0.010459774173796177, -0.054574865847826004, 0.04304150864481926, -0.04237895458936691, 0.015692727640271187, 0.010723568499088287, 0.0277536753565073, -0.040808454155921936, -0.04971613362431526, 0.04166731983423233, 0.003435468301177025, 0.010649951174855232, 0.05948267877101898, 0.0037422063760459423, -0.024600407108664513, 0.0063310773111879826, 0.04605981335043907, -0.030624745413661003, -0.0006426165928132832, -0.025864167138934135, 0.001231553964316845, 0.012858467176556587, 0.04777754843235016, -0.047532156109809875, 0.0070549794472754, -0.011453605256974697, -0.02289494127035141, 0.022588202729821205, 0.003637915477156639, 0.010251191444694996, 0.0298885740339756, 0.0360233373939991, 0.0011341646313667297, -0.006858666893094778, -0.01425719354301691, -0.06856212764978409, -0.01342286542057991, 0.0270175039768219, 0.03352035582065582, -0.011073250323534012, 0.019520821049809456, -0.017238689586520195, -0.005990597885102034, -0.0033679858315736055, 0.04313966631889343, -0.049667056649923325, -0.023729270324110985, -0.02944687008857727, -0.05482025817036629, 0.002884873189032078, -0.015594571828842163, -0.03668589144945145, -0.01074810791760683, -0.014539392665028572, 0.048317406326532364, 0.0149442870169878, 0.015275564044713974, -0.06105317920446396, -0.015214215964078903, -0.04078391566872597, 0.018428832292556763, 0.060660552233457565, -0.011692861095070839, -0.009447537362575531, -0.01625712588429451, 0.03231794014573097, -0.010349348187446594, -0.015545493923127651, 0.007269696332514286, -0.04183909669518471, -0.03155722841620445, 0.0025336577091366053, -0.05094308406114578, -0.0012967358343303204, 0.013312439434230328, -0.020367419347167015, 0.02420778200030327, -0.012085486203432083, -0.01929996907711029, 0.021410329267382622, 0.037471141666173935, -0.029226018115878105, -0.01434308011084795, 0.019962524995207787, -0.011042576283216476, 0.017655853182077408, 0.03678404912352562, -0.033495813608169556, -0.028907010331749916, 0.012281798757612705, 0.0044783782213926315, 0.03810915723443031, -0.04061214253306389, 0.020011601969599724, -0.037152133882045746, -0.00899356510490179, -0.11111285537481308, -0.006956823170185089, 0.003328109858557582, 0.03337312117218971, -0.002736105117946863, 0.03231794014573097, -0.0024968492798507214, -0.013705064542591572, -0.019042309373617172, 0.048734571784734726, 0.023348914459347725, -0.02029380202293396, -0.024907143786549568, -0.015300103463232517, 0.008177640847861767, 0.01318974420428276, -0.03680858761072159, -0.026796652004122734, -0.025226151570677757, 0.0027100322768092155, -0.0004746774211525917, -0.0016456505982205272, 0.018747840076684952, 0.04289427399635315, 0.0076009733602404594, -0.02836715243756771, 0.022931750863790512, 0.012968892231583595, 0.008079485036432743, -0.013987263664603233, -0.006834127940237522, -0.006625545676797628, -0.011312506161630154, 0.0005333410808816552, 0.023140331730246544, 0.030600206926465034, 0.023790616542100906, 0.01985209807753563, 0.0022606607526540756, -0.008153102360665798, 0.006398559547960758, 0.004594938829541206, -0.0502314530313015, -0.0298885740339756, 0.00247384374961257, -0.0014309338293969631, 0.009521154686808586, 0.02532430924475193, 0.01936131715774536, -0.052709899842739105, 0.003306638216599822, 0.022563664242625237, -0.048121094703674316, -0.019287699833512306, -0.01760677434504032, 0.0016671223565936089, 0.039900507777929306, 0.00736785214394331, 0.06561744213104248, -0.00866842269897461, 0.03673497214913368, 0.003199279773980379, 0.016502516344189644, -0.00505197886377573, -0.0350908525288105, -0.007024305406957865, 0.012152968905866146, 0.00987083651125431, -0.011422932147979736, 0.014784783124923706, 0.011895308271050453, -0.017152801156044006, -0.006312672980129719, -0.0031563364900648594, 0.011275697499513626, -0.014760243706405163, 0.012723501771688461, 0.013091587461531162, -0.024686293676495552, 0.0027330375742167234, 0.004463041201233864, -0.024379555135965347, 0.011116193607449532, 0.012416764162480831, 0.05555642768740654, 0.027680058032274246, -0.0052881669253110886, -0.042354416102170944, 0.025741472840309143, -0.02067415788769722, -0.01845337264239788, -0.011570165865123272, 0.005475277546793222, -0.021398060023784637, 0.01585223153233528, 0.036244191229343414, -0.018146634101867676, -0.0022637280635535717, 0.020183375105261803, 0.03271056339144707, -0.0332258865237236, -0.01985209807753563, 0.029814956709742546, -0.01473570428788662, -0.031532689929008484, 0.02403600886464119, -0.00659487210214138, 0.017201879993081093, -0.007919981144368649, 0.01792578212916851, -0.04917627200484276, 0.008355549536645412, 0.006717567332088947, -0.0326860249042511, -0.01526329480111599, -0.01634301245212555, -0.0459616556763649, 0.020244723185896873, -0.0369558222591877, -0.010637681931257248, 0.03023212030529976, -0.03293141722679138, -0.022882672026753426, 0.010508852079510689, -0.045519955456256866, 0.017447270452976227, -0.039458807557821274, 0.02145940624177456, -0.02210969105362892, 0.0019431867403909564, -0.004144033417105675, 0.016711099073290825, 0.02003614231944084, -0.00798132922500372, 0.03955696150660515, 0.00619917968288064, -0.007975193671882153, -0.03818277642130852, 0.014011802151799202, -0.02137351967394352, 0.013017971068620682, 0.05477117747068405, 0.004358750302344561, 0.017214149236679077, 0.0189809612929821, -0.004220718052238226, -0.0006993631832301617, 0.008294201456010342, 0.026011401787400246, 0.0027514419052749872, 0.004729903768748045, -0.02790091000497341, -0.0025029839016497135, 0.0244163628667593, -0.020490113645792007, -0.04078391566872597, 0.006686893291771412, 0.0020398092456161976, 0.03307865187525749, 0.01342286542057991, 0.0421581044793129, 0.028637081384658813, 0.002789784222841263, 0.02137351967394352, 0.02623225376009941, 0.06041516363620758, -0.009067182429134846, 0.023876504972577095, 0.05094308406114578, -0.007889307104051113, 0.008281932212412357, -0.024870336055755615, -0.07008355110883713, -0.010760377161204815, -0.020772313699126244, 0.003959990572184324, -0.005757476668804884, -0.03219524398446083, -0.017815357074141502, 0.012036408297717571, -0.005487546790391207, 0.039139799773693085, -0.01124502345919609, 0.0038280931767076254, -0.016833793371915817, -0.010594738647341728, -0.005493681877851486, -0.019839828833937645, 0.018674222752451897, 0.002226919634267688, -0.022845864295959473, 0.028931550681591034, 0.025815090164542198, -0.026747573167085648, 0.031213682144880295, 0.04360590875148773, -0.023950120434165, -0.015091520734131336, 0.01640436053276062, 0.02477218024432659, 0.027434667572379112, 0.030771980062127113, 0.005128663498908281, 0.015422798693180084, 0.018993230536580086, 0.011208214797079563, -0.012613075785338879, -0.006521254777908325, 0.01879691891372204, -0.03617057204246521, 0.030109424144029617, -0.01156403124332428, -0.012429033406078815, 0.0270175039768219, -0.04974067211151123, -0.009883105754852295, 0.006064214743673801, -0.03298049420118332, 0.0015091521199792624, -0.011441336013376713, 0.005999799817800522, -0.028440769761800766, -0.010288000106811523, -0.019643517211079597, 0.008515053428709507, 0.029667722061276436, -0.007656186353415251, 0.024318207055330276, -0.05673430487513542, -0.011410661973059177, -0.0011732737766578794, 0.018784649670124054, 0.01830613799393177, 0.0028204580303281546, -0.01649024710059166, 0.008134697563946247, 0.002406361512839794, 0.013386056758463383, -0.011324775405228138, -0.05982622504234314, -0.028146300464868546, -0.03513993322849274, -0.0007860166952013969, 0.024170972406864166, -0.0011402993695810437, 0.003668589284643531, -0.0208459310233593, 0.016158970072865486, -0.021790683269500732, -0.018956422805786133, 0.011883039027452469, -0.012195912189781666, 0.0298885740339756, 0.0022683292627334595, 0.01596265845000744, -0.004263661336153746, 0.0033679858315736055, 0.005628646817058325, 0.012711232528090477, -0.008214449509978294, 0.02409735508263111, -0.006717567332088947, -0.003205414628610015, 0.014981095679104328, -0.00014253742119763047, 0.008711365982890129, -0.030305737629532814, 0.008557996712625027, 0.023704729974269867, -0.012134564109146595, -0.041569165885448456, 0.0004777447902597487, 0.023631112650036812, -0.03295595571398735, 0.008760443888604641, -0.028489846736192703, 0.009232820942997932, 0.029962191358208656, -0.042207181453704834, -0.0027192344423383474, 0.04841556400060654, 0.025986863300204277, -0.004736038390547037, -0.016711099073290825, 0.029176941141486168, 0.008999699726700783, 0.02927509695291519, 0.02851438708603382, -0.02426912821829319, 0.03445283696055412, 0.016625212505459785, -0.015091520734131336, -0.0007039642659947276, 0.055163804441690445, -0.03756929934024811, 0.014956556260585785, 0.004567332100123167, -0.018711032345891, 0.0033710531424731016, -0.017741739749908447, 0.02149621583521366, -0.02228146605193615, 0.016060814261436462, 0.014318540692329407, -0.028416229411959648, -0.0473603829741478, -0.05221911519765854, 0.00013985346595291048, 0.02382742613554001, 0.016220318153500557, -0.00488327257335186, 0.0030060347635298967, -0.011343180201947689, 0.04132377356290817, 0.023631112650036812, 0.05688153952360153, -0.004463041201233864, 0.022612743079662323, 0.02061280980706215, -0.03477184474468231, -0.008760443888604641, -0.02809722162783146, 0.032882340252399445, -0.019520821049809456, 0.007711399346590042, 0.011539492756128311, 0.018588336184620857, 0.006852532271295786, 0.03663681447505951, -0.024870336055755615, -0.03347127512097359, 0.018036209046840668, -0.01473570428788662, -0.022477777674794197, 0.02447771094739437, 0.007091788109391928, -0.02172933705151081, 0.012085486203432083, -0.005119461100548506, 0.03391297906637192, -0.00736785214394331, -0.00826352834701538, -0.0021410328336060047, 0.0012660620268434286, 0.0011364651145413518, 0.028318073600530624, -0.050746772438287735, 0.0044753109104931355, -0.00013391040556598455, 0.013766411691904068, 0.017729470506310463, -0.01023278757929802, -0.047262225300073624, -1.7337899407721125e-05, -0.03376574441790581, 0.01023278757929802, 0.036391425877809525, 0.02245323918759823, 0.0021809088066220284, 0.01985209807753563, 0.006742106284946203, 0.004631747491657734, -0.009570232592523098, 0.010171439498662949, 0.009508885443210602, 0.02479671873152256, 0.013692794367671013, -0.02642856538295746, -0.008024272508919239, 0.0006851765210740268, 0.013901377096772194, 0.04686960205435753, -0.00987083651125431, -0.008067215792834759, -0.0029600239358842373, -0.03214616701006889, 0.03948334604501724, 0.027508284896612167, -0.01350875198841095, -0.041127461940050125, -0.020661886781454086, 0.05143386870622635, 0.014809321612119675, 0.006508985534310341, 0.010214382782578468, 0.0277536753565073, 0.007134731393307447, 0.017704930156469345, -0.022404160350561142, -0.017876705154776573, 0.020158836618065834, 0.05555642768740654, -0.03437922149896622, -0.028416229411959648, -4.152948167757131e-05, -0.0388944074511528, 0.041863635182380676, 0.04134831577539444, -0.017361383885145187, -0.01282165851444006, 0.010944420471787453, 0.0032452906016260386, -0.011545627377927303, -0.0265512615442276, 0.01579088345170021, 0.02687026932835579, 0.03815823793411255, -0.024465441703796387, -0.02944687008857727, -0.015803154557943344, -0.013606907799839973, 0.024404093623161316, 0.009711332619190216, 0.000993065070360899, 0.026305871084332466, 0.06998539716005325, -0.030305737629532814, 0.005686926655471325, -0.0006472176755778491, -0.00030405426514334977, -0.02718927711248398, 0.01526329480111599, -0.013054778799414635, 0.024698562920093536, 0.011011902242898941, 0.017324576154351234, -0.010116226971149445, -0.0232139490544796, 0.02380288764834404, 0.049078118056058884, 0.008416896685957909, 0.022121962159872055, 0.0028173907194286585, -0.006392424926161766, -0.007594838738441467, -0.017790816724300385, -0.032882340252399445, -0.012183642946183681, -0.022158769890666008, 0.01387683767825365, -0.03020758181810379, 0.06812042742967606, 0.02164345048367977, 0.014662087894976139, -0.0010337078711017966, -0.03253879025578499, 0.0008128563058562577, -0.018784649670124054, -0.018036209046840668, 0.038550861179828644, -0.033790282905101776, 0.03239155933260918, -0.0066010067239403725, 0.005435401573777199, 0.01456393115222454, 0.06532297283411026, -0.018269328400492668, -0.0132756307721138, 0.008502784185111523, 0.03818277642130852, 0.014478044584393501, 0.02424458973109722, 0.023054445162415504, 0.004395558964461088, 0.0733717828989029, 0.01783989556133747, -0.0021517686545848846, 0.009613175876438618, -0.007245156913995743, 0.004959957208484411, 0.030477510765194893, 0.002440102631226182, 0.049667056649923325, 0.011232754215598106, -0.0012583936331793666, -0.011134597472846508, -0.012490380555391312, -0.018993230536580086, -0.012330876663327217, 0.0014623745810240507, -0.010355482809245586, -0.027581902220845222, -0.01748408004641533, -0.02760644070804119, -0.014956556260585785, -0.024956222623586655, 0.024428632110357285, -0.008877004496753216, 0.0015168205136433244, -0.011698996648192406, -0.046305201947689056, -0.00714086601510644, -0.03278418257832527, 0.014956556260585785, 0.010950555093586445, 0.002111892681568861, 0.009987397119402885, -0.016060814261436462, -0.01999933272600174, 0.020306071266531944, -0.03553255647420883, 0.0007292701629921794, 0.0024124961346387863, 0.004490647930651903, 0.006748241372406483, -0.04620704799890518, 0.01194438710808754, -0.003432400757446885, 0.027827292680740356, -0.019140465185046196, 0.01619577966630459, -0.012318607419729233, 0.028808854520320892, -0.012711232528090477, -0.0060120695270597935, 0.02069869637489319, 0.003087320365011692, 0.02718927711248398, -0.028637081384658813, 0.030452972277998924, 0.023348914459347725, -0.005953789222985506, -0.005147067364305258, -0.062034741044044495, -0.0033802553080022335, -0.012686693109571934, -0.007294235285371542, 0.0016993298195302486, 0.02623225376009941, -0.0031164605170488358, -0.0002542092988733202, 0.00815923698246479, 0.012263394892215729, -0.005208414979279041, -0.0014938152162358165, 0.05383869633078575, -0.022625012323260307, 0.02944687008857727, 0.005021304823458195, 0.00016170856542885303, 0.006027406081557274, -0.005920047871768475, 0.007754342630505562, -0.040832992643117905, -0.02657580003142357, -0.014723435044288635, 0.02473537065088749, 0.015422798693180084, 0.0303548164665699, 0.0004551228485070169, 0.008337144739925861, -0.03325042501091957, 0.012011868879199028, -0.0326860249042511, -0.010699030011892319, -0.03737298771739006, -0.043556828051805496, -0.021275363862514496, 0.030183041468262672, -0.02023245394229889, 0.033962056040763855, 0.017913512885570526, 0.009447537362575531, 0.04198632761836052, -0.03909071907401085, 0.005530490539968014, 0.03386390209197998, 0.025066647678613663, -0.009797219187021255, 0.008692961186170578, 0.03185169771313667, 0.006349481642246246, 7.39047463866882e-05, -0.02126309461891651, 0.019189544022083282, 0.016981028020381927, 0.02596232481300831, -0.002437035320326686, -0.011883039027452469, 0.0038188910111784935, -0.025594238191843033, 0.017385922372341156, -9.389065962750465e-05, -0.02312806248664856, -0.025545159354805946, 0.0312873013317585, 0.0001724444009596482, -0.0003071216633543372, 0.027581902220845222, 0.02166798897087574, 0.009245090186595917, -0.029495948925614357, 0.011919847689568996, -0.06046424061059952, 0.010717433877289295, -0.019250892102718353, -0.0145762013271451, 0.0032882338855415583, 0.006766645237803459, -0.020919548347592354, 0.0119750602170825, 0.022048344835639, 0.014932016842067242, 0.00736785214394331, -0.0074721435084939, -0.03565525263547897, 0.0025060514453798532, 0.015214215964078903, -0.04012136161327362, -0.010318674147129059, 0.05565458536148071, -0.003079651854932308, -0.024379555135965347, 0.0004002933856099844, 0.03170446306467056, -0.008410762064158916, -0.015950387343764305, 0.010613142512738705, 0.0317535437643528, 0.010564064607024193, 0.09167792648077011, 0.009508885443210602, 0.002550528384745121, 0.01950855180621147, -0.033348578959703445, -0.03278418257832527, -0.0037974193692207336, -0.034084752202034, -0.010220518335700035, 0.012772579677402973, 0.028931550681591034, -0.004736038390547037, 0.026772113516926765, 0.011686726473271847, 0.035017237067222595, -0.02579054981470108, -0.03160630911588669, -0.03337312117218971, 0.014379888772964478, -0.01128796674311161, 0.021410329267382622, 0.02836715243756771, -0.0012568598613142967, -0.04252618923783302, -0.00020072182815056294, 0.0020827525295317173, -0.0003546660882420838, -0.02657580003142357, 0.006539659108966589, 0.04824379086494446, 0.041004765778779984, 0.01912819594144821, 0.02733651176095009, 0.00446610851213336, 0.0012714299373328686, -0.008809521794319153, -0.02359430491924286, -0.01725095883011818, 0.0004509052087087184, -0.040832992643117905, -0.0008067215676419437, 0.0037851498927921057, -0.026772113516926765, 0.029250558465719223, 0.013925915583968163, 0.011324775405228138, 3.700029992614873e-05, -0.02120174653828144, 0.02809722162783146, 0.010564064607024193, 0.009484346024692059, 0.010662221349775791, -0.054084084928035736, -0.0033035706728696823, 0.015214215964078903, -0.03352035582065582, -0.01229406800121069, 0.020600540563464165, -0.0030673823785036802, 0.005686926655471325, -0.02929963544011116, -0.005564231425523758, 0.021005434915423393, 0.019042309373617172, -0.00043480144813656807, 0.002213116269558668, -0.04147100821137428, 0.005625579040497541, 0.015533223748207092, 0.004555062856525183, 0.004806587938219309, 0.011913713067770004, -0.0011410661973059177, -0.03374120593070984, -0.026183174923062325, 0.017888974398374557, -0.015312372706830502, -0.03202347084879875, -0.0105579299852252, 0.01634301245212555, 0.007073383778333664, 0.025545159354805946, 0.03720121458172798, 0.019079118967056274, -0.004321941640228033, -0.04139739274978638, 0.010625412687659264, 0.0037053979467600584, -0.0019385856576263905, -0.037004899233579636, -0.00884633045643568, -0.02871069870889187, -0.028759777545928955, -0.010435234755277634, -0.0018358282977715135, 0.022060614079236984, -0.03278418257832527, 0.007668456062674522, 0.04488193988800049, -0.031213682144880295, 0.05467302352190018, -0.01912819594144821, -0.010901477187871933, -0.016588402912020683, 0.019140465185046196, 0.02809722162783146, 0.0006322641856968403, 0.007723668590188026, 0.01282165851444006, -0.021852031350135803, -0.021483946591615677, -0.01252718921750784, 0.04574080556631088, -0.02637948840856552, 0.017005568370223045, -0.04515186697244644, 0.028146300464868546, -0.000987697159871459, 0.007834094576537609, 0.015925848856568336, 0.00912239495664835, 0.014220384880900383, 0.01912819594144821, 0.009153068996965885, 0.005248290952295065, -0.054574865847826004, 0.010128496214747429, 0.013398326002061367, -0.02807268314063549, -0.004312739707529545, -0.011993465013802052, -0.015165138058364391, 0.05094308406114578, -0.004128696396946907, 0.035164471715688705, 0.034403759986162186, -0.018404293805360794, -0.021471675485372543, 0.019484013319015503, -0.0006890107761137187, -0.014367618598043919, -0.020183375105261803, 0.026158636435866356, 0.012269529514014721, -0.014625279232859612, 0.005567298736423254, -0.012576267123222351, -0.03872263431549072, -0.015459607355296612, 0.017815357074141502, -0.0029584902804344893, -0.008637748658657074, -0.008680691942572594, -0.02152075432240963, -0.05018237605690956, 0.023766078054904938, 0.002518320921808481, 0.007024305406957865, 0.02652672305703163, -0.023201679810881615, -0.04009682312607765, -0.020686427131295204, -0.03158176690340042, -0.015508685261011124, -0.013091587461531162, -0.003530557034537196, 0.015373719856142998, 0.014490313827991486, 0.0369558222591877, 0.03766745328903198, 0.0012783316196873784, 0.005886306520551443, -0.004055079538375139, 0.021103590726852417, 0.021950187161564827, -0.005975260864943266, 0.0038986429572105408, 0.04399853199720383, -0.04267342388629913, -0.00686480151489377, 0.024318207055330276, -0.007582569029182196, -0.011613109149038792, -0.027974527329206467, 0.0010659153340384364, 0.04684506356716156, 0.026796652004122734, 0.004530523903667927, 0.015950387343764305, -0.016944220289587975, 0.003524422412738204, 0.010416829958558083, -0.01841656304895878, 0.03850178420543671, -0.014698896557092667, -0.010582469403743744, 0.012833927758038044, 0.012410628609359264, 0.002857266692444682, 0.030796518549323082, 0.028588002547621727, 0.035949721932411194, -0.012269529514014721, -0.011018037796020508, 0.02549608238041401, -0.009778815321624279, -0.02794998697936535, -0.024931684136390686, 0.004665488377213478, -0.009472076781094074, 0.004328076262027025, 0.010214382782578468, -0.038550861179828644, 0.0010321740992367268, 0.009416863322257996, 0.004395558964461088, 0.012226586230099201, 0.03285779803991318, -0.008110159076750278, 0.004079618491232395, -0.0045059844851493835, 0.0384281650185585, -0.006027406081557274, 0.003447737777605653, -0.007122461684048176, 0.02927509695291519, 0.026894807815551758, 0.008754309266805649, 0.025422465056180954, -0.015950387343764305, 0.015974927693605423, 0.010551795363426208, 0.0011656052665784955, 0.007907711900770664, -0.00014637164713349193, -0.030747439712285995, 0.0013626846484839916, 0.01929996907711029, 0.018747840076684952, -0.024281399324536324, -0.016907410696148872, 0.009214416146278381, -0.010429100133478642, -0.03388844057917595, 0.016625212505459785, 0.031090987846255302, 0.00285880034789443, 0.014379888772964478, -0.029839495196938515, -0.013582369312644005, -0.008324875496327877, 0.014097689650952816, 0.005981395486742258, -0.015300103463232517, -0.03877171128988266, 0.0024799786042422056, 0.027262894436717033, -0.012981162406504154, -0.0033035706728696823, 0.00023062880791258067, -0.005091854836791754, -0.025888707488775253, 0.01625712588429451, -0.013471943326294422, 0.018612876534461975, 0.007668456062674522, -0.0004075784236192703, 0.01879691891372204, 0.04075937718153, -0.0061102258041501045, -0.025397926568984985, 0.001203180756419897, 0.027042042464017868, -0.008024272508919239, -0.010668355971574783, -0.006969092879444361, -0.01979074999690056, -0.02388877421617508, 0.004177774768322706, -0.006662354338914156, -0.0006380155100487173, 0.055458273738622665, 0.008600939996540546, 0.0062206513248384, -0.038845330476760864, 0.0009102456970140338, -0.02052692323923111, 0.02642856538295746, 0.020097488537430763, 0.028637081384658813, -0.012858467176556587, -0.01818344183266163, 0.007079518400132656, 0.010514986701309681, -0.01733684539794922, 0.041152000427246094, -0.013582369312644005, -0.019312238320708275, 0.00024596572620794177, -0.016355281695723534, 0.016833793371915817, -0.04917627200484276, -0.0016947287367656827, -0.018146634101867676, 0.006809588987380266, -0.009177608415484428, 0.041863635182380676, 0.03317680582404137, 0.054280396550893784, 0.0028005200438201427, 6.585286610061303e-05, 0.03614603355526924, -0.0005866368883289397, -0.018404293805360794, 0.02745920605957508, -0.00728810066357255, 0.007220617961138487, -0.015839962288737297, -0.010582469403743744, 0.04669782891869545, -0.010564064607024193, 0.016833793371915817, 0.007582569029182196, 0.0021348982118070126, 0.00861320924013853, -0.029692260548472404, -0.02175387553870678, 0.008343280293047428, 0.03553255647420883, -0.005858700256794691, 0.005131730809807777, 0.0035213548690080643, 0.009840162470936775, -0.05565458536148071, -0.006441502831876278, 0.006619411054998636, -0.01289527490735054, -0.005131730809807777, 0.03526262566447258, -0.01748408004641533, 0.021029973402619362, -0.002992231398820877, -0.011386123485863209, 0.0038986429572105408, 0.0068463971838355064, 0.019962524995207787, -0.005757476668804884, 0.023054445162415504, 0.029520487412810326, 0.020011601969599724, -0.00922668632119894, -0.015741806477308273, -0.01646570861339569, 0.018821457400918007, 0.005441536195576191, 0.02777821384370327, -0.015680458396673203, 0.05825572460889816, -0.012110025621950626, -0.0006322641856968403, -0.05079585313796997, -0.0232507586479187, 0.026772113516926765, -0.0051102591678500175, -0.0009033440728671849, -0.006748241372406483, 0.0008358616614714265, -0.007441469468176365, 0.011576300486922264, -0.004815790336579084, -0.026943886652588844, -0.0029186143074184656, -0.01652705669403076, -0.016011735424399376, 0.04078391566872597, 0.0260850191116333, -0.0008803387172520161, -0.023029906675219536, -0.007226752582937479, -0.0020827525295317173, 0.033667586743831635, -0.025422465056180954, 0.02851438708603382, -0.0005057346425019205, 0.025741472840309143, 0.017594505101442337, 0.02099316380918026, -0.03769199550151825, 0.011698996648192406]', 590, NOW());
+Full body baret penyok, bak kanan kiri penyok,karat,tools dan dongkrak t.a; Note 2: UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI', '[-0.023839695379137993, 0.0029446871485561132, 0.0014853798784315586, -0.0015735671622678638, -0.03172900155186653, -0.016600674018263817, -0.051188476383686066, 0.030011268332600594, -0.0091407997533679, -0.06620638072490692, 0.06409601867198944, -0.045421797782182693, -0.0013987263664603233, -0.007564164698123932, 0.04252618923783302, 0.05825572460889816, 0.03327496349811554, -0.009944453835487366, -0.04674690589308739, -0.013373786583542824, 0.019753942266106606, 0.02564331702888012, -0.015066982246935368, -0.032440636307001114, 0.035189010202884674, -0.020097488537430763, -0.025913245975971222, -0.06272183358669281, 0.007361717522144318, -0.0735190212726593, -0.005831093993037939, -0.033962056040763855, 0.019459472969174385, -0.0015099189477041364, -0.031017370522022247, 0.028637081384658813, 0.06120041385293007, 0.0010850864928215742, -0.007619377691298723, -0.04105384647846222, -0.027532823383808136, -0.03521354869008064, 0.023471608757972717, 0.05673430487513542, 0.02450224943459034, 0.01425719354301691, -0.010778781957924366, -0.029373252764344215, 0.003803553991019726, 0.033348578959703445, 0.02412189543247223, 0.035336244851350784, 0.02099316380918026, 0.05693061649799347, -0.025741472840309143, 0.015692727640271187, -0.011803287081420422, 0.03435468301177025, 0.07366625219583511, 0.012159103527665138, -0.019484013319015503, -0.024551328271627426, -0.0026762911584228277, 0.05246450752019882, -0.020931817591190338, -0.0364895798265934, -0.0426979623734951, 0.027213815599679947, -0.049986064434051514, -0.008275797590613365, 0.006883205845952034, 0.05751955509185791, 0.006643950007855892, -0.002636415185406804, -0.011766478419303894, 0.015238755382597446, 0.022293735295534134, -0.03506631404161453, -0.013655985705554485, 0.0058034872636199, -0.011870769783854485, -0.018404293805360794, -0.04996152222156525, -0.02374153956770897, -0.002999899908900261, -0.05329883471131325, -0.08308925479650497, -0.0350908525288105, -0.04210902377963066, -0.04686960205435753, -0.02198699675500393, -0.022330543026328087, 0.0040274728089571, 0.04458747059106827, 0.016183508560061455, -0.007306504528969526, -0.043262358754873276, -0.02532430924475193, -0.012557863257825375, 0.03528716787695885, 0.0208459310233593, 0.05781402066349983, 0.0364895798265934, -0.012232720851898193, 0.012220451608300209, 0.024539059028029442, -0.002665555337443948, -0.03082105703651905, -0.031679924577474594, 0.02961864322423935, -0.05997345969080925, -0.006472176872193813, 0.014846130274236202, 0.06316353380680084, 0.007306504528969526, 0.010858533903956413, 0.021471675485372543, 0.01098122913390398, -0.018723301589488983, -0.057912178337574005, 0.003217684105038643, 0.03371666744351387, -0.00354896136559546, -0.08667195588350296, -0.036244191229343414, 0.02657580003142357, -0.011140733025968075, -0.01851472072303295, -0.01381548959761858, -0.007202213630080223, -0.0019723267760127783, -0.03332404047250748, 0.008441436104476452, 0.01318974420428276, -0.011576300486922264, -0.054574865847826004, -0.0182815995067358, -0.023226218298077583, -0.02763097919523716, -0.030183041468262672, 0.02096862532198429, -0.004202313721179962, 0.002754509449005127, -0.013545560650527477, -0.07528582960367203, 0.03099283203482628, -0.000889540882781148, 0.0007008968386799097, 0.021950187161564827, 0.006588737480342388, -0.020072950050234795, 0.022698629647493362, -0.056979693472385406, -0.07989917695522308, 0.011472010053694248, -0.009134664200246334, -0.0004129463341087103, -0.004628680180758238, -0.018355216830968857, 0.017447270452976227, -0.014159036800265312, -0.0010383089538663626, 0.013987263664603233, 0.01669882982969284, 0.005453805904835463, 0.0137418732047081, 0.0101898442953825, -0.015692727640271187, -0.07710172235965729, -0.013165204785764217, 0.029324175789952278, -0.007656186353415251, -0.037004899233579636, -0.05231727287173271, -0.028440769761800766, -0.01718961074948311, 0.027655519545078278, -0.021471675485372543, 0.005472210235893726, 0.01839202456176281, -0.0033894574735313654, -0.018490180373191833, 0.018895074725151062, 0.006193045061081648, -0.020539192482829094, -0.019226351752877235, -0.0026778248138725758, 0.05290621146559715, 0.010447503998875618, 0.025618776679039, -0.0009761944529600441, 0.03231794014573097, -0.004435434937477112, 0.026894807815551758, -0.0006092588300816715, -0.04728676751255989, 0.011294101364910603, 0.024674024432897568, -0.022625012323260307, -0.005778948310762644, 0.012386090122163296, -0.02213423140347004, 0.032121628522872925, 0.05614536628127098, -0.04498009383678436, 0.020539192482829094, -0.012281798757612705, 0.018821457400918007, 0.06826765835285187, -0.017520887777209282, 0.10011935979127884, 0.016674289479851723, -0.005150135140866041, 0.038845330476760864, -0.004263661336153746, 0.014870669692754745, -0.019876638427376747, 0.020600540563464165, -0.01225112471729517, 0.009404594078660011, -0.014870669692754745, -0.019410395994782448, 0.010429100133478642, -0.0011195945553481579, -0.01693195104598999, 0.01250265073031187, 0.03386390209197998, 0.015324641950428486, 0.016796985641121864, -0.04659967124462128, -0.030158502981066704, -0.02052692323923111, 0.03604787588119507, -0.017385922372341156, 0.002206981647759676, -0.0038372953422367573, 0.020011601969599724, 0.03280872106552124, 0.011042576283216476, -0.013545560650527477, -0.005254426039755344, 0.004211516119539738, -7.840996113372967e-05, 0.001779081765562296, 0.04932350665330887, 0.0015889040660113096, -0.002404827857390046, -0.012238855473697186, -0.008533457294106483, 0.03140999376773834, 0.004073483869433403, 0.024489980190992355, -0.022440969944000244, -0.019042309373617172, -0.003315840382128954, -0.000661787751596421, -0.025226151570677757, -0.01166832260787487, 0.015827693045139313, -0.03587610274553299, -0.028023604303598404, 0.009465942159295082, -0.022146500647068024, -0.023962391540408134, -0.017668122425675392, -0.03614603355526924, 0.027434667572379112, 0.01906684786081314, 0.0017208014614880085, -0.013987263664603233, -0.029716800898313522, -0.026502182707190514, 0.0592372864484787, 0.032268863171339035, -0.043556828051805496, -0.03096829168498516, 0.0227477066218853, 0.014907478354871273, 0.002648684661835432, 0.01456393115222454, -0.025741472840309143, -0.006999766454100609, 0.0037422063760459423, 0.01985209807753563, 0.007619377691298723, 0.012514919973909855, -0.005640916060656309, -0.01783989556133747, -0.036857664585113525, 0.037765610963106155, 0.02249004691839218, -0.022256925702095032, 0.027385588735342026, -0.024894874542951584, -0.007999733090400696, -0.01359463855624199, 0.017140531912446022, -0.04534818232059479, 0.0165761336684227, 0.019103657454252243, 0.06262367963790894, 0.003561230842024088, -0.01064381655305624, -0.016122162342071533, -0.013692794367671013, 0.015201946720480919, -0.003420131281018257, -0.037152133882045746, -0.029790416359901428, -0.03023212030529976, 0.009705197997391224, 0.016158970072865486, 0.007306504528969526, -0.051826491951942444, 0.05207188427448273, -0.001880305353552103, -0.006318807601928711, -0.012748041190207005, 0.05251358449459076, -0.04429300129413605, 0.02547154203057289, 0.0011518020182847977, -0.0010306404437869787, -0.004128696396946907, -0.01625712588429451, -0.016600674018263817, -0.0021379655227065086, -0.011447470635175705, 0.010797185823321342, -0.012919814325869083, -0.008134697563946247, -0.022772246971726418, 0.020539192482829094, -0.0051102591678500175, 0.0070549794472754, 0.01637982204556465, -0.028931550681591034, 0.01631847396492958, -0.006466041784733534, -0.02807268314063549, -0.050894007086753845, 0.004640949424356222, -0.0006939952727407217, 0.011999599635601044, -0.026281332597136497, -0.005607174709439278, 0.024723101407289505, 0.00976654514670372, 0.006128630135208368, -0.033029571175575256, -0.01795032061636448, -0.008165371604263783, -0.0005862534744665027, 0.041569165885448456, -0.01333697885274887, -0.02044103667140007, 0.008962891064584255, 0.01669882982969284, -0.028907010331749916, 0.04429300129413605, 0.010926015675067902, 0.005932317581027746, -0.006030473858118057, 0.025447003543376923, 0.015778614208102226, 0.03877171128988266, -0.0005613309913314879, 0.015987196937203407, 0.03253879025578499, 0.0023649518843740225, 0.06012069433927536, 0.01672336831688881, -0.010576333850622177, -0.007410795893520117, -0.0017560763517394662, -0.03756929934024811, 0.0350908525288105, 0.040955688804388046, -0.012048677541315556, 0.0019048444228246808, 0.07013262808322906, -0.05055046081542969, 0.01623258739709854, 0.01701783761382103, 0.006527389399707317, 0.019030040130019188, 0.02289494127035141, 0.07346994429826736, 0.014932016842067242, -0.06910198926925659, -0.0161098912358284, -0.004386356566101313, 0.01988890767097473, -0.038403626531362534, 0.012968892231583595, 0.012067082338035107, 0.049372587352991104, 0.022674091160297394, -0.0331522673368454, -0.0029170806519687176, -0.012232720851898193, -0.01456393115222454, -0.0029278164729475975, 0.00023733871057629585, 0.004867935553193092, 0.03970419615507126, 0.024575866758823395, 0.07508952170610428, 0.0037268695887178183, -0.04002320393919945, 0.049372587352991104, -0.04655059427022934, 0.02126309461891651, 0.015631379559636116, 0.005849498324096203, -0.0277536753565073, -0.030600206926465034, 0.07081972062587738, -0.021717067807912827, -0.004021338187158108, 0.034109290689229965, -0.007778881583362818, -0.018379755318164825, 0.01999933272600174, -0.04166731983423233, -0.005183876026421785, 0.017533157020807266, -0.012324742041528225, -0.001776014338247478, -0.02794998697936535, 0.007858633995056152, -0.010459774173796177, -0.054574865847826004, 0.04304150864481926, -0.04237895458936691, 0.015692727640271187, 0.010723568499088287, 0.0277536753565073, -0.040808454155921936, -0.04971613362431526, 0.04166731983423233, 0.003435468301177025, 0.010649951174855232, 0.05948267877101898, 0.0037422063760459423, -0.024600407108664513, 0.0063310773111879826, 0.04605981335043907, -0.030624745413661003, -0.0006426165928132832, -0.025864167138934135, 0.001231553964316845, 0.012858467176556587, 0.04777754843235016, -0.047532156109809875, 0.0070549794472754, -0.011453605256974697, -0.02289494127035141, 0.022588202729821205, 0.003637915477156639, 0.010251191444694996, 0.0298885740339756, 0.0360233373939991, 0.0011341646313667297, -0.006858666893094778, -0.01425719354301691, -0.06856212764978409, -0.01342286542057991, 0.0270175039768219, 0.03352035582065582, -0.011073250323534012, 0.019520821049809456, -0.017238689586520195, -0.005990597885102034, -0.0033679858315736055, 0.04313966631889343, -0.049667056649923325, -0.023729270324110985, -0.02944687008857727, -0.05482025817036629, 0.002884873189032078, -0.015594571828842163, -0.03668589144945145, -0.01074810791760683, -0.014539392665028572, 0.048317406326532364, 0.0149442870169878, 0.015275564044713974, -0.06105317920446396, -0.015214215964078903, -0.04078391566872597, 0.018428832292556763, 0.060660552233457565, -0.011692861095070839, -0.009447537362575531, -0.01625712588429451, 0.03231794014573097, -0.010349348187446594, -0.015545493923127651, 0.007269696332514286, -0.04183909669518471, -0.03155722841620445, 0.0025336577091366053, -0.05094308406114578, -0.0012967358343303204, 0.013312439434230328, -0.020367419347167015, 0.02420778200030327, -0.012085486203432083, -0.01929996907711029, 0.021410329267382622, 0.037471141666173935, -0.029226018115878105, -0.01434308011084795, 0.019962524995207787, -0.011042576283216476, 0.017655853182077408, 0.03678404912352562, -0.033495813608169556, -0.028907010331749916, 0.012281798757612705, 0.0044783782213926315, 0.03810915723443031, -0.04061214253306389, 0.020011601969599724, -0.037152133882045746, -0.00899356510490179, -0.11111285537481308, -0.006956823170185089, 0.003328109858557582, 0.03337312117218971, -0.002736105117946863, 0.03231794014573097, -0.0024968492798507214, -0.013705064542591572, -0.019042309373617172, 0.048734571784734726, 0.023348914459347725, -0.02029380202293396, -0.024907143786549568, -0.015300103463232517, 0.008177640847861767, 0.01318974420428276, -0.03680858761072159, -0.026796652004122734, -0.025226151570677757, 0.0027100322768092155, -0.0004746774211525917, -0.0016456505982205272, 0.018747840076684952, 0.04289427399635315, 0.0076009733602404594, -0.02836715243756771, 0.022931750863790512, 0.012968892231583595, 0.008079485036432743, -0.013987263664603233, -0.006834127940237522, -0.006625545676797628, -0.011312506161630154, 0.0005333410808816552, 0.023140331730246544, 0.030600206926465034, 0.023790616542100906, 0.01985209807753563, 0.0022606607526540756, -0.008153102360665798, 0.006398559547960758, 0.004594938829541206, -0.0502314530313015, -0.0298885740339756, 0.00247384374961257, -0.0014309338293969631, 0.009521154686808586, 0.02532430924475193, 0.01936131715774536, -0.052709899842739105, 0.003306638216599822, 0.022563664242625237, -0.048121094703674316, -0.019287699833512306, -0.01760677434504032, 0.0016671223565936089, 0.039900507777929306, 0.00736785214394331, 0.06561744213104248, -0.00866842269897461, 0.03673497214913368, 0.003199279773980379, 0.016502516344189644, -0.00505197886377573, -0.0350908525288105, -0.007024305406957865, 0.012152968905866146, 0.00987083651125431, -0.011422932147979736, 0.014784783124923706, 0.011895308271050453, -0.017152801156044006, -0.006312672980129719, -0.0031563364900648594, 0.011275697499513626, -0.014760243706405163, 0.012723501771688461, 0.013091587461531162, -0.024686293676495552, 0.0027330375742167234, 0.004463041201233864, -0.024379555135965347, 0.011116193607449532, 0.012416764162480831, 0.05555642768740654, 0.027680058032274246, -0.0052881669253110886, -0.042354416102170944, 0.025741472840309143, -0.02067415788769722, -0.01845337264239788, -0.011570165865123272, 0.005475277546793222, -0.021398060023784637, 0.01585223153233528, 0.036244191229343414, -0.018146634101867676, -0.0022637280635535717, 0.020183375105261803, 0.03271056339144707, -0.0332258865237236, -0.01985209807753563, 0.029814956709742546, -0.01473570428788662, -0.031532689929008484, 0.02403600886464119, -0.00659487210214138, 0.017201879993081093, -0.007919981144368649, 0.01792578212916851, -0.04917627200484276, 0.008355549536645412, 0.006717567332088947, -0.0326860249042511, -0.01526329480111599, -0.01634301245212555, -0.0459616556763649, 0.020244723185896873, -0.0369558222591877, -0.010637681931257248, 0.03023212030529976, -0.03293141722679138, -0.022882672026753426, 0.010508852079510689, -0.045519955456256866, 0.017447270452976227, -0.039458807557821274, 0.02145940624177456, -0.02210969105362892, 0.0019431867403909564, -0.004144033417105675, 0.016711099073290825, 0.02003614231944084, -0.00798132922500372, 0.03955696150660515, 0.00619917968288064, -0.007975193671882153, -0.03818277642130852, 0.014011802151799202, -0.02137351967394352, 0.013017971068620682, 0.05477117747068405, 0.004358750302344561, 0.017214149236679077, 0.0189809612929821, -0.004220718052238226, -0.0006993631832301617, 0.008294201456010342, 0.026011401787400246, 0.0027514419052749872, 0.004729903768748045, -0.02790091000497341, -0.0025029839016497135, 0.0244163628667593, -0.020490113645792007, -0.04078391566872597, 0.006686893291771412, 0.0020398092456161976, 0.03307865187525749, 0.01342286542057991, 0.0421581044793129, 0.028637081384658813, 0.002789784222841263, 0.02137351967394352, 0.02623225376009941, 0.06041516363620758, -0.009067182429134846, 0.023876504972577095, 0.05094308406114578, -0.007889307104051113, 0.008281932212412357, -0.024870336055755615, -0.07008355110883713, -0.010760377161204815, -0.020772313699126244, 0.003959990572184324, -0.005757476668804884, -0.03219524398446083, -0.017815357074141502, 0.012036408297717571, -0.005487546790391207, 0.039139799773693085, -0.01124502345919609, 0.0038280931767076254, -0.016833793371915817, -0.010594738647341728, -0.005493681877851486, -0.019839828833937645, 0.018674222752451897, 0.002226919634267688, -0.022845864295959473, 0.028931550681591034, 0.025815090164542198, -0.026747573167085648, 0.031213682144880295, 0.04360590875148773, -0.023950120434165, -0.015091520734131336, 0.01640436053276062, 0.02477218024432659, 0.027434667572379112, 0.030771980062127113, 0.005128663498908281, 0.015422798693180084, 0.018993230536580086, 0.011208214797079563, -0.012613075785338879, -0.006521254777908325, 0.01879691891372204, -0.03617057204246521, 0.030109424144029617, -0.01156403124332428, -0.012429033406078815, 0.0270175039768219, -0.04974067211151123, -0.009883105754852295, 0.006064214743673801, -0.03298049420118332, 0.0015091521199792624, -0.011441336013376713, 0.005999799817800522, -0.028440769761800766, -0.010288000106811523, -0.019643517211079597, 0.008515053428709507, 0.029667722061276436, -0.007656186353415251, 0.024318207055330276, -0.05673430487513542, -0.011410661973059177, -0.0011732737766578794, 0.018784649670124054, 0.01830613799393177, 0.0028204580303281546, -0.01649024710059166, 0.008134697563946247, 0.002406361512839794, 0.013386056758463383, -0.011324775405228138, -0.05982622504234314, -0.028146300464868546, -0.03513993322849274, -0.0007860166952013969, 0.024170972406864166, -0.0011402993695810437, 0.003668589284643531, -0.0208459310233593, 0.016158970072865486, -0.021790683269500732, -0.018956422805786133, 0.011883039027452469, -0.012195912189781666, 0.0298885740339756, 0.0022683292627334595, 0.01596265845000744, -0.004263661336153746, 0.0033679858315736055, 0.005628646817058325, 0.012711232528090477, -0.008214449509978294, 0.02409735508263111, -0.006717567332088947, -0.003205414628610015, 0.014981095679104328, -0.00014253742119763047, 0.008711365982890129, -0.030305737629532814, 0.008557996712625027, 0.023704729974269867, -0.012134564109146595, -0.041569165885448456, 0.0004777447902597487, 0.023631112650036812, -0.03295595571398735, 0.008760443888604641, -0.028489846736192703, 0.009232820942997932, 0.029962191358208656, -0.042207181453704834, -0.0027192344423383474, 0.04841556400060654, 0.025986863300204277, -0.004736038390547037, -0.016711099073290825, 0.029176941141486168, 0.008999699726700783, 0.02927509695291519, 0.02851438708603382, -0.02426912821829319, 0.03445283696055412, 0.016625212505459785, -0.015091520734131336, -0.0007039642659947276, 0.055163804441690445, -0.03756929934024811, 0.014956556260585785, 0.004567332100123167, -0.018711032345891, 0.0033710531424731016, -0.017741739749908447, 0.02149621583521366, -0.02228146605193615, 0.016060814261436462, 0.014318540692329407, -0.028416229411959648, -0.0473603829741478, -0.05221911519765854, 0.00013985346595291048, 0.02382742613554001, 0.016220318153500557, -0.00488327257335186, 0.0030060347635298967, -0.011343180201947689, 0.04132377356290817, 0.023631112650036812, 0.05688153952360153, -0.004463041201233864, 0.022612743079662323, 0.02061280980706215, -0.03477184474468231, -0.008760443888604641, -0.02809722162783146, 0.032882340252399445, -0.019520821049809456, 0.007711399346590042, 0.011539492756128311, 0.018588336184620857, 0.006852532271295786, 0.03663681447505951, -0.024870336055755615, -0.03347127512097359, 0.018036209046840668, -0.01473570428788662, -0.022477777674794197, 0.02447771094739437, 0.007091788109391928, -0.02172933705151081, 0.012085486203432083, -0.005119461100548506, 0.03391297906637192, -0.00736785214394331, -0.00826352834701538, -0.0021410328336060047, 0.0012660620268434286, 0.0011364651145413518, 0.028318073600530624, -0.050746772438287735, 0.0044753109104931355, -0.00013391040556598455, 0.013766411691904068, 0.017729470506310463, -0.01023278757929802, -0.047262225300073624, -1.7337899407721125e-05, -0.03376574441790581, 0.01023278757929802, 0.036391425877809525, 0.02245323918759823, 0.0021809088066220284, 0.01985209807753563, 0.006742106284946203, 0.004631747491657734, -0.009570232592523098, 0.010171439498662949, 0.009508885443210602, 0.02479671873152256, 0.013692794367671013, -0.02642856538295746, -0.008024272508919239, 0.0006851765210740268, 0.013901377096772194, 0.04686960205435753, -0.00987083651125431, -0.008067215792834759, -0.0029600239358842373, -0.03214616701006889, 0.03948334604501724, 0.027508284896612167, -0.01350875198841095, -0.041127461940050125, -0.020661886781454086, 0.05143386870622635, 0.014809321612119675, 0.006508985534310341, 0.010214382782578468, 0.0277536753565073, 0.007134731393307447, 0.017704930156469345, -0.022404160350561142, -0.017876705154776573, 0.020158836618065834, 0.05555642768740654, -0.03437922149896622, -0.028416229411959648, -4.152948167757131e-05, -0.0388944074511528, 0.041863635182380676, 0.04134831577539444, -0.017361383885145187, -0.01282165851444006, 0.010944420471787453, 0.0032452906016260386, -0.011545627377927303, -0.0265512615442276, 0.01579088345170021, 0.02687026932835579, 0.03815823793411255, -0.024465441703796387, -0.02944687008857727, -0.015803154557943344, -0.013606907799839973, 0.024404093623161316, 0.009711332619190216, 0.000993065070360899, 0.026305871084332466, 0.06998539716005325, -0.030305737629532814, 0.005686926655471325, -0.0006472176755778491, -0.00030405426514334977, -0.02718927711248398, 0.01526329480111599, -0.013054778799414635, 0.024698562920093536, 0.011011902242898941, 0.017324576154351234, -0.010116226971149445, -0.0232139490544796, 0.02380288764834404, 0.049078118056058884, 0.008416896685957909, 0.022121962159872055, 0.0028173907194286585, -0.006392424926161766, -0.007594838738441467, -0.017790816724300385, -0.032882340252399445, -0.012183642946183681, -0.022158769890666008, 0.01387683767825365, -0.03020758181810379, 0.06812042742967606, 0.02164345048367977, 0.014662087894976139, -0.0010337078711017966, -0.03253879025578499, 0.0008128563058562577, -0.018784649670124054, -0.018036209046840668, 0.038550861179828644, -0.033790282905101776, 0.03239155933260918, -0.0066010067239403725, 0.005435401573777199, 0.01456393115222454, 0.06532297283411026, -0.018269328400492668, -0.0132756307721138, 0.008502784185111523, 0.03818277642130852, 0.014478044584393501, 0.02424458973109722, 0.023054445162415504, 0.004395558964461088, 0.0733717828989029, 0.01783989556133747, -0.0021517686545848846, 0.009613175876438618, -0.007245156913995743, 0.004959957208484411, 0.030477510765194893, 0.002440102631226182, 0.049667056649923325, 0.011232754215598106, -0.0012583936331793666, -0.011134597472846508, -0.012490380555391312, -0.018993230536580086, -0.012330876663327217, 0.0014623745810240507, -0.010355482809245586, -0.027581902220845222, -0.01748408004641533, -0.02760644070804119, -0.014956556260585785, -0.024956222623586655, 0.024428632110357285, -0.008877004496753216, 0.0015168205136433244, -0.011698996648192406, -0.046305201947689056, -0.00714086601510644, -0.03278418257832527, 0.014956556260585785, 0.010950555093586445, 0.002111892681568861, 0.009987397119402885, -0.016060814261436462, -0.01999933272600174, 0.020306071266531944, -0.03553255647420883, 0.0007292701629921794, 0.0024124961346387863, 0.004490647930651903, 0.006748241372406483, -0.04620704799890518, 0.01194438710808754, -0.003432400757446885, 0.027827292680740356, -0.019140465185046196, 0.01619577966630459, -0.012318607419729233, 0.028808854520320892, -0.012711232528090477, -0.0060120695270597935, 0.02069869637489319, 0.003087320365011692, 0.02718927711248398, -0.028637081384658813, 0.030452972277998924, 0.023348914459347725, -0.005953789222985506, -0.005147067364305258, -0.062034741044044495, -0.0033802553080022335, -0.012686693109571934, -0.007294235285371542, 0.0016993298195302486, 0.02623225376009941, -0.0031164605170488358, -0.0002542092988733202, 0.00815923698246479, 0.012263394892215729, -0.005208414979279041, -0.0014938152162358165, 0.05383869633078575, -0.022625012323260307, 0.02944687008857727, 0.005021304823458195, 0.00016170856542885303, 0.006027406081557274, -0.005920047871768475, 0.007754342630505562, -0.040832992643117905, -0.02657580003142357, -0.014723435044288635, 0.02473537065088749, 0.015422798693180084, 0.0303548164665699, 0.0004551228485070169, 0.008337144739925861, -0.03325042501091957, 0.012011868879199028, -0.0326860249042511, -0.010699030011892319, -0.03737298771739006, -0.043556828051805496, -0.021275363862514496, 0.030183041468262672, -0.02023245394229889, 0.033962056040763855, 0.017913512885570526, 0.009447537362575531, 0.04198632761836052, -0.03909071907401085, 0.005530490539968014, 0.03386390209197998, 0.025066647678613663, -0.009797219187021255, 0.008692961186170578, 0.03185169771313667, 0.006349481642246246, 7.39047463866882e-05, -0.02126309461891651, 0.019189544022083282, 0.016981028020381927, 0.02596232481300831, -0.002437035320326686, -0.011883039027452469, 0.0038188910111784935, -0.025594238191843033, 0.017385922372341156, -9.389065962750465e-05, -0.02312806248664856, -0.025545159354805946, 0.0312873013317585, 0.0001724444009596482, -0.0003071216633543372, 0.027581902220845222, 0.02166798897087574, 0.009245090186595917, -0.029495948925614357, 0.011919847689568996, -0.06046424061059952, 0.010717433877289295, -0.019250892102718353, -0.0145762013271451, 0.0032882338855415583, 0.006766645237803459, -0.020919548347592354, 0.0119750602170825, 0.022048344835639, 0.014932016842067242, 0.00736785214394331, -0.0074721435084939, -0.03565525263547897, 0.0025060514453798532, 0.015214215964078903, -0.04012136161327362, -0.010318674147129059, 0.05565458536148071, -0.003079651854932308, -0.024379555135965347, 0.0004002933856099844, 0.03170446306467056, -0.008410762064158916, -0.015950387343764305, 0.010613142512738705, 0.0317535437643528, 0.010564064607024193, 0.09167792648077011, 0.009508885443210602, 0.002550528384745121, 0.01950855180621147, -0.033348578959703445, -0.03278418257832527, -0.0037974193692207336, -0.034084752202034, -0.010220518335700035, 0.012772579677402973, 0.028931550681591034, -0.004736038390547037, 0.026772113516926765, 0.011686726473271847, 0.035017237067222595, -0.02579054981470108, -0.03160630911588669, -0.03337312117218971, 0.014379888772964478, -0.01128796674311161, 0.021410329267382622, 0.02836715243756771, -0.0012568598613142967, -0.04252618923783302, -0.00020072182815056294, 0.0020827525295317173, -0.0003546660882420838, -0.02657580003142357, 0.006539659108966589, 0.04824379086494446, 0.041004765778779984, 0.01912819594144821, 0.02733651176095009, 0.00446610851213336, 0.0012714299373328686, -0.008809521794319153, -0.02359430491924286, -0.01725095883011818, 0.0004509052087087184, -0.040832992643117905, -0.0008067215676419437, 0.0037851498927921057, -0.026772113516926765, 0.029250558465719223, 0.013925915583968163, 0.011324775405228138, 3.700029992614873e-05, -0.02120174653828144, 0.02809722162783146, 0.010564064607024193, 0.009484346024692059, 0.010662221349775791, -0.054084084928035736, -0.0033035706728696823, 0.015214215964078903, -0.03352035582065582, -0.01229406800121069, 0.020600540563464165, -0.0030673823785036802, 0.005686926655471325, -0.02929963544011116, -0.005564231425523758, 0.021005434915423393, 0.019042309373617172, -0.00043480144813656807, 0.002213116269558668, -0.04147100821137428, 0.005625579040497541, 0.015533223748207092, 0.004555062856525183, 0.004806587938219309, 0.011913713067770004, -0.0011410661973059177, -0.03374120593070984, -0.026183174923062325, 0.017888974398374557, -0.015312372706830502, -0.03202347084879875, -0.0105579299852252, 0.01634301245212555, 0.007073383778333664, 0.025545159354805946, 0.03720121458172798, 0.019079118967056274, -0.004321941640228033, -0.04139739274978638, 0.010625412687659264, 0.0037053979467600584, -0.0019385856576263905, -0.037004899233579636, -0.00884633045643568, -0.02871069870889187, -0.028759777545928955, -0.010435234755277634, -0.0018358282977715135, 0.022060614079236984, -0.03278418257832527, 0.007668456062674522, 0.04488193988800049, -0.031213682144880295, 0.05467302352190018, -0.01912819594144821, -0.010901477187871933, -0.016588402912020683, 0.019140465185046196, 0.02809722162783146, 0.0006322641856968403, 0.007723668590188026, 0.01282165851444006, -0.021852031350135803, -0.021483946591615677, -0.01252718921750784, 0.04574080556631088, -0.02637948840856552, 0.017005568370223045, -0.04515186697244644, 0.028146300464868546, -0.000987697159871459, 0.007834094576537609, 0.015925848856568336, 0.00912239495664835, 0.014220384880900383, 0.01912819594144821, 0.009153068996965885, 0.005248290952295065, -0.054574865847826004, 0.010128496214747429, 0.013398326002061367, -0.02807268314063549, -0.004312739707529545, -0.011993465013802052, -0.015165138058364391, 0.05094308406114578, -0.004128696396946907, 0.035164471715688705, 0.034403759986162186, -0.018404293805360794, -0.021471675485372543, 0.019484013319015503, -0.0006890107761137187, -0.014367618598043919, -0.020183375105261803, 0.026158636435866356, 0.012269529514014721, -0.014625279232859612, 0.005567298736423254, -0.012576267123222351, -0.03872263431549072, -0.015459607355296612, 0.017815357074141502, -0.0029584902804344893, -0.008637748658657074, -0.008680691942572594, -0.02152075432240963, -0.05018237605690956, 0.023766078054904938, 0.002518320921808481, 0.007024305406957865, 0.02652672305703163, -0.023201679810881615, -0.04009682312607765, -0.020686427131295204, -0.03158176690340042, -0.015508685261011124, -0.013091587461531162, -0.003530557034537196, 0.015373719856142998, 0.014490313827991486, 0.0369558222591877, 0.03766745328903198, 0.0012783316196873784, 0.005886306520551443, -0.004055079538375139, 0.021103590726852417, 0.021950187161564827, -0.005975260864943266, 0.0038986429572105408, 0.04399853199720383, -0.04267342388629913, -0.00686480151489377, 0.024318207055330276, -0.007582569029182196, -0.011613109149038792, -0.027974527329206467, 0.0010659153340384364, 0.04684506356716156, 0.026796652004122734, 0.004530523903667927, 0.015950387343764305, -0.016944220289587975, 0.003524422412738204, 0.010416829958558083, -0.01841656304895878, 0.03850178420543671, -0.014698896557092667, -0.010582469403743744, 0.012833927758038044, 0.012410628609359264, 0.002857266692444682, 0.030796518549323082, 0.028588002547621727, 0.035949721932411194, -0.012269529514014721, -0.011018037796020508, 0.02549608238041401, -0.009778815321624279, -0.02794998697936535, -0.024931684136390686, 0.004665488377213478, -0.009472076781094074, 0.004328076262027025, 0.010214382782578468, -0.038550861179828644, 0.0010321740992367268, 0.009416863322257996, 0.004395558964461088, 0.012226586230099201, 0.03285779803991318, -0.008110159076750278, 0.004079618491232395, -0.0045059844851493835, 0.0384281650185585, -0.006027406081557274, 0.003447737777605653, -0.007122461684048176, 0.02927509695291519, 0.026894807815551758, 0.008754309266805649, 0.025422465056180954, -0.015950387343764305, 0.015974927693605423, 0.010551795363426208, 0.0011656052665784955, 0.007907711900770664, -0.00014637164713349193, -0.030747439712285995, 0.0013626846484839916, 0.01929996907711029, 0.018747840076684952, -0.024281399324536324, -0.016907410696148872, 0.009214416146278381, -0.010429100133478642, -0.03388844057917595, 0.016625212505459785, 0.031090987846255302, 0.00285880034789443, 0.014379888772964478, -0.029839495196938515, -0.013582369312644005, -0.008324875496327877, 0.014097689650952816, 0.005981395486742258, -0.015300103463232517, -0.03877171128988266, 0.0024799786042422056, 0.027262894436717033, -0.012981162406504154, -0.0033035706728696823, 0.00023062880791258067, -0.005091854836791754, -0.025888707488775253, 0.01625712588429451, -0.013471943326294422, 0.018612876534461975, 0.007668456062674522, -0.0004075784236192703, 0.01879691891372204, 0.04075937718153, -0.0061102258041501045, -0.025397926568984985, 0.001203180756419897, 0.027042042464017868, -0.008024272508919239, -0.010668355971574783, -0.006969092879444361, -0.01979074999690056, -0.02388877421617508, 0.004177774768322706, -0.006662354338914156, -0.0006380155100487173, 0.055458273738622665, 0.008600939996540546, 0.0062206513248384, -0.038845330476760864, 0.0009102456970140338, -0.02052692323923111, 0.02642856538295746, 0.020097488537430763, 0.028637081384658813, -0.012858467176556587, -0.01818344183266163, 0.007079518400132656, 0.010514986701309681, -0.01733684539794922, 0.041152000427246094, -0.013582369312644005, -0.019312238320708275, 0.00024596572620794177, -0.016355281695723534, 0.016833793371915817, -0.04917627200484276, -0.0016947287367656827, -0.018146634101867676, 0.006809588987380266, -0.009177608415484428, 0.041863635182380676, 0.03317680582404137, 0.054280396550893784, 0.0028005200438201427, 6.585286610061303e-05, 0.03614603355526924, -0.0005866368883289397, -0.018404293805360794, 0.02745920605957508, -0.00728810066357255, 0.007220617961138487, -0.015839962288737297, -0.010582469403743744, 0.04669782891869545, -0.010564064607024193, 0.016833793371915817, 0.007582569029182196, 0.0021348982118070126, 0.00861320924013853, -0.029692260548472404, -0.02175387553870678, 0.008343280293047428, 0.03553255647420883, -0.005858700256794691, 0.005131730809807777, 0.0035213548690080643, 0.009840162470936775, -0.05565458536148071, -0.006441502831876278, 0.006619411054998636, -0.01289527490735054, -0.005131730809807777, 0.03526262566447258, -0.01748408004641533, 0.021029973402619362, -0.002992231398820877, -0.011386123485863209, 0.0038986429572105408, 0.0068463971838355064, 0.019962524995207787, -0.005757476668804884, 0.023054445162415504, 0.029520487412810326, 0.020011601969599724, -0.00922668632119894, -0.015741806477308273, -0.01646570861339569, 0.018821457400918007, 0.005441536195576191, 0.02777821384370327, -0.015680458396673203, 0.05825572460889816, -0.012110025621950626, -0.0006322641856968403, -0.05079585313796997, -0.0232507586479187, 0.026772113516926765, -0.0051102591678500175, -0.0009033440728671849, -0.006748241372406483, 0.0008358616614714265, -0.007441469468176365, 0.011576300486922264, -0.004815790336579084, -0.026943886652588844, -0.0029186143074184656, -0.01652705669403076, -0.016011735424399376, 0.04078391566872597, 0.0260850191116333, -0.0008803387172520161, -0.023029906675219536, -0.007226752582937479, -0.0020827525295317173, 0.033667586743831635, -0.025422465056180954, 0.02851438708603382, -0.0005057346425019205, 0.025741472840309143, 0.017594505101442337, 0.02099316380918026, -0.03769199550151825, 0.011698996648192406]', 590, NOW()) ON CONFLICT (id) DO NOTHING;
 INSERT INTO embeddings (id, scope, combined, embeddings, n_tokens, created_at) VALUES (2, 'lelang', 'Stock No: BA00002123J16; ID Lelang: BEKASI-CAR-2311150404; Cabang: Caready Bekasi; Tanggal: 15/11/23; Bulan: November; Lot: 0    229
 1    228
 Name: lot, dtype: int64; Seller No: SI2300000144; Seller Name: Yuliana adec (SIP); NPWP Penjual: 0    1.234570e+14
@@ -32,7 +33,7 @@ Name: kapasitas_mesin, dtype: int64; Tipe Bahan Bakar: Diesel; Odometer: -; Grad
 1   NaN
 Name: stnk_exp_date, dtype: float64; Faktur: Tidak Ada; Kwitansi Blank: Tidak Ada; FC KTP: Tidak Ada; Form A: Tidak Ada; Status Keur: Tidak Ada; Note 1: F 1088 DA
 
-UNIT DEREK, all body baret penyok, bumper depan belakang baret penyok renggang, foglamp TA, buku manual servis TA, dongkrak toolkit TA, sebagian komponen mesin, ecu dan kelistrikan TA, kap mesin repair,; Note 2: ADA BIAYA TAMBAHAN PPN SEBESAR 1,1 % DARI HARGA TERBENTUK DIBEBANKAN KE PEMENANG LELANG // UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI', '[-0.023536469787359238, -0.014767293818295002, 0.0011378006311133504, -0.014673755504190922, -0.029113667085766792, 0.0023676776327192783, -0.02969827875494957, 0.025348765775561333, -0.021455252543091774, -0.05121199041604996, 0.06397991627454758, -0.03535731881856918, -0.0038905914407223463, -0.009359634481370449, 0.050463687628507614, 0.07113555818796158, 0.016123592853546143, -0.0029011359438300133, -0.03914560377597809, -0.021151253953576088, 0.011388237588107586, 0.020250951871275902, -0.011417468078434467, -0.03512347489595413, 0.04274681210517883, -0.02331431768834591, -0.023665085434913635, -0.08217303454875946, -0.004320281092077494, -0.08525978028774261, -0.0008929944597184658, -0.034866247326135635, 0.04417326673865318, -0.016053440049290657, -0.032293953001499176, 0.04139051213860512, 0.06220269203186035, -0.009207635186612606, 0.019198650494217873, -0.032527800649404526, -0.027967827394604683, -0.02754690684378147, 0.009833170101046562, 0.058461178094148636, 0.05275536701083183, 0.0009624171070754528, -0.0042530507780611515, -0.0383739173412323, 0.011399929411709309, 0.04447726532816887, 0.024483541026711464, 0.039543140679597855, 0.01505959965288639, 0.08596131205558777, -0.011201161891222, 0.013457763008773327, -0.009242712520062923, 0.04994922876358032, 0.08156503736972809, 0.009026405401527882, -0.016906972974538803, -0.020461412146687508, -0.011142700910568237, 0.058227334171533585, -0.012639306485652924, -0.03308902680873871, -0.025395534932613373, 0.03269148990511894, -0.041296977549791336, -0.008202102966606617, 0.009961784817278385, 0.058320872485637665, 0.029160436242818832, -0.007576568517833948, -0.022145094349980354, 0.014720524661242962, 0.027009064331650734, -0.03213026374578476, 0.0056415037252008915, 0.016415897756814957, -0.0314754992723465, -0.034913014620542526, -0.029768431559205055, -0.016146976500749588, -0.005904579069465399, -0.057946719229221344, -0.06468144804239273, -0.02855243906378746, -0.034959785640239716, -0.024436771869659424, -0.029441049322485924, -0.020765410736203194, 0.0066353436559438705, 0.024062620475888252, 0.0431443490087986, 0.0062202694825828075, -0.034585632383823395, -0.03065704181790352, -0.004463511053472757, 0.038631144911050797, 0.026190606877207756, 0.03626931458711624, 0.026330914348363876, -0.0297918152064085, 0.013890375383198261, 0.0002174024994019419, -0.02243739925324917, -0.028435517102479935, -0.03519362956285477, 0.02626076154410839, -0.05654365196824074, 0.015538981184363365, 0.004706124775111675, 0.05645011365413666, 0.010090399533510208, -0.0024758309591561556, 0.0045307413674890995, -0.009353788569569588, -0.036830540746450424, -0.04786801338195801, 0.007161494344472885, 0.010675011202692986, 0.010914701968431473, -0.07642044872045517, -0.04938800260424614, 0.021595558151602745, -0.015211598016321659, -0.02520846016705036, 0.003688900265842676, -0.002754983026534319, 0.009874093346297741, -0.010984855704009533, 0.003899360541254282, 0.01046455092728138, -0.0033585946075618267, -0.026821987703442574, -0.03231734037399292, -0.020449720323085785, -0.026868756860494614, -0.03921575844287872, -0.0015258367639034986, 0.002772521460428834, 0.015913132578134537, 0.00012779247481375933, -0.08114411681890488, 0.021314945071935654, -0.000858648563735187, -0.004805509001016617, 0.0073193395510315895, 0.007073802407830954, -0.02135002240538597, 0.025185074657201767, -0.05925624817609787, -0.06622482091188431, 0.004995507653802633, -0.021829403936862946, -0.014030682854354382, 0.0048873545601964, -0.0352637805044651, 0.014416526071727276, 0.003677207976579666, -0.002040295163169503, 0.014545140787959099, -0.002728675492107868, -0.007366108242422342, 0.005556734744459391, -0.007097186986356974, -0.012615921907126904, -0.07314662635326385, -0.018158040940761566, 0.029815200716257095, -0.009102405048906803, -0.029207203537225723, -0.057946719229221344, -0.012382077053189278, -0.037438537925481796, 0.03755545988678932, -0.02864597737789154, 0.009131635539233685, 0.029628124088048935, -0.014977754093706608, -0.007705183234065771, 0.021057715639472008, -0.0013380302116274834, -0.03098442405462265, -0.008687331341207027, -0.005536273587495089, 0.04763416573405266, 0.0051095071248710155, 0.024179542437195778, -0.007354415953159332, 0.013773453421890736, 0.0005115353269502521, 0.013574684970080853, -0.016591282561421394, -0.04162435978651047, 0.01721097156405449, 0.033486563712358475, -0.018345117568969727, 0.0017742967465892434, 0.007944874465465546, -0.01560913398861885, 0.042396046221256256, 0.04146066680550575, -0.053176287561655045, 0.015667594969272614, -0.019116805866360664, -0.002421754179522395, 0.06617805361747742, -0.017152508720755577, 0.08890775591135025, 0.011768234893679619, 0.016509436070919037, 0.01702389493584633, 0.0014249911764636636, 0.037976380437612534, -0.019818339496850967, 0.0042618196457624435, 0.0049575078301131725, 0.018333425745368004, -0.011744850315153599, -0.033439792692661285, -0.007658414077013731, 0.0011648390209302306, -0.00865225400775671, -0.004548279568552971, 0.01712912507355213, 0.019034959375858307, 0.02565276436507702, -0.05743226036429405, -0.034796092659235, -0.024109389632940292, 0.016731588169932365, -0.021583866328001022, 0.013118688017129898, -0.0015156060690060258, 0.02304539643228054, 0.030212735757231712, 0.0018400655826553702, -0.015141445212066174, -0.015819594264030457, 0.006968572270125151, 0.0003728726878762245, -0.001622297684662044, 0.047353554517030716, -0.0026453682221472263, 0.007009495049715042, -0.0016515282914042473, 0.01945587992668152, 0.028903206810355186, -0.026237376034259796, 0.03306564316153526, -0.025582611560821533, -0.037532076239585876, 0.011294699274003506, 0.0060799624770879745, -0.04733017086982727, -0.038397300988435745, 0.0001991333847399801, -0.04305081069469452, -0.02965150959789753, 0.014042374677956104, -0.009499941021203995, -0.031381960958242416, -0.02171248197555542, -0.029324127361178398, 0.044921569526195526, 0.026143837720155716, 0.009926708415150642, -0.01758512295782566, -0.011815004050731659, -0.015550673007965088, 0.05490673705935478, 0.041016362607479095, -0.03921575844287872, -0.03589516133069992, 0.006582728587090969, 0.01744481548666954, 0.01725773885846138, 0.01584297977387905, -0.017514968290925026, -0.0072959549725055695, -0.01574944145977497, 0.018602347001433372, 0.0013285302557051182, 0.024179542437195778, 0.024039236828684807, -0.019946953281760216, -0.02171248197555542, 0.019946953281760216, 0.02331431768834591, -0.032200418412685394, 0.02703244797885418, -0.027921058237552643, 0.005448581650853157, -0.022250324487686157, 0.02295185811817646, -0.03264472261071205, 0.016696512699127197, 0.008874407038092613, 0.07202417403459549, 0.01282638218253851, -0.024015851318836212, -0.023793699219822884, -0.0173980463296175, 0.010540550574660301, -0.02318570390343666, -0.014077452011406422, -0.03783607482910156, -0.041600972414016724, 0.018146349117159843, 0.017737120389938354, 0.005395966582000256, -0.053363364189863205, 0.05238121375441551, 0.000726014724932611, 0.0005933809443376958, -0.00826056394726038, 0.06318484246730804, -0.0491073876619339, 0.021092792972922325, 0.01670820452272892, 0.01587805524468422, 0.0033469023182988167, -0.0221684779971838, -0.013691607862710953, 0.018882960081100464, -0.010774395428597927, 0.01707066409289837, -0.00034309402690269053, -0.009570094756782055, -0.028482286259531975, 0.02019249089062214, -0.0110842389985919, 0.01767865940928459, 0.009575940668582916, -0.020718641579151154, 0.025091538205742836, -0.007272570393979549, -0.030142582952976227, -0.048359084874391556, 0.004720740020275116, 0.007903951220214367, 0.027429984882473946, -0.02309216558933258, -0.03107796236872673, 0.02001710794866085, 0.015445442870259285, 0.028973359614610672, -0.015503903850913048, -0.03271487355232239, -0.011189469136297703, 0.0023618314880877733, 0.03135857731103897, -0.009850708767771721, -0.014510064385831356, 0.009944246150553226, 0.03814007341861725, -0.016030054539442062, 0.04679232835769653, 0.02621399238705635, -0.0011151469079777598, -5.914626854064409e-06, 0.019806647673249245, 0.02212170884013176, 0.03580162674188614, 0.005077353212982416, 0.025091538205742836, 0.0383739173412323, -0.020952485501766205, 0.05397135764360428, 0.007588260807096958, -0.014089143835008144, 0.01735127717256546, -0.002054910408332944, -0.034351788461208344, 0.03250441327691078, 0.041600972414016724, -0.025582611560821533, 0.0020505257416516542, 0.05378428474068642, -0.05761933699250221, 0.0038525916170328856, -0.00038803607458248734, 0.006769804283976555, 0.0125106917694211, 0.01891803741455078, 0.06846973299980164, 0.0001096147097996436, -0.05453258752822876, -0.02841213159263134, -0.00973378587514162, 0.03170934319496155, -0.03217703104019165, 0.015597442165017128, 0.008237180300056934, 0.027336446568369865, 0.011312237940728664, -0.029043512418866158, -0.008599638938903809, 0.0045307413674890995, -0.022226938977837563, -0.014568525366485119, -0.023934006690979004, 0.015492212027311325, 0.03741515427827835, 0.02230878546833992, 0.06234300136566162, -0.004717817064374685, -0.051165223121643066, 0.02703244797885418, -0.03126503899693489, 0.018146349117159843, 0.015597442165017128, 0.012054694816470146, -0.03411794453859329, -0.003238749224692583, 0.06033193692564964, -0.010125475935637951, 0.017608506605029106, 0.008137796074151993, -0.004799662623554468, -0.01560913398861885, 0.01863742433488369, -0.05111845210194588, -0.005276121199131012, 0.015433751046657562, 0.012241770513355732, -0.008570408448576927, -0.02745336852967739, -0.010727626271545887, -0.020075568929314613, -0.05701133981347084, 0.03966006264090538, -0.047119710594415665, 0.008710715919733047, 0.005965963006019592, 0.03748530521988869, -0.018345117568969727, -0.07015341520309448, 0.029113667085766792, 0.02850566990673542, 0.007670106366276741, 0.06253007799386978, 0.008371640928089619, -0.024390002712607384, -0.0004212858621031046, 0.040057599544525146, -0.019584493711590767, -0.016766665503382683, -0.01983003132045269, 0.005018892232328653, 0.005273198243230581, 0.043635424226522446, -0.039356064051389694, 0.009295327588915825, -0.0012788382591679692, -0.010411935858428478, 0.04277019575238228, 0.01956111006438732, -0.0028324441518634558, 0.045412641018629074, 0.026354297995567322, 0.01758512295782566, 0.004612586926668882, -0.015690980479121208, -0.0610334686934948, -0.02143186703324318, 0.014381449669599533, 0.030633656308054924, -0.02111617662012577, 0.03514685854315758, -0.011750697158277035, -0.0017509122844785452, -0.0032475183252245188, 0.05397135764360428, -0.037976380437612534, -0.01652112789452076, -0.03594193235039711, -0.04031482711434364, -0.008219641633331776, -0.04305081069469452, -0.05537442862987518, -0.017187586054205894, -0.014042374677956104, 0.04284035041928291, 0.03921575844287872, 0.0015360674588009715, -0.07571891695261002, -0.008167026564478874, -0.016918664798140526, 0.00626703817397356, 0.048499394208192825, -0.010224860161542892, -0.014077452011406422, -0.01154608279466629, 0.02693891152739525, -0.0022069094702601433, -0.002604445442557335, 0.005784733686596155, -0.03919237479567528, -0.0215721745043993, -0.020087260752916336, -0.034632403403520584, 0.01491929218173027, 0.017737120389938354, -0.02974504791200161, 0.001994987716898322, -0.029160436242818832, -0.00762918358668685, 0.028716130182147026, 0.008453486487269402, -0.003417055821046233, -0.018064504489302635, 0.01532852090895176, -0.018076196312904358, 0.010090399533510208, 0.02855243906378746, -0.019794953987002373, -0.017783889546990395, 0.01615867018699646, 0.00844764057546854, 0.05219414085149765, -0.03757884353399277, 0.023431239649653435, -0.033533331006765366, -0.0002155755937565118, -0.10204983502626419, -0.005583042278885841, -0.013118688017129898, 0.019806647673249245, 0.014790677465498447, 0.019350649788975716, 0.004431357141584158, -0.018415270373225212, -0.0013402225449681282, 0.04167112708091736, 0.026143837720155716, -0.029230589047074318, -0.035778239369392395, -0.0027184446807950735, 0.021560482680797577, 0.0173980463296175, -0.028903206810355186, -0.022753089666366577, -0.030633656308054924, 0.010020245797932148, -0.0029537510126829147, -0.008517793379724026, 0.01940911076962948, 0.04513202980160713, 0.025395534932613373, -0.024109389632940292, 0.016871895641088486, 0.014381449669599533, 0.010599011555314064, -0.024249697104096413, -0.013399302028119564, -0.0023355239536613226, -0.019303880631923676, 0.0016427590744569898, 0.021279867738485336, 0.020894024521112442, 0.024530310183763504, 0.006407345179468393, -0.0036801311653107405, 0.012043002992868423, 0.015293444506824017, 0.007412877399474382, -0.03411794453859329, -0.058087024837732315, 0.009938400238752365, -0.0024816771037876606, 0.0037648999132215977, 0.023665085434913635, 0.018064504489302635, -0.05687103420495987, 0.0010486473329365253, 0.006606113165616989, -0.05261506140232086, -0.022063247859477997, -0.01425283495336771, 0.013212226331233978, 0.030493350699543953, 0.013165457174181938, 0.06706666201353073, -0.005901655647903681, 0.045412641018629074, 0.009850708767771721, 0.01291992049664259, 0.00020223914179950953, -0.01992356963455677, -0.008511947467923164, 0.0044985874556005, 0.0022010633256286383, -0.020847255364060402, 0.03283179551362991, 0.0011078392853960395, -0.011487621814012527, -0.0033527484629303217, -0.00975132454186678, 0.027476754039525986, -0.02735983021557331, 0.01071593351662159, 0.003875975962728262, -0.029253972694277763, 0.003066288772970438, 0.00030399812385439873, -0.02955797128379345, 0.0229986272752285, 0.016217131167650223, 0.04742370545864105, 0.04365880787372589, 0.0032358262687921524, -0.048312317579984665, 0.03559116646647453, -0.0252552293241024, -0.03133518993854523, 0.0010223397985100746, 0.01628728397190571, -0.013399302028119564, 0.003940283320844173, 0.04489818587899208, -0.0021089869551360607, -0.0007314955000765622, 0.0037648999132215977, 0.020765410736203194, -0.035544395446777344, 0.00012222040095366538, 0.026775218546390533, -0.020402951166033745, -0.031101346015930176, 0.021490328013896942, -0.007348570041358471, 0.016135284677147865, 0.0011034547351300716, 0.022881705313920975, -0.04733017086982727, 0.025722919031977654, 0.009277788922190666, -0.050276611000299454, -0.029815200716257095, -0.010628242045640945, -0.047213245183229446, 0.006424883380532265, -0.02712598629295826, -0.007009495049715042, 0.03647977486252785, -0.029815200716257095, -0.016088515520095825, 0.010604857467114925, -0.03556777909398079, 0.0300256609916687, -0.03708777204155922, 0.029020128771662712, -0.01831004023551941, 0.003913975786417723, -0.00945901870727539, 0.01634574495255947, 0.01177408080548048, -0.0004932662122882903, 0.04190497100353241, 0.005878271535038948, 0.0031627498101443052, -0.03198995813727379, 0.01868419162929058, -0.02831859514117241, 0.0038292070385068655, 0.05008953809738159, -0.0006299191736616194, 0.009967630729079247, 0.026845373213291168, -0.01177408080548048, 0.00031386344926431775, -0.002000833861529827, 0.027523523196578026, 0.004577510058879852, 0.0022288323380053043, -0.030493350699543953, 0.0037970535922795534, 0.024764154106378555, -0.02116294577717781, -0.0393092967569828, 0.005252736620604992, 0.0044138189405202866, 0.02726629376411438, 0.0012488769134506583, 0.03923914209008217, 0.032293953001499176, -0.0034959784243255854, 0.02955797128379345, 0.02483430877327919, 0.04906062036752701, -0.002155755879357457, 0.025956762954592705, 0.0629042237997055, -0.00558888865634799, 0.020683564245700836, -0.014837446622550488, -0.07193063199520111, -0.016369130462408066, -0.007594107184559107, 0.01831004023551941, -0.00791564304381609, -0.041156668215990067, -0.016497744247317314, 0.007471338380128145, -0.007810413371771574, 0.03694746270775795, -0.009149174205958843, 0.005150429904460907, -0.005355043802410364, -0.006670420523732901, -0.011931926012039185, -0.010751010850071907, 0.025418920442461967, 0.009640248492360115, -0.021583866328001022, 0.032434262335300446, 0.006459960248321295, -0.03156903758645058, 0.024226311594247818, 0.0519602932035923, -0.043167732656002045, -0.021770942956209183, 0.021958017721772194, 0.024202927947044373, 0.04920092597603798, 0.020508181303739548, 0.014603601768612862, 0.00791564304381609, 0.0037619767244905233, 0.020636795088648796, 0.00014067221491131932, -0.0013175688218325377, 0.018719268962740898, -0.03736838325858116, 0.027897674590349197, -0.024319849908351898, -0.017994349822402, 0.027710597962141037, -0.05640334263443947, 0.006407345179468393, 0.006115039344877005, -0.03103119321167469, -0.001765527529641986, -0.005305351689457893, 0.006085808388888836, -0.014591909945011139, -0.022051556035876274, -0.0338607132434845, 0.007401185110211372, 0.028201671317219734, -0.0032065955456346273, 0.0067639583721756935, -0.06313807517290115, -0.019993722438812256, -0.027102602645754814, 0.021700788289308548, 0.016088515520095825, 0.013972221873700619, -0.012908227741718292, 0.0016515282914042473, 0.0006288230651989579, 0.008798407390713692, -0.017246047034859657, -0.03647977486252785, -0.02295185811817646, -0.03203672543168068, -0.001709989388473332, 0.009494095109403133, -0.022332169115543365, 0.008207948878407478, -0.01804111897945404, 0.028014596551656723, -0.025442304089665413, -0.005369659047573805, 0.011996233835816383, -0.008716561831533909, 0.019678032025694847, 0.004717817064374685, 0.02391062118113041, 0.004571664147078991, 0.0042530507780611515, -0.002655599033460021, 0.023349395021796227, -0.01218330953270197, 0.023665085434913635, -0.0038788991514593363, -0.0028017519507557154, 0.014930984936654568, -0.009973476640880108, 0.013948837295174599, -0.023290934041142464, 0.013855298981070518, 0.015538981184363365, -0.02983858436346054, -0.031288422644138336, -0.0026804450899362564, 0.023279240354895592, -0.04253635182976723, 0.007705183234065771, -0.020952485501766205, 0.016731588169932365, 0.018742652609944344, -0.032013341784477234, -0.006512575317174196, 0.0352637805044651, 0.023431239649653435, 0.0003884014440700412, 0.0005977655528113246, 0.009622709825634956, 0.022425707429647446, 0.030727194622159004, 0.028201671317219734, -0.01877772994339466, 0.021279867738485336, 0.02276478335261345, -0.010476242750883102, 0.011984541080892086, 0.026284145191311836, -0.03156903758645058, 0.02813151851296425, 0.016953742131590843, -0.008038411848247051, -0.0035778239835053682, -0.029768431559205055, 0.010014399886131287, -0.03460901603102684, 0.0015185291413217783, 0.026471221819519997, -0.021537097170948982, -0.040829285979270935, -0.03872468322515488, -0.014872523956000805, 0.021420175209641457, 0.007097186986356974, -0.0039753601886332035, -0.010505473241209984, -0.02831859514117241, 0.05252152308821678, 0.01762019842863083, 0.04293388873338699, -0.01291992049664259, 0.014720524661242962, 0.017374662682414055, -0.039449602365493774, -0.004299819469451904, -0.006471652537584305, 0.023630008101463318, -0.026564758270978928, -0.014708831906318665, -0.007658414077013731, 0.013691607862710953, -0.01277961302548647, 0.02855243906378746, -0.02450692653656006, -0.02799121104180813, 0.026401067152619362, -0.026751834899187088, -0.03376717492938042, -0.0033585946075618267, 0.009763016365468502, -0.026471221819519997, 0.0188244991004467, -0.022647859528660774, 0.0312182679772377, -0.000684361148159951, -0.02322077937424183, -0.008587947115302086, -0.004352434538304806, -0.023630008101463318, 0.014603601768612862, -0.050463687628507614, 0.013937144540250301, -0.014404834248125553, 0.02845890074968338, 0.014428218826651573, -0.0032095187343657017, -0.03608223795890808, 0.01588974893093109, -0.019163573160767555, 0.025863224640488625, 0.021198023110628128, 0.02263616770505905, 0.014778985641896725, 0.02598014660179615, 0.00020169105846434832, 0.009014713577926159, -0.010493781417608261, -0.003560285782441497, 0.02340785600244999, 0.02336108684539795, 0.022601090371608734, -0.020905716344714165, -0.007769490592181683, -0.0015872209332883358, 0.010435320436954498, 0.03262133523821831, -0.003823361126706004, -0.0015930670779198408, -0.0014768755063414574, -0.026003532111644745, 0.03231734037399292, 0.03456224873661995, -0.007594107184559107, -0.04246620088815689, -0.024273080751299858, 0.04536587372422218, 0.0006112846895121038, 0.010546396486461163, -0.0036509004421532154, 0.050697531551122665, 0.008506101556122303, 0.007260878104716539, -0.003957821521908045, -0.017935888841748238, 0.019982030615210533, 0.06659897416830063, -0.015574057586491108, -0.04632463678717613, -0.011768234893679619, -0.06253007799386978, 0.04012775048613548, 0.0402914434671402, -0.028716130182147026, -0.004673971328884363, 0.005974732339382172, 0.009646094404160976, -0.0002015083737205714, -0.031101346015930176, -0.000533458252903074, 0.02616722323000431, 0.02983858436346054, -0.028014596551656723, -0.023934006690979004, -0.014685448259115219, -0.010189782828092575, 0.017935888841748238, -0.014849139377474785, -0.005638580769300461, 0.016731588169932365, 0.06557005643844604, -0.033580102026462555, -0.0037736690137535334, -0.017503276467323303, 0.011762388981878757, -0.03708777204155922, 0.02006387524306774, -0.01960787922143936, 0.01932726614177227, 0.0038905914407223463, 0.0011736081214621663, 0.003417055821046233, -0.011487621814012527, 0.022893397137522697, 0.04667540267109871, 0.0024012927897274494, 0.03540408983826637, -0.0028207518626004457, -0.01358637772500515, -0.00975132454186678, -0.01071593351662159, -0.03771915286779404, -0.013808529824018478, -0.030586889013648033, 0.005714579951018095, -0.028108134865760803, 0.05013630539178848, 0.012697767466306686, 0.012732844799757004, 0.00033651714329607785, -0.030680425465106964, 0.0013241457054391503, -0.0007921489304862916, -0.023630008101463318, 0.03994067758321762, -0.02436661906540394, 0.03189641982316971, -0.01762019842863083, -0.005659041926264763, 0.009307019412517548, 0.06776819378137589, -0.021408483386039734, -0.012288539670407772, 0.027336446568369865, 0.03524039685726166, -0.00023585431335959584, 0.015188214369118214, 0.015118060633540154, 0.007161494344472885, 0.08586777746677399, 0.013340841047465801, 0.0006668228306807578, 0.0047850473783910275, -0.0023340624757111073, -0.018941421061754227, 0.013457763008773327, 0.005334582645446062, 0.04489818587899208, 0.02808474935591221, -0.0018766038119792938, -0.018836190924048424, -0.016509436070919037, -0.03208349272608757, -0.007424569688737392, -0.007658414077013731, 0.0053725820034742355, -0.0207420252263546, -0.021794326603412628, -0.01080947183072567, -0.00879256147891283, -0.02066018059849739, 0.03177949786186218, -0.0013818760635331273, 0.004270588979125023, -0.022004786878824234, -0.03652654215693474, -0.011166084557771683, -0.03308902680873871, 0.014217758551239967, -0.0023808313999325037, 0.0030399812385439873, 0.010780241340398788, -0.018625730648636818, -0.02202817238867283, 0.028388747945427895, -0.015679288655519485, -0.016965433955192566, -0.005433966405689716, 0.02047310397028923, -0.007798721082508564, -0.051071684807538986, 0.008891944773495197, -0.0024699848145246506, 0.022566014900803566, -0.02707921713590622, 0.008207948878407478, -0.004407972563058138, 0.029674893245100975, -0.01149931363761425, -0.004013360012322664, 0.006576882675290108, 0.01349283941090107, 0.03781269118189812, -0.03107796236872673, 0.02569953352212906, 0.016696512699127197, 0.004168281797319651, -0.0059075020253658295, -0.06753434985876083, -0.013457763008773327, -0.01698881760239601, -0.008915329352021217, 0.007997489534318447, 0.017737120389938354, -0.0024977538269013166, 0.011283007450401783, 0.002716983202844858, 0.02583984099328518, 0.008202102966606617, -0.007266724482178688, 0.053176287561655045, -0.015726055949926376, 0.01766696758568287, 0.011037470772862434, -0.009915015660226345, 0.010704241693019867, 0.015667594969272614, 0.016450975090265274, -0.028973359614610672, -0.036152392625808716, -0.013527916744351387, 0.020847255364060402, 0.0035865933168679476, 0.01945587992668152, 0.007389492820948362, 0.010061169043183327, -0.039262525737285614, 0.03383732959628105, -0.025535842403769493, 0.006997802760452032, -0.044828031212091446, -0.043728962540626526, -0.025301998481154442, 0.03902868181467056, -0.012978381477296352, 0.03535731881856918, 0.006875034421682358, 0.005337505601346493, 0.033018872141838074, -0.045973870903253555, 0.0017845274414867163, 0.045833561569452286, 0.02051987312734127, -0.008862714283168316, 0.022753089666366577, 0.04174128174781799, 0.02988535352051258, -0.005889963824301958, -0.02612045407295227, 0.02213340252637863, 0.01246392261236906, 0.011142700910568237, -0.00909071322530508, -0.02092910185456276, 0.013118688017129898, -0.023758621886372566, 0.034398555755615234, -0.004460587631911039, -0.024577079340815544, -0.027921058237552643, 0.02988535352051258, 1.181783591164276e-05, -0.004478126298636198, 0.025442304089665413, 0.018929729238152504, 0.014428218826651573, -0.01873096078634262, 0.007097186986356974, -0.06304453313350677, 0.012089771218597889, -0.013329148292541504, 0.00012359058018773794, 0.007161494344472885, 0.016825126484036446, -0.028926590457558632, 0.008956252597272396, 0.013668223284184933, 0.010388551279902458, 0.01016639918088913, -0.002904058899730444, -0.033205948770046234, -0.002233217004686594, 0.0076876450330019, -0.04980892315506935, -0.0016734511591494083, 0.053316593170166016, -0.006091654766350985, -0.026424452662467957, -0.002389600733295083, 0.02345462515950203, 0.005711656995117664, -0.011247930116951466, 0.023057088255882263, 0.024390002712607384, 0.012241770513355732, 0.0781976729631424, 0.01937403343617916, 0.011855926364660263, 0.013948837295174599, -0.03273826092481613, -0.016263900324702263, 0.0024743692483752966, -0.039449602365493774, -0.014849139377474785, 0.0084008714184165, 0.022273708134889603, 0.001937987981364131, 0.031007807701826096, 0.019105112180113792, 0.03535731881856918, -0.02005218341946602, -0.03659669682383537, -0.026728451251983643, 0.016871895641088486, -0.011587005108594894, 0.006249499972909689, 0.024530310183763504, -0.014591909945011139, -0.03699423372745514, -0.005632734391838312, 0.0042647430673241615, -0.010593165643513203, -0.011271314695477486, 0.015071291476488113, 0.04019790515303612, 0.0443369559943676, 0.019572801887989044, 0.020040491595864296, 0.007290109060704708, 0.0018868345068767667, -0.01186761911958456, -0.024904461577534676, -0.005021815188229084, -0.006144269835203886, -0.042302507907152176, 0.0028689822647720575, -0.006921803578734398, -0.025185074657201767, 0.02817828767001629, 0.02565276436507702, 0.007693490944802761, -0.01992356963455677, -0.01574944145977497, 0.031194884330034256, 0.01639251410961151, 0.011598697863519192, 0.009950092062354088, -0.040922824293375015, -0.007518107537180185, 0.004407972563058138, -0.0312182679772377, -0.01959618739783764, -0.006851649843156338, 0.0005148237687535584, 0.017561737447977066, -0.02873951569199562, -0.005863656289875507, 0.00451027974486351, 0.02441338822245598, 0.004495664499700069, -0.013258995488286018, -0.03767238184809685, 0.011470083147287369, 0.02318570390343666, -0.0005809579743072391, 0.0012744537089020014, 0.009850708767771721, 2.6216184778604656e-05, -0.024132773280143738, -0.020718641579151154, 0.0014586063334718347, -0.013095303438603878, -0.029300741851329803, -0.005673657171428204, 0.013329148292541504, -0.014463295228779316, 0.03271487355232239, 0.02827182598412037, 0.0056531960144639015, 0.014077452011406422, -0.04089944064617157, 0.005702887661755085, 0.016053440049290657, 0.0037532076239585876, -0.02817828767001629, -0.01016639918088913, -0.018438655883073807, -0.023384470492601395, -0.013481147587299347, -0.008827637881040573, 0.02198140323162079, -0.023396164178848267, -0.008897791616618633, 0.057946719229221344, -0.05191352590918541, 0.04994922876358032, -0.01987680047750473, -0.010476242750883102, -0.014825754798948765, 0.004758739843964577, 0.013177148997783661, -0.008576254360377789, 0.017702044919133186, 0.0016807588981464505, 0.002987366169691086, -0.024343233555555344, -0.0021133716218173504, 0.026190606877207756, -0.02598014660179615, 0.017012203112244606, -0.05897563695907593, 0.022191863507032394, -0.01648605242371559, 0.02726629376411438, 0.027196139097213745, 0.00629042275249958, -0.005422274116426706, 0.029253972694277763, 0.014170989394187927, 0.004045513458549976, -0.055421195924282074, 0.008225487545132637, 0.023524777963757515, -0.027196139097213745, -0.016310667619109154, -0.0032972104381769896, -0.020812179893255234, 0.032060109078884125, 0.003466747933998704, 0.02497461438179016, 0.035778239369392395, -0.02213340252637863, -0.022402323782444, 0.00596888642758131, 0.0019789107609540224, -0.014381449669599533, -0.026284145191311836, 0.00913748238235712, 0.02464723214507103, -0.007705183234065771, 0.006284576375037432, -0.0152583671733737, -0.04153082147240639, -0.013983913697302341, 0.010558088310062885, -0.005901655647903681, 0.0023472162429243326, -0.0008279564208351076, -0.027242908254265785, -0.03566131740808487, 0.030329659581184387, -0.011300545185804367, 0.013995605520904064, 0.0319431871175766, -0.023969082161784172, -0.04627786949276924, -0.01978326216340065, -0.019724801182746887, -0.011212853714823723, -0.0032153648789972067, 0.007670106366276741, 0.01881280727684498, 0.011815004050731659, 0.03437517210841179, 0.03285518288612366, -0.0010435320436954498, 0.006606113165616989, 0.0060331933200359344, 0.008915329352021217, 0.012592537328600883, 0.006348883733153343, -0.0013818760635331273, 0.037344999611377716, -0.03271487355232239, -0.007231647614389658, 0.033393025398254395, 0.0014344911323860288, -0.014112528413534164, -0.017982657998800278, -0.0077344137243926525, 0.04496833682060242, 0.02198140323162079, 0.004352434538304806, 0.006360576022416353, -0.012615921907126904, 0.01629897579550743, 0.012931612320244312, -0.02845890074968338, 0.03594193235039711, -0.023150626569986343, -0.015913132578134537, 0.022554323077201843, -0.0012364538852125406, -0.0071556479670107365, 0.03154565021395683, 0.015457135625183582, 0.026751834899187088, -0.015901440754532814, 0.00033925753086805344, 0.027055833488702774, -0.002127986866980791, -0.03860776126384735, -0.026915526017546654, -0.0004877854371443391, -0.004825970157980919, 0.01140577532351017, 0.020590025931596756, -0.02813151851296425, 0.0027199063915759325, 0.013001766055822372, -0.009634401649236679, 0.015492212027311325, 0.03208349272608757, 0.009669478982686996, 0.010604857467114925, 0.012650999240577221, 0.04627786949276924, -0.022343862801790237, -0.0018736807396635413, -0.015562365762889385, 0.012428846210241318, 0.024904461577534676, 0.003908129874616861, 0.011014086194336414, -0.015913132578134537, 0.011838388629257679, 0.01831004023551941, -0.0022200632374733686, 0.005679503548890352, 0.01703558675944805, -0.026658296585083008, 0.00258690700866282, 0.0004494203021749854, 0.028154904022812843, -0.02312724106013775, -0.012393769808113575, 0.018707577139139175, -0.021466944366693497, -0.03367363661527634, 0.022519245743751526, 0.03159242123365402, -0.0009193019941449165, -0.0041010514833033085, -0.03524039685726166, -0.01182084996253252, 0.006343037821352482, 0.024436771869659424, -0.009955938905477524, -0.010768548585474491, -0.044407110661268234, -0.011288853362202644, 0.013983913697302341, -0.016684820875525475, -0.0036713620647788048, -0.011896849609911442, 0.001955526415258646, -0.031054576858878136, 0.012241770513355732, -0.022004786878824234, 0.01877772994339466, 0.0006551305996254086, 0.0025795993860810995, 0.020648488774895668, 0.043448347598314285, -0.004445972386747599, -0.01758512295782566, 0.018789421766996384, 0.010698395781219006, -0.0196546483784914, -0.011458390392363071, -0.004951661918312311, -0.013866991735994816, -0.016240514814853668, -0.007693490944802761, -0.00011847523273900151, -0.005004276987165213, 0.04938800260424614, -0.005045199766755104, 0.014112528413534164, -0.032340724021196365, -0.0002069891052087769, -0.010067014954984188, 0.03117150068283081, 0.01959618739783764, 0.040969591587781906, -0.012428846210241318, -0.019678032025694847, 0.0026643681339919567, 0.005872425157576799, -0.018520500510931015, 0.037391770631074905, -0.034492094069719315, -0.013983913697302341, -0.008459332399070263, -0.014065759256482124, 0.006284576375037432, -0.050697531551122665, 0.002317985752597451, -0.001521452097222209, 0.01193777285516262, -0.017889119684696198, 0.04139051213860512, 0.029207203537225723, 0.043027427047491074, 0.0056502725929021835, -0.016404205933213234, 0.01721097156405449, -0.009961784817278385, -0.011551928706467152, 0.021139562129974365, -0.0077227214351296425, 0.0026877527125179768, -0.025582611560821533, -0.0015974516281858087, 0.03980036824941635, -0.026728451251983643, 0.023115549236536026, 0.006576882675290108, -0.013036842457950115, 0.00945317279547453, -0.04008098319172859, -0.02817828767001629, 0.0033030565828084946, 0.03016596846282482, 0.0034696708898991346, -0.0015550673706457019, 0.0060799624770879745, 0.024483541026711464, -0.06795527040958405, -0.008851022459566593, 0.009558402933180332, -0.016649743542075157, -0.026424452662467957, 0.04143728315830231, -0.011207007803022861, 0.02243739925324917, 0.006816573441028595, -0.006150115747004747, -0.010938086546957493, -0.0003589881816878915, 0.014439910650253296, -0.0026424452662467957, 0.02257770672440529, 0.0194208025932312, 0.008605485782027245, 0.0012349924072623253, -0.02001710794866085, -0.011920234188437462, -0.0031802880112081766, 0.010037784464657307, 0.03046996518969536, -0.01145254448056221, 0.042302507907152176, -0.016369130462408066, 0.012101463973522186, -0.041296977549791336, -0.038397300988435745, 0.027617059648036957, -0.01106085442006588, -0.0002711137058213353, 0.0002961423888336867, -0.015153137035667896, 0.005261505953967571, 0.021654020994901657, -0.005764272063970566, -0.028716130182147026, 0.0010932240402325988, -0.011616235598921776, -0.01901157572865486, 0.03411794453859329, 0.02974504791200161, -0.0060448856092989445, -0.02226201631128788, 0.0039870524778962135, 0.01501283049583435, 0.02764044515788555, -0.03397763520479202, 0.03984713926911354, 0.00945317279547453, 0.026097070425748825, 0.004881508182734251, 0.027780750766396523, -0.03418809548020363, 0.0182281956076622]', 642, NOW());
+UNIT DEREK, all body baret penyok, bumper depan belakang baret penyok renggang, foglamp TA, buku manual servis TA, dongkrak toolkit TA, sebagian komponen mesin, ecu dan kelistrikan TA, kap mesin repair,; Note 2: ADA BIAYA TAMBAHAN PPN SEBESAR 1,1 % DARI HARGA TERBENTUK DIBEBANKAN KE PEMENANG LELANG // UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI', '[-0.023536469787359238, -0.014767293818295002, 0.0011378006311133504, -0.014673755504190922, -0.029113667085766792, 0.0023676776327192783, -0.02969827875494957, 0.025348765775561333, -0.021455252543091774, -0.05121199041604996, 0.06397991627454758, -0.03535731881856918, -0.0038905914407223463, -0.009359634481370449, 0.050463687628507614, 0.07113555818796158, 0.016123592853546143, -0.0029011359438300133, -0.03914560377597809, -0.021151253953576088, 0.011388237588107586, 0.020250951871275902, -0.011417468078434467, -0.03512347489595413, 0.04274681210517883, -0.02331431768834591, -0.023665085434913635, -0.08217303454875946, -0.004320281092077494, -0.08525978028774261, -0.0008929944597184658, -0.034866247326135635, 0.04417326673865318, -0.016053440049290657, -0.032293953001499176, 0.04139051213860512, 0.06220269203186035, -0.009207635186612606, 0.019198650494217873, -0.032527800649404526, -0.027967827394604683, -0.02754690684378147, 0.009833170101046562, 0.058461178094148636, 0.05275536701083183, 0.0009624171070754528, -0.0042530507780611515, -0.0383739173412323, 0.011399929411709309, 0.04447726532816887, 0.024483541026711464, 0.039543140679597855, 0.01505959965288639, 0.08596131205558777, -0.011201161891222, 0.013457763008773327, -0.009242712520062923, 0.04994922876358032, 0.08156503736972809, 0.009026405401527882, -0.016906972974538803, -0.020461412146687508, -0.011142700910568237, 0.058227334171533585, -0.012639306485652924, -0.03308902680873871, -0.025395534932613373, 0.03269148990511894, -0.041296977549791336, -0.008202102966606617, 0.009961784817278385, 0.058320872485637665, 0.029160436242818832, -0.007576568517833948, -0.022145094349980354, 0.014720524661242962, 0.027009064331650734, -0.03213026374578476, 0.0056415037252008915, 0.016415897756814957, -0.0314754992723465, -0.034913014620542526, -0.029768431559205055, -0.016146976500749588, -0.005904579069465399, -0.057946719229221344, -0.06468144804239273, -0.02855243906378746, -0.034959785640239716, -0.024436771869659424, -0.029441049322485924, -0.020765410736203194, 0.0066353436559438705, 0.024062620475888252, 0.0431443490087986, 0.0062202694825828075, -0.034585632383823395, -0.03065704181790352, -0.004463511053472757, 0.038631144911050797, 0.026190606877207756, 0.03626931458711624, 0.026330914348363876, -0.0297918152064085, 0.013890375383198261, 0.0002174024994019419, -0.02243739925324917, -0.028435517102479935, -0.03519362956285477, 0.02626076154410839, -0.05654365196824074, 0.015538981184363365, 0.004706124775111675, 0.05645011365413666, 0.010090399533510208, -0.0024758309591561556, 0.0045307413674890995, -0.009353788569569588, -0.036830540746450424, -0.04786801338195801, 0.007161494344472885, 0.010675011202692986, 0.010914701968431473, -0.07642044872045517, -0.04938800260424614, 0.021595558151602745, -0.015211598016321659, -0.02520846016705036, 0.003688900265842676, -0.002754983026534319, 0.009874093346297741, -0.010984855704009533, 0.003899360541254282, 0.01046455092728138, -0.0033585946075618267, -0.026821987703442574, -0.03231734037399292, -0.020449720323085785, -0.026868756860494614, -0.03921575844287872, -0.0015258367639034986, 0.002772521460428834, 0.015913132578134537, 0.00012779247481375933, -0.08114411681890488, 0.021314945071935654, -0.000858648563735187, -0.004805509001016617, 0.0073193395510315895, 0.007073802407830954, -0.02135002240538597, 0.025185074657201767, -0.05925624817609787, -0.06622482091188431, 0.004995507653802633, -0.021829403936862946, -0.014030682854354382, 0.0048873545601964, -0.0352637805044651, 0.014416526071727276, 0.003677207976579666, -0.002040295163169503, 0.014545140787959099, -0.002728675492107868, -0.007366108242422342, 0.005556734744459391, -0.007097186986356974, -0.012615921907126904, -0.07314662635326385, -0.018158040940761566, 0.029815200716257095, -0.009102405048906803, -0.029207203537225723, -0.057946719229221344, -0.012382077053189278, -0.037438537925481796, 0.03755545988678932, -0.02864597737789154, 0.009131635539233685, 0.029628124088048935, -0.014977754093706608, -0.007705183234065771, 0.021057715639472008, -0.0013380302116274834, -0.03098442405462265, -0.008687331341207027, -0.005536273587495089, 0.04763416573405266, 0.0051095071248710155, 0.024179542437195778, -0.007354415953159332, 0.013773453421890736, 0.0005115353269502521, 0.013574684970080853, -0.016591282561421394, -0.04162435978651047, 0.01721097156405449, 0.033486563712358475, -0.018345117568969727, 0.0017742967465892434, 0.007944874465465546, -0.01560913398861885, 0.042396046221256256, 0.04146066680550575, -0.053176287561655045, 0.015667594969272614, -0.019116805866360664, -0.002421754179522395, 0.06617805361747742, -0.017152508720755577, 0.08890775591135025, 0.011768234893679619, 0.016509436070919037, 0.01702389493584633, 0.0014249911764636636, 0.037976380437612534, -0.019818339496850967, 0.0042618196457624435, 0.0049575078301131725, 0.018333425745368004, -0.011744850315153599, -0.033439792692661285, -0.007658414077013731, 0.0011648390209302306, -0.00865225400775671, -0.004548279568552971, 0.01712912507355213, 0.019034959375858307, 0.02565276436507702, -0.05743226036429405, -0.034796092659235, -0.024109389632940292, 0.016731588169932365, -0.021583866328001022, 0.013118688017129898, -0.0015156060690060258, 0.02304539643228054, 0.030212735757231712, 0.0018400655826553702, -0.015141445212066174, -0.015819594264030457, 0.006968572270125151, 0.0003728726878762245, -0.001622297684662044, 0.047353554517030716, -0.0026453682221472263, 0.007009495049715042, -0.0016515282914042473, 0.01945587992668152, 0.028903206810355186, -0.026237376034259796, 0.03306564316153526, -0.025582611560821533, -0.037532076239585876, 0.011294699274003506, 0.0060799624770879745, -0.04733017086982727, -0.038397300988435745, 0.0001991333847399801, -0.04305081069469452, -0.02965150959789753, 0.014042374677956104, -0.009499941021203995, -0.031381960958242416, -0.02171248197555542, -0.029324127361178398, 0.044921569526195526, 0.026143837720155716, 0.009926708415150642, -0.01758512295782566, -0.011815004050731659, -0.015550673007965088, 0.05490673705935478, 0.041016362607479095, -0.03921575844287872, -0.03589516133069992, 0.006582728587090969, 0.01744481548666954, 0.01725773885846138, 0.01584297977387905, -0.017514968290925026, -0.0072959549725055695, -0.01574944145977497, 0.018602347001433372, 0.0013285302557051182, 0.024179542437195778, 0.024039236828684807, -0.019946953281760216, -0.02171248197555542, 0.019946953281760216, 0.02331431768834591, -0.032200418412685394, 0.02703244797885418, -0.027921058237552643, 0.005448581650853157, -0.022250324487686157, 0.02295185811817646, -0.03264472261071205, 0.016696512699127197, 0.008874407038092613, 0.07202417403459549, 0.01282638218253851, -0.024015851318836212, -0.023793699219822884, -0.0173980463296175, 0.010540550574660301, -0.02318570390343666, -0.014077452011406422, -0.03783607482910156, -0.041600972414016724, 0.018146349117159843, 0.017737120389938354, 0.005395966582000256, -0.053363364189863205, 0.05238121375441551, 0.000726014724932611, 0.0005933809443376958, -0.00826056394726038, 0.06318484246730804, -0.0491073876619339, 0.021092792972922325, 0.01670820452272892, 0.01587805524468422, 0.0033469023182988167, -0.0221684779971838, -0.013691607862710953, 0.018882960081100464, -0.010774395428597927, 0.01707066409289837, -0.00034309402690269053, -0.009570094756782055, -0.028482286259531975, 0.02019249089062214, -0.0110842389985919, 0.01767865940928459, 0.009575940668582916, -0.020718641579151154, 0.025091538205742836, -0.007272570393979549, -0.030142582952976227, -0.048359084874391556, 0.004720740020275116, 0.007903951220214367, 0.027429984882473946, -0.02309216558933258, -0.03107796236872673, 0.02001710794866085, 0.015445442870259285, 0.028973359614610672, -0.015503903850913048, -0.03271487355232239, -0.011189469136297703, 0.0023618314880877733, 0.03135857731103897, -0.009850708767771721, -0.014510064385831356, 0.009944246150553226, 0.03814007341861725, -0.016030054539442062, 0.04679232835769653, 0.02621399238705635, -0.0011151469079777598, -5.914626854064409e-06, 0.019806647673249245, 0.02212170884013176, 0.03580162674188614, 0.005077353212982416, 0.025091538205742836, 0.0383739173412323, -0.020952485501766205, 0.05397135764360428, 0.007588260807096958, -0.014089143835008144, 0.01735127717256546, -0.002054910408332944, -0.034351788461208344, 0.03250441327691078, 0.041600972414016724, -0.025582611560821533, 0.0020505257416516542, 0.05378428474068642, -0.05761933699250221, 0.0038525916170328856, -0.00038803607458248734, 0.006769804283976555, 0.0125106917694211, 0.01891803741455078, 0.06846973299980164, 0.0001096147097996436, -0.05453258752822876, -0.02841213159263134, -0.00973378587514162, 0.03170934319496155, -0.03217703104019165, 0.015597442165017128, 0.008237180300056934, 0.027336446568369865, 0.011312237940728664, -0.029043512418866158, -0.008599638938903809, 0.0045307413674890995, -0.022226938977837563, -0.014568525366485119, -0.023934006690979004, 0.015492212027311325, 0.03741515427827835, 0.02230878546833992, 0.06234300136566162, -0.004717817064374685, -0.051165223121643066, 0.02703244797885418, -0.03126503899693489, 0.018146349117159843, 0.015597442165017128, 0.012054694816470146, -0.03411794453859329, -0.003238749224692583, 0.06033193692564964, -0.010125475935637951, 0.017608506605029106, 0.008137796074151993, -0.004799662623554468, -0.01560913398861885, 0.01863742433488369, -0.05111845210194588, -0.005276121199131012, 0.015433751046657562, 0.012241770513355732, -0.008570408448576927, -0.02745336852967739, -0.010727626271545887, -0.020075568929314613, -0.05701133981347084, 0.03966006264090538, -0.047119710594415665, 0.008710715919733047, 0.005965963006019592, 0.03748530521988869, -0.018345117568969727, -0.07015341520309448, 0.029113667085766792, 0.02850566990673542, 0.007670106366276741, 0.06253007799386978, 0.008371640928089619, -0.024390002712607384, -0.0004212858621031046, 0.040057599544525146, -0.019584493711590767, -0.016766665503382683, -0.01983003132045269, 0.005018892232328653, 0.005273198243230581, 0.043635424226522446, -0.039356064051389694, 0.009295327588915825, -0.0012788382591679692, -0.010411935858428478, 0.04277019575238228, 0.01956111006438732, -0.0028324441518634558, 0.045412641018629074, 0.026354297995567322, 0.01758512295782566, 0.004612586926668882, -0.015690980479121208, -0.0610334686934948, -0.02143186703324318, 0.014381449669599533, 0.030633656308054924, -0.02111617662012577, 0.03514685854315758, -0.011750697158277035, -0.0017509122844785452, -0.0032475183252245188, 0.05397135764360428, -0.037976380437612534, -0.01652112789452076, -0.03594193235039711, -0.04031482711434364, -0.008219641633331776, -0.04305081069469452, -0.05537442862987518, -0.017187586054205894, -0.014042374677956104, 0.04284035041928291, 0.03921575844287872, 0.0015360674588009715, -0.07571891695261002, -0.008167026564478874, -0.016918664798140526, 0.00626703817397356, 0.048499394208192825, -0.010224860161542892, -0.014077452011406422, -0.01154608279466629, 0.02693891152739525, -0.0022069094702601433, -0.002604445442557335, 0.005784733686596155, -0.03919237479567528, -0.0215721745043993, -0.020087260752916336, -0.034632403403520584, 0.01491929218173027, 0.017737120389938354, -0.02974504791200161, 0.001994987716898322, -0.029160436242818832, -0.00762918358668685, 0.028716130182147026, 0.008453486487269402, -0.003417055821046233, -0.018064504489302635, 0.01532852090895176, -0.018076196312904358, 0.010090399533510208, 0.02855243906378746, -0.019794953987002373, -0.017783889546990395, 0.01615867018699646, 0.00844764057546854, 0.05219414085149765, -0.03757884353399277, 0.023431239649653435, -0.033533331006765366, -0.0002155755937565118, -0.10204983502626419, -0.005583042278885841, -0.013118688017129898, 0.019806647673249245, 0.014790677465498447, 0.019350649788975716, 0.004431357141584158, -0.018415270373225212, -0.0013402225449681282, 0.04167112708091736, 0.026143837720155716, -0.029230589047074318, -0.035778239369392395, -0.0027184446807950735, 0.021560482680797577, 0.0173980463296175, -0.028903206810355186, -0.022753089666366577, -0.030633656308054924, 0.010020245797932148, -0.0029537510126829147, -0.008517793379724026, 0.01940911076962948, 0.04513202980160713, 0.025395534932613373, -0.024109389632940292, 0.016871895641088486, 0.014381449669599533, 0.010599011555314064, -0.024249697104096413, -0.013399302028119564, -0.0023355239536613226, -0.019303880631923676, 0.0016427590744569898, 0.021279867738485336, 0.020894024521112442, 0.024530310183763504, 0.006407345179468393, -0.0036801311653107405, 0.012043002992868423, 0.015293444506824017, 0.007412877399474382, -0.03411794453859329, -0.058087024837732315, 0.009938400238752365, -0.0024816771037876606, 0.0037648999132215977, 0.023665085434913635, 0.018064504489302635, -0.05687103420495987, 0.0010486473329365253, 0.006606113165616989, -0.05261506140232086, -0.022063247859477997, -0.01425283495336771, 0.013212226331233978, 0.030493350699543953, 0.013165457174181938, 0.06706666201353073, -0.005901655647903681, 0.045412641018629074, 0.009850708767771721, 0.01291992049664259, 0.00020223914179950953, -0.01992356963455677, -0.008511947467923164, 0.0044985874556005, 0.0022010633256286383, -0.020847255364060402, 0.03283179551362991, 0.0011078392853960395, -0.011487621814012527, -0.0033527484629303217, -0.00975132454186678, 0.027476754039525986, -0.02735983021557331, 0.01071593351662159, 0.003875975962728262, -0.029253972694277763, 0.003066288772970438, 0.00030399812385439873, -0.02955797128379345, 0.0229986272752285, 0.016217131167650223, 0.04742370545864105, 0.04365880787372589, 0.0032358262687921524, -0.048312317579984665, 0.03559116646647453, -0.0252552293241024, -0.03133518993854523, 0.0010223397985100746, 0.01628728397190571, -0.013399302028119564, 0.003940283320844173, 0.04489818587899208, -0.0021089869551360607, -0.0007314955000765622, 0.0037648999132215977, 0.020765410736203194, -0.035544395446777344, 0.00012222040095366538, 0.026775218546390533, -0.020402951166033745, -0.031101346015930176, 0.021490328013896942, -0.007348570041358471, 0.016135284677147865, 0.0011034547351300716, 0.022881705313920975, -0.04733017086982727, 0.025722919031977654, 0.009277788922190666, -0.050276611000299454, -0.029815200716257095, -0.010628242045640945, -0.047213245183229446, 0.006424883380532265, -0.02712598629295826, -0.007009495049715042, 0.03647977486252785, -0.029815200716257095, -0.016088515520095825, 0.010604857467114925, -0.03556777909398079, 0.0300256609916687, -0.03708777204155922, 0.029020128771662712, -0.01831004023551941, 0.003913975786417723, -0.00945901870727539, 0.01634574495255947, 0.01177408080548048, -0.0004932662122882903, 0.04190497100353241, 0.005878271535038948, 0.0031627498101443052, -0.03198995813727379, 0.01868419162929058, -0.02831859514117241, 0.0038292070385068655, 0.05008953809738159, -0.0006299191736616194, 0.009967630729079247, 0.026845373213291168, -0.01177408080548048, 0.00031386344926431775, -0.002000833861529827, 0.027523523196578026, 0.004577510058879852, 0.0022288323380053043, -0.030493350699543953, 0.0037970535922795534, 0.024764154106378555, -0.02116294577717781, -0.0393092967569828, 0.005252736620604992, 0.0044138189405202866, 0.02726629376411438, 0.0012488769134506583, 0.03923914209008217, 0.032293953001499176, -0.0034959784243255854, 0.02955797128379345, 0.02483430877327919, 0.04906062036752701, -0.002155755879357457, 0.025956762954592705, 0.0629042237997055, -0.00558888865634799, 0.020683564245700836, -0.014837446622550488, -0.07193063199520111, -0.016369130462408066, -0.007594107184559107, 0.01831004023551941, -0.00791564304381609, -0.041156668215990067, -0.016497744247317314, 0.007471338380128145, -0.007810413371771574, 0.03694746270775795, -0.009149174205958843, 0.005150429904460907, -0.005355043802410364, -0.006670420523732901, -0.011931926012039185, -0.010751010850071907, 0.025418920442461967, 0.009640248492360115, -0.021583866328001022, 0.032434262335300446, 0.006459960248321295, -0.03156903758645058, 0.024226311594247818, 0.0519602932035923, -0.043167732656002045, -0.021770942956209183, 0.021958017721772194, 0.024202927947044373, 0.04920092597603798, 0.020508181303739548, 0.014603601768612862, 0.00791564304381609, 0.0037619767244905233, 0.020636795088648796, 0.00014067221491131932, -0.0013175688218325377, 0.018719268962740898, -0.03736838325858116, 0.027897674590349197, -0.024319849908351898, -0.017994349822402, 0.027710597962141037, -0.05640334263443947, 0.006407345179468393, 0.006115039344877005, -0.03103119321167469, -0.001765527529641986, -0.005305351689457893, 0.006085808388888836, -0.014591909945011139, -0.022051556035876274, -0.0338607132434845, 0.007401185110211372, 0.028201671317219734, -0.0032065955456346273, 0.0067639583721756935, -0.06313807517290115, -0.019993722438812256, -0.027102602645754814, 0.021700788289308548, 0.016088515520095825, 0.013972221873700619, -0.012908227741718292, 0.0016515282914042473, 0.0006288230651989579, 0.008798407390713692, -0.017246047034859657, -0.03647977486252785, -0.02295185811817646, -0.03203672543168068, -0.001709989388473332, 0.009494095109403133, -0.022332169115543365, 0.008207948878407478, -0.01804111897945404, 0.028014596551656723, -0.025442304089665413, -0.005369659047573805, 0.011996233835816383, -0.008716561831533909, 0.019678032025694847, 0.004717817064374685, 0.02391062118113041, 0.004571664147078991, 0.0042530507780611515, -0.002655599033460021, 0.023349395021796227, -0.01218330953270197, 0.023665085434913635, -0.0038788991514593363, -0.0028017519507557154, 0.014930984936654568, -0.009973476640880108, 0.013948837295174599, -0.023290934041142464, 0.013855298981070518, 0.015538981184363365, -0.02983858436346054, -0.031288422644138336, -0.0026804450899362564, 0.023279240354895592, -0.04253635182976723, 0.007705183234065771, -0.020952485501766205, 0.016731588169932365, 0.018742652609944344, -0.032013341784477234, -0.006512575317174196, 0.0352637805044651, 0.023431239649653435, 0.0003884014440700412, 0.0005977655528113246, 0.009622709825634956, 0.022425707429647446, 0.030727194622159004, 0.028201671317219734, -0.01877772994339466, 0.021279867738485336, 0.02276478335261345, -0.010476242750883102, 0.011984541080892086, 0.026284145191311836, -0.03156903758645058, 0.02813151851296425, 0.016953742131590843, -0.008038411848247051, -0.0035778239835053682, -0.029768431559205055, 0.010014399886131287, -0.03460901603102684, 0.0015185291413217783, 0.026471221819519997, -0.021537097170948982, -0.040829285979270935, -0.03872468322515488, -0.014872523956000805, 0.021420175209641457, 0.007097186986356974, -0.0039753601886332035, -0.010505473241209984, -0.02831859514117241, 0.05252152308821678, 0.01762019842863083, 0.04293388873338699, -0.01291992049664259, 0.014720524661242962, 0.017374662682414055, -0.039449602365493774, -0.004299819469451904, -0.006471652537584305, 0.023630008101463318, -0.026564758270978928, -0.014708831906318665, -0.007658414077013731, 0.013691607862710953, -0.01277961302548647, 0.02855243906378746, -0.02450692653656006, -0.02799121104180813, 0.026401067152619362, -0.026751834899187088, -0.03376717492938042, -0.0033585946075618267, 0.009763016365468502, -0.026471221819519997, 0.0188244991004467, -0.022647859528660774, 0.0312182679772377, -0.000684361148159951, -0.02322077937424183, -0.008587947115302086, -0.004352434538304806, -0.023630008101463318, 0.014603601768612862, -0.050463687628507614, 0.013937144540250301, -0.014404834248125553, 0.02845890074968338, 0.014428218826651573, -0.0032095187343657017, -0.03608223795890808, 0.01588974893093109, -0.019163573160767555, 0.025863224640488625, 0.021198023110628128, 0.02263616770505905, 0.014778985641896725, 0.02598014660179615, 0.00020169105846434832, 0.009014713577926159, -0.010493781417608261, -0.003560285782441497, 0.02340785600244999, 0.02336108684539795, 0.022601090371608734, -0.020905716344714165, -0.007769490592181683, -0.0015872209332883358, 0.010435320436954498, 0.03262133523821831, -0.003823361126706004, -0.0015930670779198408, -0.0014768755063414574, -0.026003532111644745, 0.03231734037399292, 0.03456224873661995, -0.007594107184559107, -0.04246620088815689, -0.024273080751299858, 0.04536587372422218, 0.0006112846895121038, 0.010546396486461163, -0.0036509004421532154, 0.050697531551122665, 0.008506101556122303, 0.007260878104716539, -0.003957821521908045, -0.017935888841748238, 0.019982030615210533, 0.06659897416830063, -0.015574057586491108, -0.04632463678717613, -0.011768234893679619, -0.06253007799386978, 0.04012775048613548, 0.0402914434671402, -0.028716130182147026, -0.004673971328884363, 0.005974732339382172, 0.009646094404160976, -0.0002015083737205714, -0.031101346015930176, -0.000533458252903074, 0.02616722323000431, 0.02983858436346054, -0.028014596551656723, -0.023934006690979004, -0.014685448259115219, -0.010189782828092575, 0.017935888841748238, -0.014849139377474785, -0.005638580769300461, 0.016731588169932365, 0.06557005643844604, -0.033580102026462555, -0.0037736690137535334, -0.017503276467323303, 0.011762388981878757, -0.03708777204155922, 0.02006387524306774, -0.01960787922143936, 0.01932726614177227, 0.0038905914407223463, 0.0011736081214621663, 0.003417055821046233, -0.011487621814012527, 0.022893397137522697, 0.04667540267109871, 0.0024012927897274494, 0.03540408983826637, -0.0028207518626004457, -0.01358637772500515, -0.00975132454186678, -0.01071593351662159, -0.03771915286779404, -0.013808529824018478, -0.030586889013648033, 0.005714579951018095, -0.028108134865760803, 0.05013630539178848, 0.012697767466306686, 0.012732844799757004, 0.00033651714329607785, -0.030680425465106964, 0.0013241457054391503, -0.0007921489304862916, -0.023630008101463318, 0.03994067758321762, -0.02436661906540394, 0.03189641982316971, -0.01762019842863083, -0.005659041926264763, 0.009307019412517548, 0.06776819378137589, -0.021408483386039734, -0.012288539670407772, 0.027336446568369865, 0.03524039685726166, -0.00023585431335959584, 0.015188214369118214, 0.015118060633540154, 0.007161494344472885, 0.08586777746677399, 0.013340841047465801, 0.0006668228306807578, 0.0047850473783910275, -0.0023340624757111073, -0.018941421061754227, 0.013457763008773327, 0.005334582645446062, 0.04489818587899208, 0.02808474935591221, -0.0018766038119792938, -0.018836190924048424, -0.016509436070919037, -0.03208349272608757, -0.007424569688737392, -0.007658414077013731, 0.0053725820034742355, -0.0207420252263546, -0.021794326603412628, -0.01080947183072567, -0.00879256147891283, -0.02066018059849739, 0.03177949786186218, -0.0013818760635331273, 0.004270588979125023, -0.022004786878824234, -0.03652654215693474, -0.011166084557771683, -0.03308902680873871, 0.014217758551239967, -0.0023808313999325037, 0.0030399812385439873, 0.010780241340398788, -0.018625730648636818, -0.02202817238867283, 0.028388747945427895, -0.015679288655519485, -0.016965433955192566, -0.005433966405689716, 0.02047310397028923, -0.007798721082508564, -0.051071684807538986, 0.008891944773495197, -0.0024699848145246506, 0.022566014900803566, -0.02707921713590622, 0.008207948878407478, -0.004407972563058138, 0.029674893245100975, -0.01149931363761425, -0.004013360012322664, 0.006576882675290108, 0.01349283941090107, 0.03781269118189812, -0.03107796236872673, 0.02569953352212906, 0.016696512699127197, 0.004168281797319651, -0.0059075020253658295, -0.06753434985876083, -0.013457763008773327, -0.01698881760239601, -0.008915329352021217, 0.007997489534318447, 0.017737120389938354, -0.0024977538269013166, 0.011283007450401783, 0.002716983202844858, 0.02583984099328518, 0.008202102966606617, -0.007266724482178688, 0.053176287561655045, -0.015726055949926376, 0.01766696758568287, 0.011037470772862434, -0.009915015660226345, 0.010704241693019867, 0.015667594969272614, 0.016450975090265274, -0.028973359614610672, -0.036152392625808716, -0.013527916744351387, 0.020847255364060402, 0.0035865933168679476, 0.01945587992668152, 0.007389492820948362, 0.010061169043183327, -0.039262525737285614, 0.03383732959628105, -0.025535842403769493, 0.006997802760452032, -0.044828031212091446, -0.043728962540626526, -0.025301998481154442, 0.03902868181467056, -0.012978381477296352, 0.03535731881856918, 0.006875034421682358, 0.005337505601346493, 0.033018872141838074, -0.045973870903253555, 0.0017845274414867163, 0.045833561569452286, 0.02051987312734127, -0.008862714283168316, 0.022753089666366577, 0.04174128174781799, 0.02988535352051258, -0.005889963824301958, -0.02612045407295227, 0.02213340252637863, 0.01246392261236906, 0.011142700910568237, -0.00909071322530508, -0.02092910185456276, 0.013118688017129898, -0.023758621886372566, 0.034398555755615234, -0.004460587631911039, -0.024577079340815544, -0.027921058237552643, 0.02988535352051258, 1.181783591164276e-05, -0.004478126298636198, 0.025442304089665413, 0.018929729238152504, 0.014428218826651573, -0.01873096078634262, 0.007097186986356974, -0.06304453313350677, 0.012089771218597889, -0.013329148292541504, 0.00012359058018773794, 0.007161494344472885, 0.016825126484036446, -0.028926590457558632, 0.008956252597272396, 0.013668223284184933, 0.010388551279902458, 0.01016639918088913, -0.002904058899730444, -0.033205948770046234, -0.002233217004686594, 0.0076876450330019, -0.04980892315506935, -0.0016734511591494083, 0.053316593170166016, -0.006091654766350985, -0.026424452662467957, -0.002389600733295083, 0.02345462515950203, 0.005711656995117664, -0.011247930116951466, 0.023057088255882263, 0.024390002712607384, 0.012241770513355732, 0.0781976729631424, 0.01937403343617916, 0.011855926364660263, 0.013948837295174599, -0.03273826092481613, -0.016263900324702263, 0.0024743692483752966, -0.039449602365493774, -0.014849139377474785, 0.0084008714184165, 0.022273708134889603, 0.001937987981364131, 0.031007807701826096, 0.019105112180113792, 0.03535731881856918, -0.02005218341946602, -0.03659669682383537, -0.026728451251983643, 0.016871895641088486, -0.011587005108594894, 0.006249499972909689, 0.024530310183763504, -0.014591909945011139, -0.03699423372745514, -0.005632734391838312, 0.0042647430673241615, -0.010593165643513203, -0.011271314695477486, 0.015071291476488113, 0.04019790515303612, 0.0443369559943676, 0.019572801887989044, 0.020040491595864296, 0.007290109060704708, 0.0018868345068767667, -0.01186761911958456, -0.024904461577534676, -0.005021815188229084, -0.006144269835203886, -0.042302507907152176, 0.0028689822647720575, -0.006921803578734398, -0.025185074657201767, 0.02817828767001629, 0.02565276436507702, 0.007693490944802761, -0.01992356963455677, -0.01574944145977497, 0.031194884330034256, 0.01639251410961151, 0.011598697863519192, 0.009950092062354088, -0.040922824293375015, -0.007518107537180185, 0.004407972563058138, -0.0312182679772377, -0.01959618739783764, -0.006851649843156338, 0.0005148237687535584, 0.017561737447977066, -0.02873951569199562, -0.005863656289875507, 0.00451027974486351, 0.02441338822245598, 0.004495664499700069, -0.013258995488286018, -0.03767238184809685, 0.011470083147287369, 0.02318570390343666, -0.0005809579743072391, 0.0012744537089020014, 0.009850708767771721, 2.6216184778604656e-05, -0.024132773280143738, -0.020718641579151154, 0.0014586063334718347, -0.013095303438603878, -0.029300741851329803, -0.005673657171428204, 0.013329148292541504, -0.014463295228779316, 0.03271487355232239, 0.02827182598412037, 0.0056531960144639015, 0.014077452011406422, -0.04089944064617157, 0.005702887661755085, 0.016053440049290657, 0.0037532076239585876, -0.02817828767001629, -0.01016639918088913, -0.018438655883073807, -0.023384470492601395, -0.013481147587299347, -0.008827637881040573, 0.02198140323162079, -0.023396164178848267, -0.008897791616618633, 0.057946719229221344, -0.05191352590918541, 0.04994922876358032, -0.01987680047750473, -0.010476242750883102, -0.014825754798948765, 0.004758739843964577, 0.013177148997783661, -0.008576254360377789, 0.017702044919133186, 0.0016807588981464505, 0.002987366169691086, -0.024343233555555344, -0.0021133716218173504, 0.026190606877207756, -0.02598014660179615, 0.017012203112244606, -0.05897563695907593, 0.022191863507032394, -0.01648605242371559, 0.02726629376411438, 0.027196139097213745, 0.00629042275249958, -0.005422274116426706, 0.029253972694277763, 0.014170989394187927, 0.004045513458549976, -0.055421195924282074, 0.008225487545132637, 0.023524777963757515, -0.027196139097213745, -0.016310667619109154, -0.0032972104381769896, -0.020812179893255234, 0.032060109078884125, 0.003466747933998704, 0.02497461438179016, 0.035778239369392395, -0.02213340252637863, -0.022402323782444, 0.00596888642758131, 0.0019789107609540224, -0.014381449669599533, -0.026284145191311836, 0.00913748238235712, 0.02464723214507103, -0.007705183234065771, 0.006284576375037432, -0.0152583671733737, -0.04153082147240639, -0.013983913697302341, 0.010558088310062885, -0.005901655647903681, 0.0023472162429243326, -0.0008279564208351076, -0.027242908254265785, -0.03566131740808487, 0.030329659581184387, -0.011300545185804367, 0.013995605520904064, 0.0319431871175766, -0.023969082161784172, -0.04627786949276924, -0.01978326216340065, -0.019724801182746887, -0.011212853714823723, -0.0032153648789972067, 0.007670106366276741, 0.01881280727684498, 0.011815004050731659, 0.03437517210841179, 0.03285518288612366, -0.0010435320436954498, 0.006606113165616989, 0.0060331933200359344, 0.008915329352021217, 0.012592537328600883, 0.006348883733153343, -0.0013818760635331273, 0.037344999611377716, -0.03271487355232239, -0.007231647614389658, 0.033393025398254395, 0.0014344911323860288, -0.014112528413534164, -0.017982657998800278, -0.0077344137243926525, 0.04496833682060242, 0.02198140323162079, 0.004352434538304806, 0.006360576022416353, -0.012615921907126904, 0.01629897579550743, 0.012931612320244312, -0.02845890074968338, 0.03594193235039711, -0.023150626569986343, -0.015913132578134537, 0.022554323077201843, -0.0012364538852125406, -0.0071556479670107365, 0.03154565021395683, 0.015457135625183582, 0.026751834899187088, -0.015901440754532814, 0.00033925753086805344, 0.027055833488702774, -0.002127986866980791, -0.03860776126384735, -0.026915526017546654, -0.0004877854371443391, -0.004825970157980919, 0.01140577532351017, 0.020590025931596756, -0.02813151851296425, 0.0027199063915759325, 0.013001766055822372, -0.009634401649236679, 0.015492212027311325, 0.03208349272608757, 0.009669478982686996, 0.010604857467114925, 0.012650999240577221, 0.04627786949276924, -0.022343862801790237, -0.0018736807396635413, -0.015562365762889385, 0.012428846210241318, 0.024904461577534676, 0.003908129874616861, 0.011014086194336414, -0.015913132578134537, 0.011838388629257679, 0.01831004023551941, -0.0022200632374733686, 0.005679503548890352, 0.01703558675944805, -0.026658296585083008, 0.00258690700866282, 0.0004494203021749854, 0.028154904022812843, -0.02312724106013775, -0.012393769808113575, 0.018707577139139175, -0.021466944366693497, -0.03367363661527634, 0.022519245743751526, 0.03159242123365402, -0.0009193019941449165, -0.0041010514833033085, -0.03524039685726166, -0.01182084996253252, 0.006343037821352482, 0.024436771869659424, -0.009955938905477524, -0.010768548585474491, -0.044407110661268234, -0.011288853362202644, 0.013983913697302341, -0.016684820875525475, -0.0036713620647788048, -0.011896849609911442, 0.001955526415258646, -0.031054576858878136, 0.012241770513355732, -0.022004786878824234, 0.01877772994339466, 0.0006551305996254086, 0.0025795993860810995, 0.020648488774895668, 0.043448347598314285, -0.004445972386747599, -0.01758512295782566, 0.018789421766996384, 0.010698395781219006, -0.0196546483784914, -0.011458390392363071, -0.004951661918312311, -0.013866991735994816, -0.016240514814853668, -0.007693490944802761, -0.00011847523273900151, -0.005004276987165213, 0.04938800260424614, -0.005045199766755104, 0.014112528413534164, -0.032340724021196365, -0.0002069891052087769, -0.010067014954984188, 0.03117150068283081, 0.01959618739783764, 0.040969591587781906, -0.012428846210241318, -0.019678032025694847, 0.0026643681339919567, 0.005872425157576799, -0.018520500510931015, 0.037391770631074905, -0.034492094069719315, -0.013983913697302341, -0.008459332399070263, -0.014065759256482124, 0.006284576375037432, -0.050697531551122665, 0.002317985752597451, -0.001521452097222209, 0.01193777285516262, -0.017889119684696198, 0.04139051213860512, 0.029207203537225723, 0.043027427047491074, 0.0056502725929021835, -0.016404205933213234, 0.01721097156405449, -0.009961784817278385, -0.011551928706467152, 0.021139562129974365, -0.0077227214351296425, 0.0026877527125179768, -0.025582611560821533, -0.0015974516281858087, 0.03980036824941635, -0.026728451251983643, 0.023115549236536026, 0.006576882675290108, -0.013036842457950115, 0.00945317279547453, -0.04008098319172859, -0.02817828767001629, 0.0033030565828084946, 0.03016596846282482, 0.0034696708898991346, -0.0015550673706457019, 0.0060799624770879745, 0.024483541026711464, -0.06795527040958405, -0.008851022459566593, 0.009558402933180332, -0.016649743542075157, -0.026424452662467957, 0.04143728315830231, -0.011207007803022861, 0.02243739925324917, 0.006816573441028595, -0.006150115747004747, -0.010938086546957493, -0.0003589881816878915, 0.014439910650253296, -0.0026424452662467957, 0.02257770672440529, 0.0194208025932312, 0.008605485782027245, 0.0012349924072623253, -0.02001710794866085, -0.011920234188437462, -0.0031802880112081766, 0.010037784464657307, 0.03046996518969536, -0.01145254448056221, 0.042302507907152176, -0.016369130462408066, 0.012101463973522186, -0.041296977549791336, -0.038397300988435745, 0.027617059648036957, -0.01106085442006588, -0.0002711137058213353, 0.0002961423888336867, -0.015153137035667896, 0.005261505953967571, 0.021654020994901657, -0.005764272063970566, -0.028716130182147026, 0.0010932240402325988, -0.011616235598921776, -0.01901157572865486, 0.03411794453859329, 0.02974504791200161, -0.0060448856092989445, -0.02226201631128788, 0.0039870524778962135, 0.01501283049583435, 0.02764044515788555, -0.03397763520479202, 0.03984713926911354, 0.00945317279547453, 0.026097070425748825, 0.004881508182734251, 0.027780750766396523, -0.03418809548020363, 0.0182281956076622]', 642, NOW()) ON CONFLICT (id) DO NOTHING;
 INSERT INTO embeddings (id, scope, combined, embeddings, n_tokens, created_at) VALUES (3, 'lelang', 'Stock No: BA00001323K14; ID Lelang: BEKASI-CAR-2311150404; Cabang: Caready Bekasi; Tanggal: 15/11/23; Bulan: November; Lot: 0    226
 1    227
 2    120
@@ -60,7 +61,7 @@ Name: odometer, dtype: int64; Grade: F; No Mesin: 2,7292E+13; No Rangka: MHL2030
 Name: stnk_exp_date, dtype: object; Faktur: Tidak Ada; Kwitansi Blank: Tidak Ada; FC KTP: Tidak Ada; Form A: Tidak Ada; Status Keur: Tidak Ada; Note 1: B 1207 KDZ
 KM 289895
 
-Unit derek, mesin rembes, radiation remebes tidak berfungsi normal, metik jeduk delay, body baret penyok repaint, air suspension tudak berfungsi, bumper depan belakang baret penyok renggang, sebagian komponen kelistrikan tidak berfungsi, interior kotor jok kotor, ban cadangan TA, dongkrak tolkit TA,; Note 2: ADA BIAYA TAMBAHAN PPN SEBESAR 1,1 % DARI HARGA TERBENTUK DIBEBANKAN KE PEMENANG LELANG // UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI', '[-0.03200637921690941, 0.009793268516659737, 0.010232046246528625, -0.0016728390473872423, -0.02869117073714733, -0.009037597104907036, -0.0514344684779644, 0.022243579849600792, -0.020220328122377396, -0.045023441314697266, 0.060941312462091446, -0.04097693786025047, -0.0128586171194911, -0.004710763692855835, 0.05762610584497452, 0.059429969638586044, 0.02664354257285595, -0.017417026683688164, -0.050118137151002884, -0.0019653572235256433, 0.006606038194149733, 0.030714422464370728, -0.012663604691624641, -0.026936059817671776, 0.023352710530161858, -0.021000375971198082, -0.028252393007278442, -0.08224639296531677, 0.006118507590144873, -0.07308082282543182, 0.007124039344489574, -0.03334708511829376, 0.047192953526973724, -0.012919558212161064, -0.03139696270227432, 0.03944121673703194, 0.060405030846595764, -0.0021603696513921022, -5.608505307463929e-05, -0.02447403036057949, -0.02771610952913761, -0.01900150068104267, -0.0015890446957200766, 0.050654418766498566, 0.038685545325279236, 0.0023721405304968357, -0.009451997466385365, -0.03775923699140549, -0.0034157605841755867, 0.05197075009346008, 0.03342021629214287, 0.03658916428685188, 0.0075810994021594524, 0.06879055500030518, -0.008994937874376774, 0.014418714679777622, -0.009714045561850071, 0.03839302808046341, 0.06581661850214005, 0.009159479290246964, -0.010091881267726421, -0.014772173948585987, -0.015235328115522861, 0.05099568888545036, -0.0244130901992321, -0.05045940726995468, -0.037344835698604584, 0.02869117073714733, -0.04214701056480408, -0.006746203172951937, 0.001007055165246129, 0.04899681732058525, 0.023949936032295227, -0.012066380120813847, -0.017246391624212265, 0.018855242058634758, 0.032957062125205994, -0.03388337045907974, -0.0023462404496967793, 0.030202515423297882, -0.02334052324295044, -0.022511720657348633, -0.04234202578663826, -0.011408213526010513, 0.0002793778257910162, -0.04775361344218254, -0.06922933459281921, -0.034565914422273636, -0.03741796687245369, -0.033712733536958694, -0.03644290566444397, -0.017100133001804352, -0.009683574549853802, 0.029714984819293022, 0.026448529213666916, -0.0004406818770803511, -0.03139696270227432, -0.030300021171569824, -0.006539002992212772, 0.03434652462601662, 0.03254266083240509, 0.05348209664225578, 0.029203077778220177, -0.01781924068927765, 0.00479303440079093, 0.015600976534187794, -0.010353929363191128, -0.016112882643938065, -0.033493343740701675, 0.028276769444346428, -0.04526720568537712, 0.014979374594986439, 0.00674010906368494, 0.05635852739214897, 0.006825427059084177, 0.002309675794094801, -0.014443091116845608, 0.02047628164291382, -0.03415151312947273, -0.04309769719839096, 0.0003467941714916378, 0.030226891860365868, 0.005612695124000311, -0.06376899033784866, -0.03656478598713875, 0.01564972847700119, -0.01586911827325821, -0.03222576528787613, -0.0010923730442300439, 0.0006676882621832192, -0.005652306601405144, -0.016539473086595535, -0.014930621720850468, 0.008793831802904606, -0.017989875748753548, -0.04148884490132332, -0.013175511732697487, -0.01421151403337717, -0.03339583799242973, -0.048436153680086136, 0.008220982737839222, 0.0047838930040597916, -0.0002729028055910021, -0.0005903689889237285, -0.07927245646715164, 0.03946559503674507, 0.012316239066421986, 0.005920448340475559, 0.0037539848126471043, 0.009001031517982483, -0.03480967879295349, 0.035492219030857086, -0.035443466156721115, -0.0825389176607132, 0.02881305292248726, -0.017246391624212265, 0.002743882592767477, 0.015540034510195255, -0.03285955637693405, 0.031591977924108505, -0.00019748794147744775, -0.006036236882209778, 0.01683199033141136, 0.013333959504961967, -0.0002628094225656241, 0.01781924068927765, -0.005280564539134502, -0.012358898296952248, -0.06586536765098572, -0.01261485181748867, 0.04709544777870178, -0.013078005984425545, -0.02805737964808941, -0.058893684297800064, -0.028886182233691216, -0.023949936032295227, 0.02983686700463295, -0.02961747720837593, 0.031104445457458496, 0.026619166135787964, -0.010573318228125572, -0.017124509438872337, 0.01060988288372755, 0.016636978834867477, -0.02674104832112789, -0.005192199721932411, -0.02106131799519062, 0.04658354073762894, 0.01998875103890896, 0.033225204795598984, -0.005161729175597429, 0.014881868846714497, -0.0050276583060622215, 0.015893494710326195, -0.022024190053343773, -0.04124508053064346, 0.021378211677074432, 0.03485843166708946, -0.025254080072045326, -0.0024208936374634504, 0.013687418773770332, -0.03644290566444397, 0.052068255841732025, 0.04178136587142944, -0.04885055497288704, 0.01722201518714428, -0.004789987113326788, -0.00042544654570519924, 0.07200825214385986, -0.014723421074450016, 0.08171011507511139, 0.02549784444272518, 0.01048190612345934, 0.029227454215288162, 0.00642930855974555, 0.02886180579662323, -0.01673448458313942, 0.001875468879006803, -0.007172792684286833, 0.027106696739792824, -0.007660322822630405, -0.009500750340521336, -0.007739546708762646, -0.0020918105728924274, -0.001858710078522563, 0.013468029908835888, 0.02366960607469082, 0.023596476763486862, 0.011481343768537045, -0.04436527565121651, -0.030738798901438713, -0.021999813616275787, 0.021353835240006447, -0.00820270087569952, 0.019367149099707603, -0.004314644727855921, 0.01090849470347166, 0.01874554716050625, -0.012834240682423115, -0.01758766360580921, -0.008397713303565979, 0.01036611758172512, 0.016344459727406502, 0.0019851631950587034, 0.0390024408698082, 0.003872820409014821, 0.007855335250496864, -0.007910182699561119, 0.014467467553913593, 0.011213202029466629, -0.01781924068927765, 0.02518095076084137, -0.02349897101521492, -0.01808738149702549, -0.004683339968323708, 0.012364991940557957, -0.04083067923784256, -0.01348021812736988, 0.011127883568406105, -0.05509094521403313, -0.037929873913526535, 0.0041287741623818874, -0.01440652646124363, -0.03381023928523064, -0.024266831576824188, -0.0333714634180069, 0.02744796685874462, 0.026667919009923935, 0.0030714422464370728, -0.03515094891190529, -0.01667354255914688, -0.02019595168530941, 0.046437282115221024, 0.044852808117866516, -0.03049503266811371, -0.025302832946181297, 0.013809301890432835, 0.021366024389863014, 0.004823504947125912, 0.009915151633322239, -0.012358898296952248, -0.013309583067893982, -0.0009430668433196843, 0.0034157605841755867, 0.004220185801386833, 0.014053067192435265, 0.008915713988244534, -0.0002409086300758645, -0.032567039132118225, 0.02722857892513275, 0.03215263783931732, -0.03588224574923515, 0.023974312469363213, -0.02966623194515705, -0.007501875516027212, -0.012785487808287144, 0.023633040487766266, -0.05123945698142052, 0.0037539848126471043, 0.014918433502316475, 0.0637202337384224, 0.004917963873594999, -0.03310332074761391, -0.022499533370137215, -0.007745640818029642, 0.014979374594986439, -0.009360585361719131, -0.01077442429959774, -0.02934933640062809, -0.04024564474821091, 0.02404744178056717, 0.024279018864035606, 0.007727358490228653, -0.06810801476240158, 0.04760735481977463, -0.009714045561850071, 0.015198763459920883, -0.010756141506135464, 0.062403906136751175, -0.05002063140273094, 0.04207388311624527, 0.009872492402791977, -0.004165338817983866, 0.003470607800409198, -0.016161635518074036, -0.02316988632082939, 0.005359788425266743, -0.004610210191458464, 0.023474594578146935, -0.021853554993867874, -0.01629570685327053, -0.03432214632630348, 0.02437652461230755, -0.014309020712971687, 0.02247515693306923, 0.023754924535751343, -0.023035816848278046, 0.029690608382225037, -0.006989968474954367, -0.049898747354745865, -0.04495031386613846, 0.004238468129187822, 0.018379900604486465, 0.017453592270612717, -0.015332833863794804, -0.018818678334355354, 0.019099008291959763, 0.004625445697456598, 0.03239640220999718, -0.029276207089424133, -0.03239640220999718, 0.0015090592205524445, 0.01454059686511755, 0.027106696739792824, 0.0014686856884509325, -0.005326270591467619, 0.02220701426267624, 0.04195199906826019, -0.032469529658555984, 0.033980876207351685, 0.032469529658555984, 0.004631539806723595, 0.008964466862380505, 0.03195762261748314, 0.027496719732880592, 0.030031878501176834, -0.004881399217993021, 0.019562160596251488, 0.020866304636001587, -0.024059630930423737, 0.059868745505809784, 0.01142649631947279, -0.010457529686391354, 0.021000375971198082, -0.010987718589603901, -0.04660791531205177, 0.03558972850441933, 0.04438965395092964, -0.0202568918466568, -0.01151181384921074, 0.05182449147105217, -0.06440278142690659, -0.00022472109412774444, 0.0014359296765178442, 0.011548378504812717, 0.028081756085157394, 0.029056817293167114, 0.05777236446738243, 0.006520720664411783, -0.05821114033460617, -0.03237202391028404, -0.006618226412683725, 0.03032439760863781, -0.02247515693306923, 0.016600413247942924, 0.006959497928619385, 0.03281080350279808, 0.022438591346144676, -0.030446279793977737, 0.0015410534106194973, 0.0072642043232917786, -0.018721170723438263, -0.007763923145830631, -0.02203637920320034, 0.0002186269557569176, 0.026546034961938858, 0.029008064419031143, 0.05387211963534355, 0.019915621727705002, -0.051093198359012604, 0.026351023465394974, -0.04314645007252693, 0.01933058351278305, 0.014443091116845608, 0.012188262306153774, -0.03156759962439537, -0.023462405428290367, 0.08205138146877289, -0.0073860869742929935, 0.010975530371069908, 0.017855804413557053, 0.004607163369655609, -0.008153948001563549, 0.0021298988722264767, -0.06450028717517853, -0.01134727243334055, 0.02198762632906437, -0.012882993556559086, -0.02030564472079277, -0.027106696739792824, -0.009013219736516476, 0.0014625914627686143, -0.04414588585495949, 0.04019688814878464, -0.03846615552902222, 0.012785487808287144, 0.004189715255051851, 0.037271708250045776, -0.046437282115221024, -0.0578211173415184, 0.029276207089424133, 0.01543034054338932, 0.0206347294151783, 0.04248828440904617, 0.003952044062316418, -0.030080631375312805, 0.008799925446510315, 0.04726608097553253, -0.017148885875940323, -0.021573225036263466, -0.028837429359555244, -0.0049789054319262505, 0.009470280259847641, 0.03780798986554146, -0.06220889091491699, 0.01532064564526081, -0.004003844223916531, -0.008434277959167957, 0.036028504371643066, 0.004189715255051851, -0.00778220547363162, 0.022974874824285507, 0.02642415277659893, 0.004722951911389828, -0.015015939250588417, -0.013748359866440296, -0.0667429268360138, -0.007690793834626675, 0.012377181090414524, 0.014638103544712067, -0.013468029908835888, 0.029203077778220177, -0.011304613202810287, -0.0033974782563745975, 0.0006638794438913465, 0.04541346803307533, -0.03968498110771179, -0.030690046027302742, -0.03368835896253586, -0.05075192451477051, -0.01358991302549839, -0.021902307868003845, -0.03990437090396881, -0.02810613438487053, -0.02377930097281933, 0.054505910724401474, 0.03615038841962814, -0.0020384870003908873, -0.06289143115282059, -0.02403525449335575, -0.020049691200256348, 0.017563287168741226, 0.05518845468759537, -0.011487437412142754, -0.01926964335143566, -0.01716107316315174, 0.025570975616574287, 0.0004223994619678706, -0.005262282211333513, -0.015661917626857758, -0.04641290381550789, -0.03675980120897293, 0.011572754941880703, -0.04602288082242012, 0.002521446906030178, 0.01543034054338932, -0.013602101244032383, 0.008135665208101273, -0.030202515423297882, -0.007794393692165613, 0.0195012204349041, 0.01970842108130455, -0.01280986424535513, -0.024937184527516365, 0.003885008627548814, -0.01465029176324606, 0.015259704552590847, 0.026521658524870872, -0.021975437179207802, -0.02637539990246296, 0.03320082649588585, 0.009665291756391525, 0.04780236631631851, -0.02674104832112789, 0.015954434871673584, -0.029056817293167114, 0.0018358570523560047, -0.10335646569728851, 0.002725600264966488, -0.012724545784294605, 0.033054567873477936, 0.010798800736665726, 0.020281268283724785, 0.008866961114108562, -0.01753891073167324, -0.004153150599449873, 0.04314645007252693, 0.036394152790308, -0.027423590421676636, -0.03600412607192993, -0.007343428209424019, 0.007477499078959227, 0.00559136550873518, -0.04273204877972603, -0.013894619420170784, -0.030958186835050583, 0.009244796819984913, 0.0034005253110080957, -0.0101284459233284, 0.032298896461725235, 0.042707670480012894, 0.026814177632331848, -0.014150572940707207, 0.02143915370106697, -0.00939715001732111, 0.010158916935324669, -0.008714607916772366, 0.006276955362409353, -0.009208232164382935, -0.019354961812496185, 0.010469717904925346, 0.023815864697098732, 0.027911121025681496, 0.017575474455952644, 0.007270298432558775, 0.0009179285261780024, -0.00045287012471817434, 0.01759985089302063, 0.015150010585784912, -0.042805179953575134, -0.05879617854952812, -0.005314082372933626, -0.007191075012087822, -0.0024254643358290195, 0.024985937401652336, 0.01986686885356903, -0.04582786560058594, 0.00833677127957344, 0.018050817772746086, -0.03883180394768715, -0.0315188467502594, 0.003626008052378893, 0.011377743445336819, 0.04241515323519707, -0.005134305451065302, 0.055968500673770905, -0.008726796135306358, 0.03125070407986641, 0.0025442996993660927, 0.02035439759492874, 0.0035467843990772963, -0.03351772204041481, -0.0028444358613342047, 0.004561457317322493, 0.010993813164532185, -0.018002063035964966, 0.02717982605099678, 0.003147618845105171, -0.01857491210103035, -0.003242077771574259, -0.00392462033778429, 0.025546599179506302, -0.03705231845378876, 0.014443091116845608, 0.002621999941766262, -0.02165854349732399, 0.007532346062362194, 0.004409104119986296, -0.034955937415361404, 0.018928371369838715, 0.02046409249305725, 0.05621226876974106, 0.03807613253593445, -0.0008135664975270629, -0.045681606978178024, 0.032957062125205994, -0.020013127475976944, -0.0251321978867054, 0.009001031517982483, 0.019793737679719925, -0.013065817765891552, 0.011609320528805256, 0.03437089920043945, -0.009007126092910767, 0.0026052410248667, 0.021390400826931, 0.024279018864035606, -0.03108006902039051, 0.000460106908576563, 0.02318207547068596, -0.014857492409646511, -0.036247894167900085, 0.020049691200256348, -0.01911119557917118, 0.010061411187052727, 0.0027667356189340353, 0.020488468930125237, -0.040318772196769714, 0.00771517027169466, -0.012267486192286015, -0.03217701241374016, -0.03188449516892433, -0.005521283019334078, -0.05036190152168274, -0.0037570318672806025, -0.017465779557824135, -0.008056441321969032, 0.04456028714776039, -0.03483405336737633, -0.022060755640268326, 0.014504032209515572, -0.04855803772807121, 0.011706826277077198, -0.0337858647108078, 0.02664354257285595, -0.027740485966205597, -0.001649986021220684, 0.008568348363041878, 0.005445106420665979, 0.02306019328534603, -0.005256188102066517, 0.042853932827711105, -0.0009141197078861296, 0.0029069005977362394, -0.02859366312623024, 0.02290174551308155, -0.021841365844011307, 0.003909385297447443, 0.05465216934680939, -0.007404369302093983, 0.021317271515727043, 0.0168929323554039, -0.011810426600277424, 0.0017307333182543516, 0.0055151889100670815, 0.021097881719470024, 0.007910182699561119, 0.002047628164291382, -0.039831243455410004, 0.0017246392089873552, 0.013772736303508282, -0.01939152553677559, -0.047192953526973724, 0.002638758858665824, -0.0068010506220161915, 0.03741796687245369, 0.007928464561700821, 0.03783236816525459, 0.028081756085157394, 0.0028444358613342047, 0.029373712837696075, 0.040806304663419724, 0.04921620339155197, -0.003242077771574259, 0.026619166135787964, 0.0638664960861206, 0.007526251953095198, 0.02490062080323696, -0.018172699958086014, -0.07678605616092682, -0.008397713303565979, -0.01532064564526081, 0.005576130002737045, 0.00024167039373423904, -0.03315207362174988, -0.018160510808229446, 0.014772173948585987, 0.005966154392808676, 0.042780801653862, -0.02241421490907669, 0.012980499304831028, -0.009007126092910767, -0.018111757934093475, -0.006929027382284403, -0.0014008884318172932, 0.028276769444346428, 0.012389369308948517, -0.006063660606741905, 0.03315207362174988, 0.02302362769842148, -0.027301708236336708, 0.03342021629214287, 0.04819238930940628, -0.03422464057803154, -0.020074067637324333, 0.009281362406909466, 0.014101820066571236, 0.05002063140273094, 0.01922089047729969, 0.016978248953819275, 0.022438591346144676, 0.008848678320646286, 0.0065877558663487434, -0.009354491718113422, -0.014906245283782482, 0.02713107317686081, -0.020403152331709862, 0.011006001383066177, -0.02187793143093586, -0.01538158766925335, 0.017965499311685562, -0.05777236446738243, -0.00284900632686913, -0.004116585478186607, -0.02393774688243866, 0.009933434426784515, -0.002570199780166149, 0.007501875516027212, -0.024827491492033005, -0.014967186376452446, -0.018221452832221985, 0.008385525085031986, 0.042756423354148865, 0.0019303160952404141, 0.014235890470445156, -0.056504786014556885, -0.0024940231814980507, -0.009287456050515175, 0.025863492861390114, 0.010725671425461769, 0.005920448340475559, -0.00820879451930523, 0.0019333631498739123, -0.0036899964325129986, 0.009208232164382935, -0.01061597652733326, -0.03741796687245369, -0.004518798552453518, -0.02403525449335575, -0.001944027841091156, 0.022840803489089012, -0.01580817624926567, 0.004966717213392258, -0.012578287161886692, 0.03875867649912834, -0.02486405521631241, -0.013602101244032383, 0.007355616427958012, -0.010091881267726421, 0.02610725909471512, 0.009665291756391525, 0.02295049838721752, -0.004942340310662985, 0.018660230562090874, -0.0016179918311536312, 0.03242077678442001, -0.016259143128991127, 0.015576599165797234, -0.008842584677040577, -0.00037745526060462, 0.014345585368573666, -0.005582224112004042, 0.01502812746912241, -0.02355991117656231, 0.012919558212161064, 0.03746671974658966, -0.021670730784535408, -0.020756611600518227, 0.0034005253110080957, 0.027009189128875732, -0.0283255223184824, 0.00229444052092731, -0.03320082649588585, 0.01270016934722662, 0.018550535663962364, -0.028496157377958298, -0.004479186609387398, 0.03846615552902222, 0.032615792006254196, 0.0011060847900807858, -0.0027667356189340353, 0.009226514957845211, 0.019257454201579094, 0.03173823654651642, 0.02637539990246296, -0.004238468129187822, 0.03634539991617203, 0.02247515693306923, -0.01733171008527279, 0.007319051772356033, 0.03334708511829376, -0.03524845466017723, 0.01574723608791828, 0.013858054764568806, -0.009299644269049168, 0.003991656005382538, -0.03622351586818695, 0.017526721581816673, -0.026131635531783104, 0.00890352576971054, 0.019159948453307152, -0.02593662217259407, -0.048338647931814194, -0.04261016473174095, -0.01391899585723877, 0.021036941558122635, 0.004083068110048771, -0.015881305560469627, -0.005009375978261232, -0.027204202488064766, 0.049581851810216904, 0.02090287022292614, 0.044267769902944565, -0.003229889553040266, 0.02387680672109127, 0.013979936949908733, -0.04365835711359978, -0.01134727243334055, -0.022292332723736763, 0.023633040487766266, -0.024291208013892174, -0.010920682922005653, -0.006325708236545324, 0.012919558212161064, 0.0009933434193953872, 0.023303957656025887, -0.02549784444272518, -0.0281548872590065, 0.028033003211021423, -0.02771610952913761, -0.030251268297433853, 0.0034431840758770704, -0.0002574770478531718, -0.03059253841638565, 0.010183293372392654, -0.010232046246528625, 0.02669229544699192, -0.005704106763005257, -0.017087943851947784, -0.004741234239190817, 0.0024010876659303904, -0.020768798887729645, 0.018221452832221985, -0.04987436905503273, 0.010475811548531055, -0.016746671870350838, 0.01911119557917118, 0.006941215600818396, -0.0066365087404847145, -0.04602288082242012, 0.008086912333965302, -0.01873335987329483, 0.019123384729027748, 0.014723421074450016, 0.02107350528240204, 0.0005031467298977077, 0.02436433732509613, 0.004003844223916531, -0.005603553727269173, -0.014601538889110088, -0.013845866546034813, 0.021049128845334053, 0.023267393931746483, 0.013017063960433006, -0.0018617571331560612, -0.005996625404804945, -0.0005957013345323503, 0.007946747355163097, 0.04212263599038124, -0.005506047513335943, 0.002940418431535363, -0.008239265531301498, -0.017672980204224586, 0.035272832959890366, 0.028130510821938515, -0.0033304428216069937, -0.04928933456540108, -0.010225952602922916, 0.03795424848794937, 0.007111851125955582, 0.010719576850533485, 0.000824993010610342, 0.042902685701847076, 0.006953403819352388, 0.015162198804318905, -0.005216576159000397, -0.019403714686632156, 0.024949373677372932, 0.06308645009994507, -0.024327771738171577, -0.04380461573600769, -0.0085317837074399, -0.052653294056653976, 0.04760735481977463, 0.05245828256011009, -0.022999251261353493, -0.005667542107403278, -0.002289869822561741, 0.01758766360580921, 0.0007617663941346109, -0.014967186376452446, 0.0063135200180113316, 0.017612040042877197, 0.02241421490907669, -0.018184887245297432, -0.02842302806675434, -0.016100695356726646, -0.016795426607131958, 0.0264729056507349, -0.01527189277112484, 0.007038721814751625, 0.02966623194515705, 0.06381773948669434, -0.03873429819941521, -0.0015768564771860838, -0.01237108651548624, 0.0055944123305380344, -0.03000750206410885, 0.015600976534187794, -0.015040315687656403, 0.01151181384921074, 0.007891899906098843, 0.0009407814941368997, -0.012297957204282284, -0.0025107820983976126, 0.012602663598954678, 0.05421339347958565, -0.004789987113326788, 0.03390774503350258, 0.008732889778912067, -0.004217138979583979, -0.003930714447051287, -0.00996390450745821, -0.04044065624475479, -0.01619820110499859, -0.032737672328948975, 0.01421151403337717, -0.029690608382225037, 0.04434090107679367, 0.01933058351278305, 0.011773861944675446, -0.008513501845300198, -0.03931933641433716, 0.0005385688273236156, -0.014686856418848038, -0.011353367008268833, 0.04473092406988144, -0.024510595947504044, 0.032079506665468216, 0.0002470027538947761, -0.011853084899485111, 0.0035528785083442926, 0.056992314755916595, -0.011036471463739872, -0.0038971968460828066, 0.03183574229478836, 0.046266645193099976, 0.00534760020673275, 0.022182637825608253, 0.023572100326418877, 0.0040769740007817745, 0.07386086881160736, 0.009945622645318508, 0.0005419967928901315, 0.009220420382916927, -0.010640352964401245, -0.024705607444047928, 0.013541160151362419, 0.007312957663089037, 0.045998502522706985, 0.021049128845334053, -0.008879149332642555, -0.024754362180829048, -0.01808738149702549, -0.030714422464370728, -0.018002063035964966, -0.0032359836623072624, 0.0038271143566817045, -0.008495219051837921, -0.021256329491734505, -0.010670823976397514, -0.002224358031526208, -0.02988561987876892, 0.022877369076013565, -0.009976092725992203, 0.0038149261381477118, -0.002967841923236847, -0.047461096197366714, -0.011109601706266403, -0.028471780940890312, 0.033005814999341965, 0.009049785323441029, 0.0024071817751973867, 0.0023919465020298958, -0.00939715001732111, -0.01494280993938446, 0.03149447217583656, -0.019574349746108055, -0.03385899215936661, -0.009409339167177677, 0.0013140470255166292, -0.004159244708716869, -0.043682731688022614, -0.005088599398732185, 0.001928792567923665, 0.024681231006979942, -0.023108946159482002, 0.02854491025209427, -0.010097975842654705, 0.030616914853453636, 0.0002841388632077724, -0.0010824700584635139, 0.006831521168351173, 0.005411588586866856, 0.04170823469758034, -0.028934935107827187, 0.020171575248241425, 0.023255204781889915, 0.00844646617770195, 0.0015722858952358365, -0.06162385642528534, -0.006721826735883951, -0.020488468930125237, -0.011322895996272564, 0.0027530237566679716, 0.025570975616574287, -0.0070021566934883595, 0.006685262080281973, 0.00747140496969223, 0.01602756604552269, 0.0029084242414683104, -0.011651978828012943, 0.044925935566425323, -0.023633040487766266, 0.016600413247942924, 0.013565536588430405, -0.013224264606833458, 0.005783330649137497, 0.0020902869291603565, 0.011907932348549366, -0.03963622823357582, -0.029641853645443916, -0.013175511732697487, 0.023791488260030746, 0.01348021812736988, 0.03285955637693405, -0.002623523585498333, 0.01432120893150568, -0.03064129129052162, 0.03254266083240509, -0.02713107317686081, 0.006069754716008902, -0.04495031386613846, -0.04214701056480408, -0.01551565807312727, 0.03568723425269127, -0.01101818960160017, 0.033444590866565704, 0.01759985089302063, 0.003952044062316418, 0.02881305292248726, -0.040855057537555695, 0.0004814363783225417, 0.030446279793977737, 0.022487344220280647, -0.006404932122677565, 0.01109741348773241, 0.025424715131521225, 0.014199325814843178, -0.008190512657165527, -0.03205513209104538, 0.02788674458861351, 0.014820926822721958, 0.02452278509736061, 0.0033731015864759684, -0.0281548872590065, 0.010353929363191128, -0.013212076388299465, 0.024547161534428596, -0.010591600090265274, -0.022657979279756546, -0.026814177632331848, 0.03741796687245369, 0.006301331799477339, 0.0004631539632100612, 0.033590853214263916, 0.01857491210103035, 0.008251453749835491, -0.02258484996855259, 0.0030958186835050583, -0.06503657251596451, 0.00333653693087399, -0.01926964335143566, -0.002884047571569681, 0.0013902237406000495, 0.007111851125955582, -0.012639228254556656, 0.0041988566517829895, 0.02241421490907669, 0.013455841690301895, 0.005329317878931761, -0.0013422324554994702, -0.017685169354081154, -1.7996731912717223e-05, 0.006685262080281973, -0.046534787863492966, -0.017721733078360558, 0.052604541182518005, 0.0003092771512456238, -0.034297771751880646, -0.016649166122078896, 0.026448529213666916, -0.014174949377775192, -0.005768095143139362, 0.01807519420981407, 0.02659478969871998, -0.0017124508740380406, 0.0867316797375679, 0.004707716405391693, 0.012102944776415825, 0.0232795812189579, -0.042537037283182144, -0.022816427052021027, 0.008184418082237244, -0.03266454488039017, -0.023157699033617973, 0.014479655772447586, 0.029739361256361008, 0.010262517258524895, 0.037661731243133545, 0.017612040042877197, 0.023803677409887314, -0.012505157850682735, -0.03980686515569687, -0.03237202391028404, 0.02095162309706211, -0.021999813616275787, 0.011530096642673016, 0.029909996315836906, -0.0054725296795368195, -0.030470656231045723, 0.0028444358613342047, 0.011524002067744732, -0.007276392541825771, -0.013285206630825996, 0.008568348363041878, 0.04358522593975067, 0.045023441314697266, 0.028837429359555244, 0.011688543483614922, -0.0021253281738609076, 0.0008227077196352184, -0.0028916653245687485, -0.020159386098384857, -0.0037570318672806025, -0.012687981128692627, -0.041659481823444366, -0.011079130694270134, 0.0016956920735538006, -0.01574723608791828, 0.028228016570210457, 0.006276955362409353, 0.003321301657706499, -0.020695669576525688, -0.014613727107644081, 0.02854491025209427, 0.01543034054338932, 0.008781643584370613, 0.014174949377775192, -0.04899681732058525, -0.00011607415945036337, 0.014479655772447586, -0.027033565565943718, -0.023243017494678497, 0.006380555685609579, -0.007806582376360893, 0.01770954579114914, -0.03510219603776932, -0.01171901449561119, -0.0018708982970565557, 0.03112882189452648, 0.008635384030640125, -0.020220328122377396, -0.03990437090396881, 0.015393775887787342, 0.006996062584221363, -0.006231249310076237, -0.00013149992446415126, 0.016636978834867477, -0.004430433269590139, -0.02686293050646782, -0.02095162309706211, 0.0038453969173133373, -0.009244796819984913, -0.034687794744968414, -0.007770017255097628, 0.017209826037287712, 0.001477826852351427, 0.03059253841638565, 0.044487159699201584, 0.021024752408266068, -0.004643728025257587, -0.041830118745565414, 0.01156056672334671, 0.004028220660984516, -0.0025382055900990963, -0.036467280238866806, -0.0157594233751297, -0.013358335942029953, -0.03178698942065239, -0.009561692364513874, -0.0008661284227855504, 0.015637541189789772, -0.02392555959522724, -0.005350647494196892, 0.06011250987648964, -0.04314645007252693, 0.05036190152168274, -0.02540033869445324, -0.015405964106321335, -0.011377743445336819, 0.008629289455711842, 0.02788674458861351, -0.005146493669599295, 0.025595352053642273, -0.0026494236662983894, -0.0049789054319262505, -0.024705607444047928, -0.010500187985599041, 0.026984812691807747, -0.02615601196885109, 0.015393775887787342, -0.054603416472673416, 0.024729983881115913, 0.0025762938894331455, 0.012297957204282284, 0.02756984904408455, 0.007258110214024782, 0.0032603603322058916, 0.015418152324855328, 0.013382712379097939, -0.0018861336866393685, -0.05640728026628494, 0.007666416931897402, 0.027813615277409554, -0.04516969993710518, -0.01456497423350811, -0.004552315920591354, -0.011688543483614922, 0.023645229637622833, 0.0025854352861642838, 0.023462405428290367, 0.027204202488064766, -0.020988188683986664, -0.0063866497948765755, -0.0050581288523972034, 0.008726796135306358, 0.00906806718558073, -0.026180388405919075, 0.00844646617770195, 0.026789801195263863, -0.008994937874376774, 0.003979467786848545, -0.015686294063925743, -0.03178698942065239, -0.02041533961892128, 0.008543971925973892, -0.0041775270365178585, -0.01077442429959774, -0.003229889553040266, -0.024717796593904495, -0.04473092406988144, 0.0322745181620121, -0.005947872065007687, 0.010335646569728851, 0.025570975616574287, -0.022548286244273186, -0.04146447032690048, -0.013114570640027523, -0.027082320302724838, -0.009202138520777225, -0.0035681137815117836, -0.0020780987106263638, 0.026936059817671776, 0.00852569006383419, 0.03383461758494377, 0.02686293050646782, 0.01310238242149353, 0.00812347698956728, -0.006685262080281973, 0.018111757934093475, 0.013017063960433006, 0.0034523254726082087, 0.0029983127024024725, 0.033493343740701675, -0.042975813150405884, -0.010195481590926647, 0.03244515508413315, -0.009519033133983612, -0.019732797518372536, -0.03437089920043945, -0.009610445238649845, 0.03654041141271591, 0.021890118718147278, 0.0044913748279213905, 0.01813613437116146, -0.01348021812736988, 0.008355054073035717, -0.007057004142552614, -0.013955560512840748, 0.04575473815202713, -0.01841646432876587, -0.01954997330904007, 0.032518286257982254, 0.004844834562391043, -0.019257454201579094, 0.03205513209104538, 0.026351023465394974, 0.03493155911564827, -0.011700731702148914, -0.00844646617770195, 0.021024752408266068, -0.009256985038518906, -0.03139696270227432, -0.0264729056507349, -0.0011175113031640649, 0.01085364818572998, 0.015832552686333656, 0.0049271052703261375, -0.04229327291250229, 0.0033030190970748663, 0.010524564422667027, 0.00833677127957344, 0.009451997466385365, 0.03315207362174988, -0.00017663458129391074, 0.019781550392508507, 0.007331239990890026, 0.05231202393770218, -0.018428653478622437, 0.0062647671438753605, -0.018501782789826393, 0.0264729056507349, 0.01997656188905239, 0.005643165670335293, 0.02253609709441662, -0.006831521168351173, 0.008489124476909637, 0.017746109515428543, -0.005953966174274683, 0.006819332949817181, 0.013614289462566376, -0.038904935121536255, -0.0023569052573293447, 0.015442528761923313, 0.02717982605099678, -0.016161635518074036, -0.017234202474355698, 0.018550535663962364, -0.014918433502316475, -0.023706169798970222, 0.014491843990981579, 0.0257172342389822, 0.009299644269049168, -0.009183855727314949, -0.03583349287509918, -0.004223233088850975, -0.00844646617770195, 0.011889650486409664, -0.006764485500752926, -0.009854210540652275, -0.03444403037428856, -0.013455841690301895, 0.028252393007278442, -0.014808738604187965, -0.006002719514071941, -0.016161635518074036, 0.0001967261778190732, -0.025546599179506302, 0.021573225036263466, -0.021853554993867874, 0.02522970363497734, -0.006011860445141792, 0.00934230349957943, 0.011158354580402374, 0.04339021444320679, -0.005957013461738825, -0.01318769995123148, 0.024547161534428596, 0.012389369308948517, -0.005679730325937271, -0.0055151889100670815, -0.00012502491881605238, -0.016125071793794632, -0.018721170723438263, -0.009622633457183838, -0.0005469482857733965, 0.002861194545403123, 0.05367710813879967, 0.005219623446464539, 0.00865976046770811, -0.03498031198978424, 0.011816520243883133, -0.016344459727406502, 0.030446279793977737, 0.01261485181748867, 0.030397526919841766, -0.006904650945216417, -0.019147761166095734, -0.0015585740329697728, 0.01199325080960989, -0.01786799356341362, 0.028715547174215317, -0.024071818217635155, -0.012072473764419556, -0.00259152939543128, -0.01755109801888466, 0.007252016104757786, -0.0461447611451149, 0.0017139744013547897, -0.018331147730350494, 0.014796550385653973, -0.01543034054338932, 0.036296647042036057, 0.03476092591881752, 0.054505910724401474, -0.0008013782789930701, -0.019574349746108055, 0.03290830925107002, -0.012346710078418255, -0.01329739484935999, 0.014126196503639221, 0.003266454441472888, 0.004311597906053066, -0.017197638750076294, 0.0017490156460553408, 0.04997187480330467, -0.014735609292984009, 0.025107821449637413, 0.017465779557824135, -0.015491281636059284, 0.015089069493114948, -0.040367525070905685, -0.02744796685874462, 0.00787971168756485, 0.02035439759492874, 0.0020445811096578836, 0.0025260173715651035, -9.379249240737408e-05, 0.007837052457034588, -0.07035065442323685, -0.008318489417433739, 0.006337896455079317, -0.017624227330088615, -0.013638665899634361, 0.04148884490132332, -0.018367711454629898, 0.02722857892513275, 0.0038484439719468355, -0.0072276396676898, -0.013004875741899014, 0.00010655207734089345, 0.022353272885084152, -0.001927269040606916, 0.013175511732697487, 0.01884305477142334, 0.018331147730350494, 0.0003586015373002738, -0.014235890470445156, -0.026009751483798027, -0.009250891394913197, 0.00996390450745821, 0.04029439762234688, -0.006965592037886381, 0.059868745505809784, -0.019781550392508507, 0.011779955588281155, -0.048533663153648376, -0.036686670035123825, 0.027984250336885452, -0.009982187300920486, 0.001701786182820797, -0.010737859643995762, -0.0075079696252942085, -0.006539002992212772, 0.018282392993569374, 0.0025382055900990963, -0.027642980217933655, 0.012492968700826168, -0.019354961812496185, -0.01367523055523634, 0.030787551775574684, 0.027691733092069626, -0.010219858027994633, -0.03217701241374016, -0.006813238840550184, 0.013797113671898842, 0.023377086967229843, -0.03634539991617203, 0.02810613438487053, 0.002206075470894575, 0.023267393931746483, 0.0010040081106126308, 0.023645229637622833, -0.037612978368997574, 0.023364899680018425]', 800, NOW());
+Unit derek, mesin rembes, radiation remebes tidak berfungsi normal, metik jeduk delay, body baret penyok repaint, air suspension tudak berfungsi, bumper depan belakang baret penyok renggang, sebagian komponen kelistrikan tidak berfungsi, interior kotor jok kotor, ban cadangan TA, dongkrak tolkit TA,; Note 2: ADA BIAYA TAMBAHAN PPN SEBESAR 1,1 % DARI HARGA TERBENTUK DIBEBANKAN KE PEMENANG LELANG // UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI', '[-0.03200637921690941, 0.009793268516659737, 0.010232046246528625, -0.0016728390473872423, -0.02869117073714733, -0.009037597104907036, -0.0514344684779644, 0.022243579849600792, -0.020220328122377396, -0.045023441314697266, 0.060941312462091446, -0.04097693786025047, -0.0128586171194911, -0.004710763692855835, 0.05762610584497452, 0.059429969638586044, 0.02664354257285595, -0.017417026683688164, -0.050118137151002884, -0.0019653572235256433, 0.006606038194149733, 0.030714422464370728, -0.012663604691624641, -0.026936059817671776, 0.023352710530161858, -0.021000375971198082, -0.028252393007278442, -0.08224639296531677, 0.006118507590144873, -0.07308082282543182, 0.007124039344489574, -0.03334708511829376, 0.047192953526973724, -0.012919558212161064, -0.03139696270227432, 0.03944121673703194, 0.060405030846595764, -0.0021603696513921022, -5.608505307463929e-05, -0.02447403036057949, -0.02771610952913761, -0.01900150068104267, -0.0015890446957200766, 0.050654418766498566, 0.038685545325279236, 0.0023721405304968357, -0.009451997466385365, -0.03775923699140549, -0.0034157605841755867, 0.05197075009346008, 0.03342021629214287, 0.03658916428685188, 0.0075810994021594524, 0.06879055500030518, -0.008994937874376774, 0.014418714679777622, -0.009714045561850071, 0.03839302808046341, 0.06581661850214005, 0.009159479290246964, -0.010091881267726421, -0.014772173948585987, -0.015235328115522861, 0.05099568888545036, -0.0244130901992321, -0.05045940726995468, -0.037344835698604584, 0.02869117073714733, -0.04214701056480408, -0.006746203172951937, 0.001007055165246129, 0.04899681732058525, 0.023949936032295227, -0.012066380120813847, -0.017246391624212265, 0.018855242058634758, 0.032957062125205994, -0.03388337045907974, -0.0023462404496967793, 0.030202515423297882, -0.02334052324295044, -0.022511720657348633, -0.04234202578663826, -0.011408213526010513, 0.0002793778257910162, -0.04775361344218254, -0.06922933459281921, -0.034565914422273636, -0.03741796687245369, -0.033712733536958694, -0.03644290566444397, -0.017100133001804352, -0.009683574549853802, 0.029714984819293022, 0.026448529213666916, -0.0004406818770803511, -0.03139696270227432, -0.030300021171569824, -0.006539002992212772, 0.03434652462601662, 0.03254266083240509, 0.05348209664225578, 0.029203077778220177, -0.01781924068927765, 0.00479303440079093, 0.015600976534187794, -0.010353929363191128, -0.016112882643938065, -0.033493343740701675, 0.028276769444346428, -0.04526720568537712, 0.014979374594986439, 0.00674010906368494, 0.05635852739214897, 0.006825427059084177, 0.002309675794094801, -0.014443091116845608, 0.02047628164291382, -0.03415151312947273, -0.04309769719839096, 0.0003467941714916378, 0.030226891860365868, 0.005612695124000311, -0.06376899033784866, -0.03656478598713875, 0.01564972847700119, -0.01586911827325821, -0.03222576528787613, -0.0010923730442300439, 0.0006676882621832192, -0.005652306601405144, -0.016539473086595535, -0.014930621720850468, 0.008793831802904606, -0.017989875748753548, -0.04148884490132332, -0.013175511732697487, -0.01421151403337717, -0.03339583799242973, -0.048436153680086136, 0.008220982737839222, 0.0047838930040597916, -0.0002729028055910021, -0.0005903689889237285, -0.07927245646715164, 0.03946559503674507, 0.012316239066421986, 0.005920448340475559, 0.0037539848126471043, 0.009001031517982483, -0.03480967879295349, 0.035492219030857086, -0.035443466156721115, -0.0825389176607132, 0.02881305292248726, -0.017246391624212265, 0.002743882592767477, 0.015540034510195255, -0.03285955637693405, 0.031591977924108505, -0.00019748794147744775, -0.006036236882209778, 0.01683199033141136, 0.013333959504961967, -0.0002628094225656241, 0.01781924068927765, -0.005280564539134502, -0.012358898296952248, -0.06586536765098572, -0.01261485181748867, 0.04709544777870178, -0.013078005984425545, -0.02805737964808941, -0.058893684297800064, -0.028886182233691216, -0.023949936032295227, 0.02983686700463295, -0.02961747720837593, 0.031104445457458496, 0.026619166135787964, -0.010573318228125572, -0.017124509438872337, 0.01060988288372755, 0.016636978834867477, -0.02674104832112789, -0.005192199721932411, -0.02106131799519062, 0.04658354073762894, 0.01998875103890896, 0.033225204795598984, -0.005161729175597429, 0.014881868846714497, -0.0050276583060622215, 0.015893494710326195, -0.022024190053343773, -0.04124508053064346, 0.021378211677074432, 0.03485843166708946, -0.025254080072045326, -0.0024208936374634504, 0.013687418773770332, -0.03644290566444397, 0.052068255841732025, 0.04178136587142944, -0.04885055497288704, 0.01722201518714428, -0.004789987113326788, -0.00042544654570519924, 0.07200825214385986, -0.014723421074450016, 0.08171011507511139, 0.02549784444272518, 0.01048190612345934, 0.029227454215288162, 0.00642930855974555, 0.02886180579662323, -0.01673448458313942, 0.001875468879006803, -0.007172792684286833, 0.027106696739792824, -0.007660322822630405, -0.009500750340521336, -0.007739546708762646, -0.0020918105728924274, -0.001858710078522563, 0.013468029908835888, 0.02366960607469082, 0.023596476763486862, 0.011481343768537045, -0.04436527565121651, -0.030738798901438713, -0.021999813616275787, 0.021353835240006447, -0.00820270087569952, 0.019367149099707603, -0.004314644727855921, 0.01090849470347166, 0.01874554716050625, -0.012834240682423115, -0.01758766360580921, -0.008397713303565979, 0.01036611758172512, 0.016344459727406502, 0.0019851631950587034, 0.0390024408698082, 0.003872820409014821, 0.007855335250496864, -0.007910182699561119, 0.014467467553913593, 0.011213202029466629, -0.01781924068927765, 0.02518095076084137, -0.02349897101521492, -0.01808738149702549, -0.004683339968323708, 0.012364991940557957, -0.04083067923784256, -0.01348021812736988, 0.011127883568406105, -0.05509094521403313, -0.037929873913526535, 0.0041287741623818874, -0.01440652646124363, -0.03381023928523064, -0.024266831576824188, -0.0333714634180069, 0.02744796685874462, 0.026667919009923935, 0.0030714422464370728, -0.03515094891190529, -0.01667354255914688, -0.02019595168530941, 0.046437282115221024, 0.044852808117866516, -0.03049503266811371, -0.025302832946181297, 0.013809301890432835, 0.021366024389863014, 0.004823504947125912, 0.009915151633322239, -0.012358898296952248, -0.013309583067893982, -0.0009430668433196843, 0.0034157605841755867, 0.004220185801386833, 0.014053067192435265, 0.008915713988244534, -0.0002409086300758645, -0.032567039132118225, 0.02722857892513275, 0.03215263783931732, -0.03588224574923515, 0.023974312469363213, -0.02966623194515705, -0.007501875516027212, -0.012785487808287144, 0.023633040487766266, -0.05123945698142052, 0.0037539848126471043, 0.014918433502316475, 0.0637202337384224, 0.004917963873594999, -0.03310332074761391, -0.022499533370137215, -0.007745640818029642, 0.014979374594986439, -0.009360585361719131, -0.01077442429959774, -0.02934933640062809, -0.04024564474821091, 0.02404744178056717, 0.024279018864035606, 0.007727358490228653, -0.06810801476240158, 0.04760735481977463, -0.009714045561850071, 0.015198763459920883, -0.010756141506135464, 0.062403906136751175, -0.05002063140273094, 0.04207388311624527, 0.009872492402791977, -0.004165338817983866, 0.003470607800409198, -0.016161635518074036, -0.02316988632082939, 0.005359788425266743, -0.004610210191458464, 0.023474594578146935, -0.021853554993867874, -0.01629570685327053, -0.03432214632630348, 0.02437652461230755, -0.014309020712971687, 0.02247515693306923, 0.023754924535751343, -0.023035816848278046, 0.029690608382225037, -0.006989968474954367, -0.049898747354745865, -0.04495031386613846, 0.004238468129187822, 0.018379900604486465, 0.017453592270612717, -0.015332833863794804, -0.018818678334355354, 0.019099008291959763, 0.004625445697456598, 0.03239640220999718, -0.029276207089424133, -0.03239640220999718, 0.0015090592205524445, 0.01454059686511755, 0.027106696739792824, 0.0014686856884509325, -0.005326270591467619, 0.02220701426267624, 0.04195199906826019, -0.032469529658555984, 0.033980876207351685, 0.032469529658555984, 0.004631539806723595, 0.008964466862380505, 0.03195762261748314, 0.027496719732880592, 0.030031878501176834, -0.004881399217993021, 0.019562160596251488, 0.020866304636001587, -0.024059630930423737, 0.059868745505809784, 0.01142649631947279, -0.010457529686391354, 0.021000375971198082, -0.010987718589603901, -0.04660791531205177, 0.03558972850441933, 0.04438965395092964, -0.0202568918466568, -0.01151181384921074, 0.05182449147105217, -0.06440278142690659, -0.00022472109412774444, 0.0014359296765178442, 0.011548378504812717, 0.028081756085157394, 0.029056817293167114, 0.05777236446738243, 0.006520720664411783, -0.05821114033460617, -0.03237202391028404, -0.006618226412683725, 0.03032439760863781, -0.02247515693306923, 0.016600413247942924, 0.006959497928619385, 0.03281080350279808, 0.022438591346144676, -0.030446279793977737, 0.0015410534106194973, 0.0072642043232917786, -0.018721170723438263, -0.007763923145830631, -0.02203637920320034, 0.0002186269557569176, 0.026546034961938858, 0.029008064419031143, 0.05387211963534355, 0.019915621727705002, -0.051093198359012604, 0.026351023465394974, -0.04314645007252693, 0.01933058351278305, 0.014443091116845608, 0.012188262306153774, -0.03156759962439537, -0.023462405428290367, 0.08205138146877289, -0.0073860869742929935, 0.010975530371069908, 0.017855804413557053, 0.004607163369655609, -0.008153948001563549, 0.0021298988722264767, -0.06450028717517853, -0.01134727243334055, 0.02198762632906437, -0.012882993556559086, -0.02030564472079277, -0.027106696739792824, -0.009013219736516476, 0.0014625914627686143, -0.04414588585495949, 0.04019688814878464, -0.03846615552902222, 0.012785487808287144, 0.004189715255051851, 0.037271708250045776, -0.046437282115221024, -0.0578211173415184, 0.029276207089424133, 0.01543034054338932, 0.0206347294151783, 0.04248828440904617, 0.003952044062316418, -0.030080631375312805, 0.008799925446510315, 0.04726608097553253, -0.017148885875940323, -0.021573225036263466, -0.028837429359555244, -0.0049789054319262505, 0.009470280259847641, 0.03780798986554146, -0.06220889091491699, 0.01532064564526081, -0.004003844223916531, -0.008434277959167957, 0.036028504371643066, 0.004189715255051851, -0.00778220547363162, 0.022974874824285507, 0.02642415277659893, 0.004722951911389828, -0.015015939250588417, -0.013748359866440296, -0.0667429268360138, -0.007690793834626675, 0.012377181090414524, 0.014638103544712067, -0.013468029908835888, 0.029203077778220177, -0.011304613202810287, -0.0033974782563745975, 0.0006638794438913465, 0.04541346803307533, -0.03968498110771179, -0.030690046027302742, -0.03368835896253586, -0.05075192451477051, -0.01358991302549839, -0.021902307868003845, -0.03990437090396881, -0.02810613438487053, -0.02377930097281933, 0.054505910724401474, 0.03615038841962814, -0.0020384870003908873, -0.06289143115282059, -0.02403525449335575, -0.020049691200256348, 0.017563287168741226, 0.05518845468759537, -0.011487437412142754, -0.01926964335143566, -0.01716107316315174, 0.025570975616574287, 0.0004223994619678706, -0.005262282211333513, -0.015661917626857758, -0.04641290381550789, -0.03675980120897293, 0.011572754941880703, -0.04602288082242012, 0.002521446906030178, 0.01543034054338932, -0.013602101244032383, 0.008135665208101273, -0.030202515423297882, -0.007794393692165613, 0.0195012204349041, 0.01970842108130455, -0.01280986424535513, -0.024937184527516365, 0.003885008627548814, -0.01465029176324606, 0.015259704552590847, 0.026521658524870872, -0.021975437179207802, -0.02637539990246296, 0.03320082649588585, 0.009665291756391525, 0.04780236631631851, -0.02674104832112789, 0.015954434871673584, -0.029056817293167114, 0.0018358570523560047, -0.10335646569728851, 0.002725600264966488, -0.012724545784294605, 0.033054567873477936, 0.010798800736665726, 0.020281268283724785, 0.008866961114108562, -0.01753891073167324, -0.004153150599449873, 0.04314645007252693, 0.036394152790308, -0.027423590421676636, -0.03600412607192993, -0.007343428209424019, 0.007477499078959227, 0.00559136550873518, -0.04273204877972603, -0.013894619420170784, -0.030958186835050583, 0.009244796819984913, 0.0034005253110080957, -0.0101284459233284, 0.032298896461725235, 0.042707670480012894, 0.026814177632331848, -0.014150572940707207, 0.02143915370106697, -0.00939715001732111, 0.010158916935324669, -0.008714607916772366, 0.006276955362409353, -0.009208232164382935, -0.019354961812496185, 0.010469717904925346, 0.023815864697098732, 0.027911121025681496, 0.017575474455952644, 0.007270298432558775, 0.0009179285261780024, -0.00045287012471817434, 0.01759985089302063, 0.015150010585784912, -0.042805179953575134, -0.05879617854952812, -0.005314082372933626, -0.007191075012087822, -0.0024254643358290195, 0.024985937401652336, 0.01986686885356903, -0.04582786560058594, 0.00833677127957344, 0.018050817772746086, -0.03883180394768715, -0.0315188467502594, 0.003626008052378893, 0.011377743445336819, 0.04241515323519707, -0.005134305451065302, 0.055968500673770905, -0.008726796135306358, 0.03125070407986641, 0.0025442996993660927, 0.02035439759492874, 0.0035467843990772963, -0.03351772204041481, -0.0028444358613342047, 0.004561457317322493, 0.010993813164532185, -0.018002063035964966, 0.02717982605099678, 0.003147618845105171, -0.01857491210103035, -0.003242077771574259, -0.00392462033778429, 0.025546599179506302, -0.03705231845378876, 0.014443091116845608, 0.002621999941766262, -0.02165854349732399, 0.007532346062362194, 0.004409104119986296, -0.034955937415361404, 0.018928371369838715, 0.02046409249305725, 0.05621226876974106, 0.03807613253593445, -0.0008135664975270629, -0.045681606978178024, 0.032957062125205994, -0.020013127475976944, -0.0251321978867054, 0.009001031517982483, 0.019793737679719925, -0.013065817765891552, 0.011609320528805256, 0.03437089920043945, -0.009007126092910767, 0.0026052410248667, 0.021390400826931, 0.024279018864035606, -0.03108006902039051, 0.000460106908576563, 0.02318207547068596, -0.014857492409646511, -0.036247894167900085, 0.020049691200256348, -0.01911119557917118, 0.010061411187052727, 0.0027667356189340353, 0.020488468930125237, -0.040318772196769714, 0.00771517027169466, -0.012267486192286015, -0.03217701241374016, -0.03188449516892433, -0.005521283019334078, -0.05036190152168274, -0.0037570318672806025, -0.017465779557824135, -0.008056441321969032, 0.04456028714776039, -0.03483405336737633, -0.022060755640268326, 0.014504032209515572, -0.04855803772807121, 0.011706826277077198, -0.0337858647108078, 0.02664354257285595, -0.027740485966205597, -0.001649986021220684, 0.008568348363041878, 0.005445106420665979, 0.02306019328534603, -0.005256188102066517, 0.042853932827711105, -0.0009141197078861296, 0.0029069005977362394, -0.02859366312623024, 0.02290174551308155, -0.021841365844011307, 0.003909385297447443, 0.05465216934680939, -0.007404369302093983, 0.021317271515727043, 0.0168929323554039, -0.011810426600277424, 0.0017307333182543516, 0.0055151889100670815, 0.021097881719470024, 0.007910182699561119, 0.002047628164291382, -0.039831243455410004, 0.0017246392089873552, 0.013772736303508282, -0.01939152553677559, -0.047192953526973724, 0.002638758858665824, -0.0068010506220161915, 0.03741796687245369, 0.007928464561700821, 0.03783236816525459, 0.028081756085157394, 0.0028444358613342047, 0.029373712837696075, 0.040806304663419724, 0.04921620339155197, -0.003242077771574259, 0.026619166135787964, 0.0638664960861206, 0.007526251953095198, 0.02490062080323696, -0.018172699958086014, -0.07678605616092682, -0.008397713303565979, -0.01532064564526081, 0.005576130002737045, 0.00024167039373423904, -0.03315207362174988, -0.018160510808229446, 0.014772173948585987, 0.005966154392808676, 0.042780801653862, -0.02241421490907669, 0.012980499304831028, -0.009007126092910767, -0.018111757934093475, -0.006929027382284403, -0.0014008884318172932, 0.028276769444346428, 0.012389369308948517, -0.006063660606741905, 0.03315207362174988, 0.02302362769842148, -0.027301708236336708, 0.03342021629214287, 0.04819238930940628, -0.03422464057803154, -0.020074067637324333, 0.009281362406909466, 0.014101820066571236, 0.05002063140273094, 0.01922089047729969, 0.016978248953819275, 0.022438591346144676, 0.008848678320646286, 0.0065877558663487434, -0.009354491718113422, -0.014906245283782482, 0.02713107317686081, -0.020403152331709862, 0.011006001383066177, -0.02187793143093586, -0.01538158766925335, 0.017965499311685562, -0.05777236446738243, -0.00284900632686913, -0.004116585478186607, -0.02393774688243866, 0.009933434426784515, -0.002570199780166149, 0.007501875516027212, -0.024827491492033005, -0.014967186376452446, -0.018221452832221985, 0.008385525085031986, 0.042756423354148865, 0.0019303160952404141, 0.014235890470445156, -0.056504786014556885, -0.0024940231814980507, -0.009287456050515175, 0.025863492861390114, 0.010725671425461769, 0.005920448340475559, -0.00820879451930523, 0.0019333631498739123, -0.0036899964325129986, 0.009208232164382935, -0.01061597652733326, -0.03741796687245369, -0.004518798552453518, -0.02403525449335575, -0.001944027841091156, 0.022840803489089012, -0.01580817624926567, 0.004966717213392258, -0.012578287161886692, 0.03875867649912834, -0.02486405521631241, -0.013602101244032383, 0.007355616427958012, -0.010091881267726421, 0.02610725909471512, 0.009665291756391525, 0.02295049838721752, -0.004942340310662985, 0.018660230562090874, -0.0016179918311536312, 0.03242077678442001, -0.016259143128991127, 0.015576599165797234, -0.008842584677040577, -0.00037745526060462, 0.014345585368573666, -0.005582224112004042, 0.01502812746912241, -0.02355991117656231, 0.012919558212161064, 0.03746671974658966, -0.021670730784535408, -0.020756611600518227, 0.0034005253110080957, 0.027009189128875732, -0.0283255223184824, 0.00229444052092731, -0.03320082649588585, 0.01270016934722662, 0.018550535663962364, -0.028496157377958298, -0.004479186609387398, 0.03846615552902222, 0.032615792006254196, 0.0011060847900807858, -0.0027667356189340353, 0.009226514957845211, 0.019257454201579094, 0.03173823654651642, 0.02637539990246296, -0.004238468129187822, 0.03634539991617203, 0.02247515693306923, -0.01733171008527279, 0.007319051772356033, 0.03334708511829376, -0.03524845466017723, 0.01574723608791828, 0.013858054764568806, -0.009299644269049168, 0.003991656005382538, -0.03622351586818695, 0.017526721581816673, -0.026131635531783104, 0.00890352576971054, 0.019159948453307152, -0.02593662217259407, -0.048338647931814194, -0.04261016473174095, -0.01391899585723877, 0.021036941558122635, 0.004083068110048771, -0.015881305560469627, -0.005009375978261232, -0.027204202488064766, 0.049581851810216904, 0.02090287022292614, 0.044267769902944565, -0.003229889553040266, 0.02387680672109127, 0.013979936949908733, -0.04365835711359978, -0.01134727243334055, -0.022292332723736763, 0.023633040487766266, -0.024291208013892174, -0.010920682922005653, -0.006325708236545324, 0.012919558212161064, 0.0009933434193953872, 0.023303957656025887, -0.02549784444272518, -0.0281548872590065, 0.028033003211021423, -0.02771610952913761, -0.030251268297433853, 0.0034431840758770704, -0.0002574770478531718, -0.03059253841638565, 0.010183293372392654, -0.010232046246528625, 0.02669229544699192, -0.005704106763005257, -0.017087943851947784, -0.004741234239190817, 0.0024010876659303904, -0.020768798887729645, 0.018221452832221985, -0.04987436905503273, 0.010475811548531055, -0.016746671870350838, 0.01911119557917118, 0.006941215600818396, -0.0066365087404847145, -0.04602288082242012, 0.008086912333965302, -0.01873335987329483, 0.019123384729027748, 0.014723421074450016, 0.02107350528240204, 0.0005031467298977077, 0.02436433732509613, 0.004003844223916531, -0.005603553727269173, -0.014601538889110088, -0.013845866546034813, 0.021049128845334053, 0.023267393931746483, 0.013017063960433006, -0.0018617571331560612, -0.005996625404804945, -0.0005957013345323503, 0.007946747355163097, 0.04212263599038124, -0.005506047513335943, 0.002940418431535363, -0.008239265531301498, -0.017672980204224586, 0.035272832959890366, 0.028130510821938515, -0.0033304428216069937, -0.04928933456540108, -0.010225952602922916, 0.03795424848794937, 0.007111851125955582, 0.010719576850533485, 0.000824993010610342, 0.042902685701847076, 0.006953403819352388, 0.015162198804318905, -0.005216576159000397, -0.019403714686632156, 0.024949373677372932, 0.06308645009994507, -0.024327771738171577, -0.04380461573600769, -0.0085317837074399, -0.052653294056653976, 0.04760735481977463, 0.05245828256011009, -0.022999251261353493, -0.005667542107403278, -0.002289869822561741, 0.01758766360580921, 0.0007617663941346109, -0.014967186376452446, 0.0063135200180113316, 0.017612040042877197, 0.02241421490907669, -0.018184887245297432, -0.02842302806675434, -0.016100695356726646, -0.016795426607131958, 0.0264729056507349, -0.01527189277112484, 0.007038721814751625, 0.02966623194515705, 0.06381773948669434, -0.03873429819941521, -0.0015768564771860838, -0.01237108651548624, 0.0055944123305380344, -0.03000750206410885, 0.015600976534187794, -0.015040315687656403, 0.01151181384921074, 0.007891899906098843, 0.0009407814941368997, -0.012297957204282284, -0.0025107820983976126, 0.012602663598954678, 0.05421339347958565, -0.004789987113326788, 0.03390774503350258, 0.008732889778912067, -0.004217138979583979, -0.003930714447051287, -0.00996390450745821, -0.04044065624475479, -0.01619820110499859, -0.032737672328948975, 0.01421151403337717, -0.029690608382225037, 0.04434090107679367, 0.01933058351278305, 0.011773861944675446, -0.008513501845300198, -0.03931933641433716, 0.0005385688273236156, -0.014686856418848038, -0.011353367008268833, 0.04473092406988144, -0.024510595947504044, 0.032079506665468216, 0.0002470027538947761, -0.011853084899485111, 0.0035528785083442926, 0.056992314755916595, -0.011036471463739872, -0.0038971968460828066, 0.03183574229478836, 0.046266645193099976, 0.00534760020673275, 0.022182637825608253, 0.023572100326418877, 0.0040769740007817745, 0.07386086881160736, 0.009945622645318508, 0.0005419967928901315, 0.009220420382916927, -0.010640352964401245, -0.024705607444047928, 0.013541160151362419, 0.007312957663089037, 0.045998502522706985, 0.021049128845334053, -0.008879149332642555, -0.024754362180829048, -0.01808738149702549, -0.030714422464370728, -0.018002063035964966, -0.0032359836623072624, 0.0038271143566817045, -0.008495219051837921, -0.021256329491734505, -0.010670823976397514, -0.002224358031526208, -0.02988561987876892, 0.022877369076013565, -0.009976092725992203, 0.0038149261381477118, -0.002967841923236847, -0.047461096197366714, -0.011109601706266403, -0.028471780940890312, 0.033005814999341965, 0.009049785323441029, 0.0024071817751973867, 0.0023919465020298958, -0.00939715001732111, -0.01494280993938446, 0.03149447217583656, -0.019574349746108055, -0.03385899215936661, -0.009409339167177677, 0.0013140470255166292, -0.004159244708716869, -0.043682731688022614, -0.005088599398732185, 0.001928792567923665, 0.024681231006979942, -0.023108946159482002, 0.02854491025209427, -0.010097975842654705, 0.030616914853453636, 0.0002841388632077724, -0.0010824700584635139, 0.006831521168351173, 0.005411588586866856, 0.04170823469758034, -0.028934935107827187, 0.020171575248241425, 0.023255204781889915, 0.00844646617770195, 0.0015722858952358365, -0.06162385642528534, -0.006721826735883951, -0.020488468930125237, -0.011322895996272564, 0.0027530237566679716, 0.025570975616574287, -0.0070021566934883595, 0.006685262080281973, 0.00747140496969223, 0.01602756604552269, 0.0029084242414683104, -0.011651978828012943, 0.044925935566425323, -0.023633040487766266, 0.016600413247942924, 0.013565536588430405, -0.013224264606833458, 0.005783330649137497, 0.0020902869291603565, 0.011907932348549366, -0.03963622823357582, -0.029641853645443916, -0.013175511732697487, 0.023791488260030746, 0.01348021812736988, 0.03285955637693405, -0.002623523585498333, 0.01432120893150568, -0.03064129129052162, 0.03254266083240509, -0.02713107317686081, 0.006069754716008902, -0.04495031386613846, -0.04214701056480408, -0.01551565807312727, 0.03568723425269127, -0.01101818960160017, 0.033444590866565704, 0.01759985089302063, 0.003952044062316418, 0.02881305292248726, -0.040855057537555695, 0.0004814363783225417, 0.030446279793977737, 0.022487344220280647, -0.006404932122677565, 0.01109741348773241, 0.025424715131521225, 0.014199325814843178, -0.008190512657165527, -0.03205513209104538, 0.02788674458861351, 0.014820926822721958, 0.02452278509736061, 0.0033731015864759684, -0.0281548872590065, 0.010353929363191128, -0.013212076388299465, 0.024547161534428596, -0.010591600090265274, -0.022657979279756546, -0.026814177632331848, 0.03741796687245369, 0.006301331799477339, 0.0004631539632100612, 0.033590853214263916, 0.01857491210103035, 0.008251453749835491, -0.02258484996855259, 0.0030958186835050583, -0.06503657251596451, 0.00333653693087399, -0.01926964335143566, -0.002884047571569681, 0.0013902237406000495, 0.007111851125955582, -0.012639228254556656, 0.0041988566517829895, 0.02241421490907669, 0.013455841690301895, 0.005329317878931761, -0.0013422324554994702, -0.017685169354081154, -1.7996731912717223e-05, 0.006685262080281973, -0.046534787863492966, -0.017721733078360558, 0.052604541182518005, 0.0003092771512456238, -0.034297771751880646, -0.016649166122078896, 0.026448529213666916, -0.014174949377775192, -0.005768095143139362, 0.01807519420981407, 0.02659478969871998, -0.0017124508740380406, 0.0867316797375679, 0.004707716405391693, 0.012102944776415825, 0.0232795812189579, -0.042537037283182144, -0.022816427052021027, 0.008184418082237244, -0.03266454488039017, -0.023157699033617973, 0.014479655772447586, 0.029739361256361008, 0.010262517258524895, 0.037661731243133545, 0.017612040042877197, 0.023803677409887314, -0.012505157850682735, -0.03980686515569687, -0.03237202391028404, 0.02095162309706211, -0.021999813616275787, 0.011530096642673016, 0.029909996315836906, -0.0054725296795368195, -0.030470656231045723, 0.0028444358613342047, 0.011524002067744732, -0.007276392541825771, -0.013285206630825996, 0.008568348363041878, 0.04358522593975067, 0.045023441314697266, 0.028837429359555244, 0.011688543483614922, -0.0021253281738609076, 0.0008227077196352184, -0.0028916653245687485, -0.020159386098384857, -0.0037570318672806025, -0.012687981128692627, -0.041659481823444366, -0.011079130694270134, 0.0016956920735538006, -0.01574723608791828, 0.028228016570210457, 0.006276955362409353, 0.003321301657706499, -0.020695669576525688, -0.014613727107644081, 0.02854491025209427, 0.01543034054338932, 0.008781643584370613, 0.014174949377775192, -0.04899681732058525, -0.00011607415945036337, 0.014479655772447586, -0.027033565565943718, -0.023243017494678497, 0.006380555685609579, -0.007806582376360893, 0.01770954579114914, -0.03510219603776932, -0.01171901449561119, -0.0018708982970565557, 0.03112882189452648, 0.008635384030640125, -0.020220328122377396, -0.03990437090396881, 0.015393775887787342, 0.006996062584221363, -0.006231249310076237, -0.00013149992446415126, 0.016636978834867477, -0.004430433269590139, -0.02686293050646782, -0.02095162309706211, 0.0038453969173133373, -0.009244796819984913, -0.034687794744968414, -0.007770017255097628, 0.017209826037287712, 0.001477826852351427, 0.03059253841638565, 0.044487159699201584, 0.021024752408266068, -0.004643728025257587, -0.041830118745565414, 0.01156056672334671, 0.004028220660984516, -0.0025382055900990963, -0.036467280238866806, -0.0157594233751297, -0.013358335942029953, -0.03178698942065239, -0.009561692364513874, -0.0008661284227855504, 0.015637541189789772, -0.02392555959522724, -0.005350647494196892, 0.06011250987648964, -0.04314645007252693, 0.05036190152168274, -0.02540033869445324, -0.015405964106321335, -0.011377743445336819, 0.008629289455711842, 0.02788674458861351, -0.005146493669599295, 0.025595352053642273, -0.0026494236662983894, -0.0049789054319262505, -0.024705607444047928, -0.010500187985599041, 0.026984812691807747, -0.02615601196885109, 0.015393775887787342, -0.054603416472673416, 0.024729983881115913, 0.0025762938894331455, 0.012297957204282284, 0.02756984904408455, 0.007258110214024782, 0.0032603603322058916, 0.015418152324855328, 0.013382712379097939, -0.0018861336866393685, -0.05640728026628494, 0.007666416931897402, 0.027813615277409554, -0.04516969993710518, -0.01456497423350811, -0.004552315920591354, -0.011688543483614922, 0.023645229637622833, 0.0025854352861642838, 0.023462405428290367, 0.027204202488064766, -0.020988188683986664, -0.0063866497948765755, -0.0050581288523972034, 0.008726796135306358, 0.00906806718558073, -0.026180388405919075, 0.00844646617770195, 0.026789801195263863, -0.008994937874376774, 0.003979467786848545, -0.015686294063925743, -0.03178698942065239, -0.02041533961892128, 0.008543971925973892, -0.0041775270365178585, -0.01077442429959774, -0.003229889553040266, -0.024717796593904495, -0.04473092406988144, 0.0322745181620121, -0.005947872065007687, 0.010335646569728851, 0.025570975616574287, -0.022548286244273186, -0.04146447032690048, -0.013114570640027523, -0.027082320302724838, -0.009202138520777225, -0.0035681137815117836, -0.0020780987106263638, 0.026936059817671776, 0.00852569006383419, 0.03383461758494377, 0.02686293050646782, 0.01310238242149353, 0.00812347698956728, -0.006685262080281973, 0.018111757934093475, 0.013017063960433006, 0.0034523254726082087, 0.0029983127024024725, 0.033493343740701675, -0.042975813150405884, -0.010195481590926647, 0.03244515508413315, -0.009519033133983612, -0.019732797518372536, -0.03437089920043945, -0.009610445238649845, 0.03654041141271591, 0.021890118718147278, 0.0044913748279213905, 0.01813613437116146, -0.01348021812736988, 0.008355054073035717, -0.007057004142552614, -0.013955560512840748, 0.04575473815202713, -0.01841646432876587, -0.01954997330904007, 0.032518286257982254, 0.004844834562391043, -0.019257454201579094, 0.03205513209104538, 0.026351023465394974, 0.03493155911564827, -0.011700731702148914, -0.00844646617770195, 0.021024752408266068, -0.009256985038518906, -0.03139696270227432, -0.0264729056507349, -0.0011175113031640649, 0.01085364818572998, 0.015832552686333656, 0.0049271052703261375, -0.04229327291250229, 0.0033030190970748663, 0.010524564422667027, 0.00833677127957344, 0.009451997466385365, 0.03315207362174988, -0.00017663458129391074, 0.019781550392508507, 0.007331239990890026, 0.05231202393770218, -0.018428653478622437, 0.0062647671438753605, -0.018501782789826393, 0.0264729056507349, 0.01997656188905239, 0.005643165670335293, 0.02253609709441662, -0.006831521168351173, 0.008489124476909637, 0.017746109515428543, -0.005953966174274683, 0.006819332949817181, 0.013614289462566376, -0.038904935121536255, -0.0023569052573293447, 0.015442528761923313, 0.02717982605099678, -0.016161635518074036, -0.017234202474355698, 0.018550535663962364, -0.014918433502316475, -0.023706169798970222, 0.014491843990981579, 0.0257172342389822, 0.009299644269049168, -0.009183855727314949, -0.03583349287509918, -0.004223233088850975, -0.00844646617770195, 0.011889650486409664, -0.006764485500752926, -0.009854210540652275, -0.03444403037428856, -0.013455841690301895, 0.028252393007278442, -0.014808738604187965, -0.006002719514071941, -0.016161635518074036, 0.0001967261778190732, -0.025546599179506302, 0.021573225036263466, -0.021853554993867874, 0.02522970363497734, -0.006011860445141792, 0.00934230349957943, 0.011158354580402374, 0.04339021444320679, -0.005957013461738825, -0.01318769995123148, 0.024547161534428596, 0.012389369308948517, -0.005679730325937271, -0.0055151889100670815, -0.00012502491881605238, -0.016125071793794632, -0.018721170723438263, -0.009622633457183838, -0.0005469482857733965, 0.002861194545403123, 0.05367710813879967, 0.005219623446464539, 0.00865976046770811, -0.03498031198978424, 0.011816520243883133, -0.016344459727406502, 0.030446279793977737, 0.01261485181748867, 0.030397526919841766, -0.006904650945216417, -0.019147761166095734, -0.0015585740329697728, 0.01199325080960989, -0.01786799356341362, 0.028715547174215317, -0.024071818217635155, -0.012072473764419556, -0.00259152939543128, -0.01755109801888466, 0.007252016104757786, -0.0461447611451149, 0.0017139744013547897, -0.018331147730350494, 0.014796550385653973, -0.01543034054338932, 0.036296647042036057, 0.03476092591881752, 0.054505910724401474, -0.0008013782789930701, -0.019574349746108055, 0.03290830925107002, -0.012346710078418255, -0.01329739484935999, 0.014126196503639221, 0.003266454441472888, 0.004311597906053066, -0.017197638750076294, 0.0017490156460553408, 0.04997187480330467, -0.014735609292984009, 0.025107821449637413, 0.017465779557824135, -0.015491281636059284, 0.015089069493114948, -0.040367525070905685, -0.02744796685874462, 0.00787971168756485, 0.02035439759492874, 0.0020445811096578836, 0.0025260173715651035, -9.379249240737408e-05, 0.007837052457034588, -0.07035065442323685, -0.008318489417433739, 0.006337896455079317, -0.017624227330088615, -0.013638665899634361, 0.04148884490132332, -0.018367711454629898, 0.02722857892513275, 0.0038484439719468355, -0.0072276396676898, -0.013004875741899014, 0.00010655207734089345, 0.022353272885084152, -0.001927269040606916, 0.013175511732697487, 0.01884305477142334, 0.018331147730350494, 0.0003586015373002738, -0.014235890470445156, -0.026009751483798027, -0.009250891394913197, 0.00996390450745821, 0.04029439762234688, -0.006965592037886381, 0.059868745505809784, -0.019781550392508507, 0.011779955588281155, -0.048533663153648376, -0.036686670035123825, 0.027984250336885452, -0.009982187300920486, 0.001701786182820797, -0.010737859643995762, -0.0075079696252942085, -0.006539002992212772, 0.018282392993569374, 0.0025382055900990963, -0.027642980217933655, 0.012492968700826168, -0.019354961812496185, -0.01367523055523634, 0.030787551775574684, 0.027691733092069626, -0.010219858027994633, -0.03217701241374016, -0.006813238840550184, 0.013797113671898842, 0.023377086967229843, -0.03634539991617203, 0.02810613438487053, 0.002206075470894575, 0.023267393931746483, 0.0010040081106126308, 0.023645229637622833, -0.037612978368997574, 0.023364899680018425]', 800, NOW()) ON CONFLICT (id) DO NOTHING;
 INSERT INTO embeddings (id, scope, combined, embeddings, n_tokens, created_at) VALUES (4, 'lelang', 'Stock No: BA00001223K14; ID Lelang: BEKASI-CAR-2311150404; Cabang: Caready Bekasi; Tanggal: 15/11/23; Bulan: November; Lot: 0    226
 1    227
 2    120
@@ -88,7 +89,7 @@ Name: odometer, dtype: int64; Grade: D; No Mesin: DP82110; No Rangka: MHKV1AA2JD
 Name: stnk_exp_date, dtype: object; Faktur: Tidak Ada; Kwitansi Blank: Tidak Ada; FC KTP: Tidak Ada; Form A: Tidak Ada; Status Keur: Tidak Ada; Note 1: B 1690 PRD
 Km 339803
 
-Tarikan kap depan putus ,no mesin,no rangka tdk terjangkau, stir kusam, bamper gores renggang, body gores penyok, buku servis TA, dongkrak TA, mesin kasar, rekstir berisik; Note 2: ADA BIAYA TAMBAHAN PPN SEBESAR 1,1 % DARI HARGA TERBENTUK DIBEBANKAN KE PEMENANG LELANG // UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI // NOPOL BLOKIR E.T.L.E // NOTE PENULISAN IDENTITAS PEMILIK PADA STNK & BPKB SAMA, SEDANGKAN PADA COPY SCAN FAKTUR BERBEDA // STNK SOBEK', '[-0.01658739149570465, -0.00785879697650671, 0.011975018307566643, -0.01591360569000244, -0.025971395894885063, -0.011350234970450401, -0.05120775103569031, 0.022161440923810005, -0.0220144335180521, -0.05792111158370972, 0.07497403025627136, -0.05311885476112366, -0.01441902481019497, -0.014896800741553307, 0.048292096704244614, 0.06169431656599045, 0.020948626101017, -0.008679591119289398, -0.04488641396164894, -0.026853444054722786, 0.014566033147275448, 0.02435430884361267, -0.03373831510543823, -0.02516285330057144, 0.04135822132229805, -0.02423180267214775, -0.03447335213422775, -0.0662025585770607, 0.012342538684606552, -0.06654557585716248, -0.0003575660812202841, -0.031655702739953995, 0.04635649174451828, -0.018081972375512123, -0.03217022866010666, 0.052138801664114, 0.0660555511713028, -0.006578603759407997, 0.023717274889349937, -0.04035366699099541, -0.02651042491197586, -0.029205569997429848, 0.0048359474167227745, 0.059881217777729034, 0.03493887931108475, 0.01621987111866474, -0.0017028414877131581, -0.024979092180728912, -0.0014746730448678136, 0.04821859300136566, 0.03413033485412598, 0.027098456397652626, 0.005221843253821135, 0.0689467042684555, -0.016673145815730095, -9.503831097390503e-05, 0.007105381693691015, 0.036580465734004974, 0.06938772648572922, 0.001950917299836874, -0.02626541256904602, -0.02070361189544201, -0.0006799115217290819, 0.03907959908246994, -0.004921702202409506, -0.03756051883101463, -0.03410583361983299, 0.03182721138000488, -0.03555141016840935, 0.0023812216240912676, -0.0005018941592425108, 0.06350741535425186, 0.0216346625238657, 0.001995326019823551, -0.015497082844376564, 0.018277982249855995, 0.021732667461037636, -0.03131268173456192, 0.011080720461905003, 0.010921462439000607, -0.028470531105995178, -0.024121547117829323, -0.033199284225702286, 0.015950357541441917, 0.017775705084204674, -0.05404990538954735, -0.08815573900938034, -0.03611494228243828, -0.039545126259326935, -0.035894427448511124, -0.02219819277524948, -0.028397025540471077, -0.005472981836646795, 0.027833495289087296, 0.029475083574652672, 0.01837598718702793, -0.037021487951278687, -0.023692773655056953, -0.014321019873023033, 0.029475083574652672, 0.02668193355202675, 0.0440288670361042, 0.03719300031661987, -0.026485923677682877, 0.007846546359360218, 0.02854403480887413, -0.007773042656481266, -0.00850808247923851, -0.020005324855446815, 0.019135529175400734, -0.06424245238304138, 0.014198512770235538, 0.016660895198583603, 0.06595754623413086, 0.013071452267467976, -0.009016484953463078, -0.016489386558532715, 0.011515619233250618, -0.016722148284316063, -0.03692348301410675, -0.007338144350796938, 0.028274519369006157, 0.004015153273940086, -0.054637935012578964, -0.02976910024881363, 0.027049453929066658, 0.000561616150662303, -0.022357450798153877, 0.007056379225105047, -0.018290232867002487, -0.0030029425397515297, -0.021377399563789368, 0.011056219227612019, 0.010419185273349285, -0.014345521107316017, -0.032954271882772446, -0.025236357003450394, -0.007313643116503954, -0.025652877986431122, -0.035355400294065475, 0.0016859968891367316, 0.009818903170526028, 0.007485152222216129, 0.003240299178287387, -0.07595407962799072, 0.033689308911561966, -0.0015014712698757648, 0.00016480963677167892, 0.014247516170144081, 0.014566033147275448, -0.005506671033799648, 0.026216408237814903, -0.05057071894407272, -0.08506856858730316, 0.027147458866238594, -0.011668751947581768, -0.009935284033417702, 0.0212548915296793, -0.03390982374548912, 0.03062664531171322, -0.009273748844861984, 0.005794561468064785, 0.019527548924088478, 0.01967455819249153, 0.008177314884960651, 0.019307037815451622, 0.0008659684099256992, -0.019748061895370483, -0.07418999075889587, -0.021426402032375336, 0.0426567941904068, -0.019760312512516975, -0.02668193355202675, -0.05042371153831482, -0.03346879780292511, -0.035110387951135635, 0.027882497757673264, -0.02854403480887413, 0.010774454101920128, 0.006590854376554489, -0.02022583782672882, -0.015203067101538181, 0.01435777172446251, -0.005708806682378054, -0.014945803210139275, -0.013561478815972805, -0.00989240687340498, 0.05238381400704384, 0.020385095849633217, 0.027024952694773674, -0.0037119495682418346, 0.011031717993319035, -0.014345521107316017, 0.02379077859222889, -0.01793496310710907, -0.04498441889882088, 0.011227728798985481, 0.04772856459021568, -0.033444296568632126, -0.011472742073237896, 0.023901034146547318, -0.04356333985924721, 0.031778208911418915, 0.041652239859104156, -0.042068760842084885, 0.023754026740789413, -0.022173691540956497, -0.004995205905288458, 0.07458201050758362, -0.010253801010549068, 0.09339901804924011, 0.0012687088456004858, -0.001310820458456874, 0.021426402032375336, 0.009776025079190731, 0.029573088511824608, -0.016538389027118683, 0.008373324759304523, -0.01130735781043768, 0.02756398171186447, -0.004661375656723976, -0.03253774717450142, -0.021230390295386314, -0.001326133729889989, -0.009053236804902554, 0.011705503799021244, 0.015019306913018227, 0.02780899405479431, 0.009482009336352348, -0.07036778330802917, -0.014566033147275448, -0.008410076610744, 0.03981463983654976, -0.029867105185985565, 0.019307037815451622, -0.004646062385290861, 0.0063703423365950584, 0.02261471562087536, -0.007846546359360218, -0.011533995158970356, -0.005353537853807211, -0.0009578483295626938, -0.006067138630896807, -0.011184850707650185, 0.028372524306178093, -0.004661375656723976, 0.00645609712228179, -0.013794241473078728, 0.020176833495497704, 0.020446348935365677, -0.02022583782672882, 0.021561158820986748, -0.022100187838077545, -0.026828942820429802, 0.004734879359602928, -0.009714771993458271, -0.02785799652338028, -0.007093131076544523, 0.012342538684606552, -0.05444192513823509, -0.0136839859187603, 0.0017487815348431468, -0.007877172902226448, -0.039545126259326935, -0.021793920546770096, -0.03383632004261017, 0.02513835020363331, 0.04738554731011391, 0.0023904098197817802, -0.034669362008571625, -0.011821885593235493, -0.021475404500961304, 0.053412869572639465, 0.033811818808317184, -0.03606593981385231, -0.030308129265904427, 0.01429651863873005, 0.028250018134713173, 0.00696449913084507, 0.026583928614854813, -0.015239818952977657, 0.0026093902997672558, -0.005463793873786926, 0.020495351403951645, 0.006002822425216436, 0.011705503799021244, 0.0007668146281503141, -0.026363417506217957, -0.020997628569602966, 0.015166315250098705, 0.0213283970952034, -0.03655596449971199, 0.014737541787326336, -0.028250018134713173, 0.00912061519920826, -0.02372952550649643, 0.019686808809638023, -0.05287384241819382, 0.009439132176339626, 0.023888783529400826, 0.05659804120659828, 0.0031055419240146875, -0.024195050820708275, -0.03626194968819618, -0.013573729433119297, 0.010658073239028454, -0.01525206957012415, 0.002313842996954918, -0.03070015087723732, -0.03795253857970238, 0.02360701933503151, 0.021230390295386314, 0.018400488421320915, -0.0651244968175888, 0.05591200664639473, -0.02039734646677971, 0.005969133228063583, -0.0067929900251328945, 0.05997922271490097, -0.04099070280790329, 0.0305041391402483, 0.002016764599829912, 0.012875442393124104, 0.008857225999236107, -0.018045220524072647, -0.014076006598770618, 0.016856905072927475, -0.01130735781043768, 0.015999360010027885, -0.005338224582374096, -0.019429543986916542, -0.03229273483157158, 0.03025912679731846, -0.01726117730140686, 0.01174838189035654, 0.02435430884361267, -0.017738953232765198, 0.04013315588235855, 0.004514367785304785, -0.039104100316762924, -0.04856161028146744, 0.01245279423892498, 0.025971395894885063, 0.0010221643606200814, -0.025383364409208298, -0.020630108192563057, 0.03484087437391281, 0.0006802943535149097, 0.01414951030164957, -0.0280540082603693, -0.03618844598531723, -0.008532583713531494, 0.004318356979638338, 0.03315028175711632, -0.00021457794355228543, -0.024746330454945564, 0.008593836799263954, 0.031043168157339096, -0.01830248348414898, 0.04295080900192261, 0.015950357541441917, -0.012507922016084194, 0.0037793281953781843, 0.03126367926597595, 0.011944391764700413, 0.021793920546770096, 0.001578803639858961, 0.012103650718927383, 0.02342325821518898, -0.015386827290058136, 0.06924071907997131, 0.018633252009749413, -0.01886601373553276, 0.013218460604548454, -0.0026109216269105673, -0.045155927538871765, 0.0374625138938427, 0.04371035099029541, -0.017163172364234924, 0.00019294787489343435, 0.03871208056807518, -0.05096273869276047, 0.005760872270911932, -0.012091400101780891, 0.002998348558321595, 0.03834456205368042, 0.025726383551955223, 0.05253082513809204, 0.0030427572783082724, -0.05419691279530525, -0.015141814015805721, -0.012342538684606552, 0.024930089712142944, -0.021830672398209572, 0.013647233135998249, -0.011546245776116848, 0.0342528410255909, 0.02219819277524948, -0.031190175563097, -0.0018712880555540323, -0.0024332869797945023, -0.00545460544526577, -0.008281445130705833, -0.02582438848912716, 0.0016063675284385681, 0.026779938489198685, 0.025995897129178047, 0.06051825359463692, 0.019454045221209526, -0.04199525713920593, 0.020434098318219185, -0.025407865643501282, 0.02545686811208725, 0.003252549795433879, 0.021744918078184128, -0.031214676797389984, -0.028887052088975906, 0.05620602145791054, -0.007062504533678293, -0.008949105627834797, 0.017420437186956406, -0.013414471410214901, -0.0024455375969409943, -0.011252230033278465, -0.07075980305671692, -0.0319497175514698, 0.005895629525184631, -0.016599642112851143, -0.013622731901705265, -0.0343998484313488, -0.005613864399492741, -0.008263069204986095, -0.05110974609851837, 0.021475404500961304, -0.05184478685259819, 0.00275946082547307, 0.010229299776256084, 0.034007828682661057, -0.032758262008428574, -0.06394843757152557, 0.028642039746046066, 0.005641428288072348, 0.007969053462147713, 0.03925111144781113, 0.012097525410354137, -0.028274519369006157, 0.013990252278745174, 0.035526908934116364, -0.020691361278295517, -0.010333430022001266, -0.022406455129384995, 0.0008629057556390762, 0.03660496696829796, 0.03731550648808479, -0.048659615218639374, 0.011503368616104126, -0.004348983522504568, 0.005659804213792086, 0.016722148284316063, 0.019368290901184082, -0.0007262343424372375, 0.01264267973601818, 0.02631441503763199, 0.0006714892224408686, 0.010210923850536346, -0.016918160021305084, -0.06541851907968521, 0.002355189062654972, 0.01451703067868948, 0.019098777323961258, -0.008685716427862644, 0.012526297941803932, -0.007803669199347496, -0.003996777348220348, -0.0207158625125885, 0.04704253003001213, -0.039422620087862015, -0.02878904715180397, -0.030798155814409256, -0.04140722379088402, -0.0024991342797875404, -0.031778208911418915, -0.04346533492207527, -0.013598230667412281, -0.01239154115319252, 0.04030466452240944, 0.041211213916540146, 0.0033566805068403482, -0.06889770179986954, -0.015484832227230072, -0.019490797072649002, 0.00853870902210474, 0.06536951661109924, -0.011466615833342075, -0.014651787467300892, -0.011448239907622337, 0.0343998484313488, -0.0063580917194485664, -0.015484832227230072, -0.005276971030980349, -0.04924764856696129, -0.023778527975082397, -0.005019707139581442, -0.04745905101299286, 0.0024470689240843058, 0.01885376311838627, -0.02224719524383545, 0.011148098856210709, -0.014406774193048477, 0.0008942980784922838, 0.030871659517288208, 0.017040666192770004, -0.023276250809431076, -0.027220962569117546, 0.01837598718702793, -0.012618178501725197, 0.004918639548122883, 0.030798155814409256, -0.024758581072092056, -0.02287197858095169, 0.0280540082603693, 0.016415882855653763, 0.046013474464416504, -0.03925111144781113, 0.019870568066835403, -0.023815279826521873, -0.005381101742386818, -0.11731230467557907, -0.0011201695306226611, -0.005120775196701288, 0.030063115060329437, 0.010584568604826927, 0.01830248348414898, 0.014308769255876541, -0.008930729702115059, -0.014333270490169525, 0.04224026948213577, 0.02138965018093586, -0.01886601373553276, -0.03398332744836807, -0.013916747644543648, 0.00921249482780695, 0.02582438848912716, -0.04378385469317436, -0.02404804341495037, -0.05037470906972885, 0.019686808809638023, 0.014174011535942554, -0.01634237915277481, 0.028446028009057045, 0.046209484338760376, 0.018167726695537567, -0.015484832227230072, 0.014566033147275448, -0.007730165496468544, 0.01531332265585661, -0.012826438993215561, 0.00285746599547565, -0.003993714693933725, -0.0040702810510993, 0.007154384627938271, 0.022479958832263947, 0.02533436194062233, 0.021120134741067886, 0.006061013322323561, -0.0032984898425638676, -0.0035833176225423813, 0.025407865643501282, 0.011356360279023647, -0.0400596521794796, -0.059930220246315, -0.00016557530034333467, -0.00042264771764166653, 0.02495459094643593, 0.033566802740097046, 0.021855175495147705, -0.06140030175447464, 0.017898211255669594, 0.019331539049744606, -0.041260216385126114, -0.025113848969340324, 0.001286319107748568, -0.012336413376033306, 0.03021012432873249, -0.00853870902210474, 0.05302084982395172, -0.009457508102059364, 0.03907959908246994, -0.006848118267953396, 0.026608429849147797, -0.014651787467300892, -0.038246557116508484, -0.014553782530128956, 0.014137259684503078, 0.010921462439000607, -0.02349676378071308, 0.019711310043931007, 0.004033529199659824, -0.006694984622299671, -0.019527548924088478, -0.005724119953811169, 0.029058562591671944, -0.018351485952734947, 0.013781990855932236, 0.012428293004631996, -0.024207301437854767, 0.01886601373553276, 0.008428452536463737, -0.018829261884093285, 0.019809314981102943, 0.02077711746096611, 0.04420037567615509, 0.045033421367406845, 0.007693413645029068, -0.04684651643037796, 0.03783003240823746, -0.020728113129734993, -0.0165138877928257, 0.0010191015899181366, 0.0018345360876992345, -0.010927587747573853, 0.004061093088239431, 0.03322378545999527, -0.0030197873711586, 0.004122346639633179, 0.014455776661634445, 0.02391328476369381, -0.03383632004261017, -0.011043968610465527, 0.038001541048288345, -0.01251404732465744, -0.02854403480887413, 0.01535007543861866, -0.024807583540678024, 0.005647553596645594, -0.015999360010027885, 0.008667340502142906, -0.05086473375558853, 0.005123837850987911, -0.0006293775513768196, -0.038932591676712036, -0.04197075590491295, -0.02355801686644554, -0.029254572466015816, -0.00019888178212568164, -0.01941729336977005, -0.012961196713149548, 0.03322378545999527, -0.029083063825964928, -0.02643692120909691, 0.020764866843819618, -0.03160670027136803, 0.030038613826036453, -0.04290180653333664, 0.0200298260897398, -0.023827530443668365, -0.011154224164783955, 0.0014264360070228577, 0.006449971813708544, 0.02668193355202675, -0.005923193413764238, 0.039667632430791855, -0.003228048561140895, 0.010161921381950378, -0.023165995255112648, 0.005760872270911932, -0.019086526706814766, 0.0037578893825411797, 0.05287384241819382, -0.00016902080096770078, 0.016820153221488, 0.019625555723905563, 0.0011530931806191802, 0.009267623536288738, -0.0012020958820357919, 0.021561158820986748, 0.0011952049098908901, 0.00369051075540483, -0.028495032340288162, -0.008428452536463737, 0.025383364409208298, -0.019490797072649002, -0.03643345832824707, 0.0011209351941943169, -0.002171429106965661, 0.03160670027136803, 0.010468187741935253, 0.037389010190963745, 0.02372952550649643, -0.0008299821056425571, 0.022724971175193787, 0.040721189230680466, 0.06233134865760803, -0.007178885862231255, 0.03530639782547951, 0.051991794258356094, -0.005540360230952501, 0.02004207670688629, -0.02194092981517315, -0.08051132410764694, -0.013941248878836632, -0.010737702250480652, 0.008005805313587189, -0.0020887372083961964, -0.03185171261429787, -0.011405362747609615, -0.0013835587305948138, -0.002110176021233201, 0.035649415105581284, -0.031190175563097, 0.006670483388006687, -0.005613864399492741, -0.009818903170526028, -0.0008070121402852237, -0.008900103159248829, 0.023165995255112648, 0.01155849639326334, -0.025799887254834175, 0.03163120150566101, 0.015705343335866928, -0.02447681501507759, 0.029989611357450485, 0.054588932543992996, -0.04253428429365158, -0.00773629080504179, 0.003684385446831584, 0.009837279096245766, 0.03094516322016716, 0.03351780027151108, 0.01451703067868948, 0.01817997731268406, 0.008207941427826881, 0.00645609712228179, -0.012985697947442532, -0.0017840021755546331, 0.013071452267467976, -0.03212122619152069, 0.01793496310710907, -0.024856586009263992, -0.01609736494719982, 0.033321790397167206, -0.05743108689785004, -0.002823776798322797, 0.0026859568897634745, -0.02680443972349167, 0.000495003187097609, 0.00025171274319291115, 0.015031557530164719, -0.026461422443389893, -0.008183440193533897, -0.012060773558914661, 0.008532583713531494, 0.031018666923046112, -0.0067256116308271885, 0.022639216855168343, -0.05208979919552803, -0.0136839859187603, -0.0009264560649171472, 0.026044899597764015, 0.025481369346380234, 0.005672054830938578, -0.006927747279405594, 0.007711789570748806, 0.0017227488569915295, 0.021242640912532806, -0.004505179822444916, -0.046748511493206024, -0.01868225447833538, -0.03221923112869263, -0.005469919182360172, 0.034056831151247025, -0.027171960100531578, 0.0004835181753151119, -0.00927987415343523, 0.040476176887750626, -0.027833495289087296, -0.0007476729806512594, -0.006106953136622906, 0.0012817251263186336, 0.02866654098033905, 0.003065727185457945, 0.006554102059453726, -0.005427041556686163, 0.01081733126193285, -0.0006619183695875108, 0.024084795266389847, -0.01044368650764227, 0.0033873070497065783, -0.014125009067356586, -0.00856933556497097, 0.002162241144105792, 0.007644410710781813, -0.001261817873455584, -0.017028415575623512, 0.008802098222076893, 0.03207222372293472, -0.0305041391402483, -0.024378810077905655, -0.005148339085280895, 0.016440384089946747, -0.03322378545999527, -0.003662946866825223, -0.029622092843055725, -0.0009103770717047155, 0.026730936020612717, -0.033615805208683014, -0.019809314981102943, 0.03503688424825668, 0.030871659517288208, 0.0016768089262768626, -0.017163172364234924, 0.01724892668426037, 0.0220144335180521, 0.029450582340359688, 0.033444296568632126, 0.003880396019667387, 0.036531463265419006, 0.010400809347629547, -0.018143225461244583, 0.012538548558950424, 0.046626005321741104, -0.021769419312477112, 0.02009107917547226, 0.009916908107697964, -0.009187993593513966, -0.006701109930872917, -0.027098456397652626, 0.017555193975567818, -0.027514979243278503, 0.015337823890149593, 0.012936695478856564, -0.013328716158866882, -0.040647685527801514, -0.04209326207637787, -0.005613864399492741, 0.014994805678725243, 0.003047351259738207, -0.0037609520368278027, -0.001736530801281333, -0.020997628569602966, 0.04420037567615509, 0.03062664531171322, 0.03802604228258133, -0.004520493093878031, 0.026485923677682877, 0.013304214924573898, -0.02626541256904602, -0.010762203484773636, -0.025383364409208298, 0.029548587277531624, -0.026240909472107887, -0.005978321190923452, -0.0034914377611130476, 0.011233854107558727, 0.008881727233529091, 0.026118403300642967, -0.03655596449971199, -0.03778102993965149, 0.024562569335103035, -0.03452235460281372, -0.03403232991695404, 0.00785879697650671, 0.017212174832820892, -0.02829902060329914, 0.013978001661598682, -0.008214066736400127, 0.02267596870660782, 0.007521904073655605, -0.01615861803293228, -0.0004777756694238633, -0.01059681922197342, -0.008483581244945526, 0.03148419037461281, -0.04782656952738762, 0.018032969906926155, -0.006609230302274227, 0.029254572466015816, 0.008618338033556938, -0.013169458135962486, -0.04603797569870949, 0.008244693279266357, -0.013206209987401962, 0.010713201016187668, 0.025799887254834175, 0.021830672398209572, 0.011301232501864433, 0.026951448991894722, 0.004593996796756983, -0.0018651627469807863, -0.014786544255912304, 0.002246464602649212, 0.01506831031292677, 0.008134436793625355, 0.026853444054722786, -0.014529281295835972, -0.010639696381986141, 0.007087005767971277, 0.012134277261793613, 0.04699352756142616, -0.0035312522668391466, 0.012489546090364456, -0.007448400370776653, -0.02687794528901577, 0.0325622484087944, 0.025971395894885063, -0.014468027278780937, -0.035894427448511124, -0.007454525679349899, 0.05444192513823509, 0.010210923850536346, 0.015668591484427452, -0.012367039918899536, 0.0458664633333683, -0.004713440779596567, 0.006621480919420719, -0.0012855534441769123, -0.018032969906926155, 0.023141494020819664, 0.056794051080942154, -0.015509333461523056, -0.033689308911561966, -0.004572558216750622, -0.04652800038456917, 0.04197075590491295, 0.03932461515069008, -0.035110387951135635, -0.01139923743903637, 0.002330687828361988, 0.006431595887988806, 0.0014363897498697042, -0.02146315388381481, 0.014566033147275448, 0.020948626101017, 0.033199284225702286, -0.03721750155091286, -0.021646913141012192, -0.014884550124406815, -0.003963088151067495, 0.023950036615133286, -0.007172760553658009, 0.015668591484427452, 0.018535247072577477, 0.0634584128856659, -0.01519081648439169, -0.011864762753248215, -0.004244853276759386, 0.007681163027882576, -0.03212122619152069, -0.00071857770672068, -0.016550639644265175, 0.007564781699329615, 0.014970304444432259, -0.0014785013627260923, -0.0011408425634726882, -0.010792830027639866, 0.01873125694692135, 0.05566699057817459, 0.004021278582513332, 0.015827851369976997, -0.0024838210083544254, 0.0019279473926872015, -0.0020672986283898354, -0.021744918078184128, -0.04699352756142616, -0.02100987918674946, -0.03518389165401459, 0.002274028491228819, -0.03542890399694443, 0.0470915324985981, 0.010811205953359604, 0.011595248244702816, -0.0016477135941386223, -0.04976217448711395, 0.007313643116503954, -0.010168046690523624, -0.019282536581158638, 0.046209484338760376, -0.029818102717399597, 0.046331990510225296, -0.004624623339623213, -0.008073183707892895, 0.01158912293612957, 0.056941062211990356, 0.002946283435449004, -0.007864922285079956, 0.01824123039841652, 0.031239179894328117, 0.005607739090919495, 0.02410929650068283, 0.019564300775527954, 0.005485232453793287, 0.06889770179986954, 0.01658739149570465, 0.01254467386752367, 0.009390129707753658, -0.017702201381325722, -0.009512635879218578, 0.01602386124432087, 0.004933952819555998, 0.0458664633333683, 0.03224373236298561, 0.0005673586274497211, -0.012538548558950424, -0.025064846500754356, -0.03731550648808479, -0.01392899826169014, 0.011135848239064217, -0.008618338033556938, -0.02016458287835121, -0.01775120384991169, -0.015925856307148933, -0.006468347739428282, -0.026534926146268845, 0.016979413107037544, -0.007687288336455822, 0.010186422616243362, -0.01174838189035654, -0.052481818944215775, -0.017408184707164764, -0.03437534719705582, 0.02047085016965866, 0.0013368531363084912, -0.00037287941086106, 0.009904657490551472, -0.01609736494719982, -0.013536977581679821, 0.013671734370291233, -0.03070015087723732, -0.020826119929552078, 0.005301472265273333, 0.009451382793486118, -0.003644570941105485, -0.036335453391075134, -0.007025752682238817, 0.0004471490392461419, 0.025848889723420143, -0.01603611186146736, 0.03280726447701454, 0.001615555607713759, 0.015448080375790596, -0.007178885862231255, -0.005482169799506664, 0.020728113129734993, 0.012324162758886814, 0.03224373236298561, -0.024084795266389847, 0.0299651101231575, 0.01078670471906662, 2.8305719752097502e-05, 0.0017824708484113216, -0.06409544497728348, -0.01764094829559326, -0.013451223261654377, -0.005479107145220041, 0.010688699781894684, 0.008716343902051449, -0.005243281833827496, 0.00738102151080966, -0.0023199685383588076, 0.022700469940900803, -0.0016660895198583603, -0.004116221331059933, 0.05066872388124466, -0.03025912679731846, 0.027147458866238594, 0.020078828558325768, -0.004051905125379562, 0.011013342067599297, -0.010872459039092064, -0.008587711490690708, -0.02896055579185486, -0.03770752623677254, 0.0034148709382861853, 0.024746330454945564, 0.019613303244113922, 0.032513245940208435, 0.002955471398308873, 0.017800206318497658, -0.028764545917510986, 0.020299341529607773, -0.025652877986431122, -0.010688699781894684, -0.04581746086478233, -0.041456226259469986, -0.018461741507053375, 0.018020719289779663, -0.026338916271924973, 0.035967934876680374, 0.019956322386860847, 0.0022372764069586992, 0.03488987684249878, -0.052187804132699966, -0.0016905908705666661, 0.043244823813438416, 0.03341979533433914, -0.004952328745275736, 0.015889104455709457, 0.040280163288116455, 0.004584808833897114, -0.00436429725959897, -0.018571998924016953, 0.022651467472314835, 0.021499905735254288, 0.023692773655056953, -0.0044623021967709064, -0.021781669929623604, 0.010498814284801483, -0.017040666192770004, 0.02088737301528454, -0.01044368650764227, -0.02039734646677971, -0.02236970141530037, 0.04258329048752785, 0.008900103159248829, -0.002466976409777999, 0.031288180500268936, 0.010590693913400173, 0.011454365216195583, -0.019466295838356018, 0.0090593621134758, -0.06434045732021332, 0.003687448101118207, -0.019441794604063034, -0.00908998865634203, 0.009218620136380196, 0.01127673126757145, -0.02619190700352192, 0.006033449433743954, 0.027196461334824562, 0.013818742707371712, 0.007497402839362621, -0.0020917998626828194, -0.026069400832057, 0.0011645782506093383, 0.016305625438690186, -0.04880662262439728, -0.014382272958755493, 0.06169431656599045, 0.007773042656481266, -0.03724200278520584, -0.012287409976124763, 0.03212122619152069, -0.010749952867627144, -0.012250658124685287, 0.015999360010027885, 0.015497082844376564, 0.00986178033053875, 0.09261497855186462, 0.002491477644070983, 0.002074955264106393, 0.016244372352957726, -0.03292977064847946, -0.020936375483870506, 0.013475724495947361, -0.038932591676712036, -0.01103784330189228, 0.0188047606498003, 0.038810085505247116, 0.005240219179540873, 0.029426081106066704, 0.03273376077413559, 0.03221923112869263, -0.015827851369976997, -0.032390739768743515, -0.026926947757601738, 0.02212468907237053, -0.01121547818183899, 0.007007376756519079, 0.02417054958641529, -0.0033628058154135942, -0.04057418182492256, -0.0028406213968992233, 0.0002633891417644918, -0.015105062164366245, 0.0035435028839856386, 0.009396255016326904, 0.048488106578588486, 0.03687448054552078, 0.03339529410004616, 0.014076006598770618, 0.0007798309670761228, -0.0005512796342372894, -0.01479879580438137, 0.001545879989862442, 0.011313483119010925, -0.019637806341052055, -0.030675647780299187, -0.006094702519476414, -0.0012120495084673166, -0.02187967672944069, 0.03542890399694443, 0.013794241473078728, 0.018535247072577477, -0.002805400872603059, -0.019160030409693718, 0.030063115060329437, 0.007834295742213726, 0.002854403341189027, 0.0009364096913486719, -0.03964313119649887, 0.0004777756694238633, 0.012379290536046028, -0.03903059661388397, -0.0071421340107917786, -0.003929398488253355, 0.0017640948062762618, 0.017591945827007294, -0.036825478076934814, -0.010633571073412895, 0.010860208421945572, 0.027906998991966248, 0.01670989766716957, -0.021377399563789368, -0.03802604228258133, 0.015239818952977657, 0.011295107193291187, -0.014223014004528522, -0.003647633595392108, 0.012948946096003056, -0.011288981884717941, -0.03724200278520584, -0.024023540318012238, 0.015742095187306404, -0.008116060867905617, -0.020666860044002533, -0.00034014717675745487, 0.027392473071813583, 0.00795680284500122, 0.03925111144781113, 0.04035366699099541, 0.006468347739428282, -0.004979892633855343, -0.04037817195057869, 0.01891501620411873, -0.006884870119392872, 0.004538869019597769, -0.029793601483106613, -0.012066898867487907, -0.007656661327928305, -0.023435508832335472, -0.006511224899441004, 0.0007044128724373877, 0.014235265552997589, -0.03866307809948921, -0.007019627373665571, 0.05404990538954735, -0.04054968059062958, 0.06566353142261505, -0.010633571073412895, -0.005157527048140764, -0.0063887182623147964, 0.009898532181978226, 0.015876853838562965, -0.00131694576703012, 0.019209032878279686, 0.002871248172596097, -0.006688859313726425, -0.022541211917996407, -0.01014354545623064, 0.035110387951135635, -0.028617538511753082, 0.022761723026633263, -0.048169590532779694, 0.01492130197584629, -0.013340966776013374, 0.007944552227854729, 0.0331747829914093, 0.0019432606641203165, 0.012385415844619274, 0.0212548915296793, 0.012195530347526073, 0.013414471410214901, -0.041652239859104156, -0.004094782751053572, 0.029867105185985565, -0.033811818808317184, -0.012654930353164673, 0.009169617667794228, -0.0018115660641342402, 0.029450582340359688, 0.011184850707650185, 0.03285626694560051, 0.018596500158309937, -0.015448080375790596, -0.01973581127822399, -0.0007939958013594151, 0.007019627373665571, 0.00038302448228932917, -0.021352898329496384, 0.004593996796756983, 0.013647233135998249, -0.010762203484773636, 0.004698127508163452, -0.014847798272967339, -0.02817651443183422, -0.01158912293612957, 0.022528961300849915, 0.012654930353164673, -0.0006557930610142648, 0.0037180748768150806, -0.029548587277531624, -0.051746781915426254, 0.030479637905955315, 0.007871047593653202, 0.010437561199069023, 0.0318027101457119, -0.02281072549521923, -0.04211776331067085, -0.010774454101920128, -0.03390982374548912, -0.0090593621134758, -0.021181387826800346, 0.007619909476488829, 0.01873125694692135, 0.016256622970104218, 0.039226606488227844, 0.0337873175740242, 0.006137579679489136, -0.004707315471023321, 0.0010305866599082947, 0.021095633506774902, 0.01492130197584629, 0.012036272324621677, 0.00025228699087165296, 0.03697248548269272, -0.03474286571145058, -0.0048175714910030365, 0.03711949661374092, -0.022663718089461327, -0.020189085975289345, -0.014835547655820847, -0.014174011535942554, 0.037756528705358505, 0.02016458287835121, -0.000625166401732713, 0.012250658124685287, -0.012177154421806335, 0.00943300686776638, -0.0007021158817224205, -0.024501316249370575, 0.045768458396196365, -0.023386506363749504, -0.013144956901669502, 0.01817997731268406, 0.002655330114066601, -0.007062504533678293, 0.04432288184762001, 0.02700045146048069, 0.037389010190963745, -0.016538389027118683, -0.012348663993179798, 0.023202747106552124, -0.015827851369976997, -0.027465976774692535, -0.02070361189544201, 0.0033260537311434746, -0.009328876622021198, 0.02311699278652668, 0.007681163027882576, -0.03809954598546028, 0.0030121307354420424, 0.012489546090364456, -0.007013502065092325, 0.025407865643501282, 0.030185623094439507, -0.00011427567369537428, 0.01242216769605875, 0.010198673233389854, 0.044102370738983154, -0.017395934090018272, 0.010462062433362007, -0.009610641747713089, 0.007031877990812063, 0.028152013197541237, 0.016538389027118683, 0.01984606683254242, -0.011239979416131973, 0.016415882855653763, 0.01187088806182146, 0.0006577072199434042, 0.0037823908496648073, 0.009488134644925594, -0.028691042214632034, -0.003249487141147256, 0.012526297941803932, 0.02152440696954727, -0.007687288336455822, -0.018768008798360825, 0.01297344733029604, -0.012618178501725197, -0.02655942738056183, 0.01297344733029604, 0.03876108303666115, 0.0012143464991822839, -0.005087085999548435, -0.03496338054537773, -0.01386774517595768, 0.004407174419611692, 0.01787371002137661, -0.007822045125067234, -0.012158778496086597, -0.032513245940208435, -0.013022449798882008, 0.016244372352957726, -0.012269034050405025, -0.0020734239369630814, -0.0067684887908399105, -0.002526698401197791, -0.020189085975289345, 0.016844654455780983, -0.024084795266389847, 0.036580465734004974, 0.0029661906883120537, 0.009665769524872303, 0.008655089884996414, 0.03998614847660065, -0.014529281295835972, -0.027588482946157455, 0.023386506363749504, 0.0168936587870121, -0.015166315250098705, 0.0008261537877842784, -0.004125409293919802, -0.01498255506157875, -0.019870568066835403, -0.003666009521111846, 0.002367439679801464, -0.0016063675284385681, 0.04253428429365158, 0.009965910576283932, 0.00403965450823307, -0.020066577941179276, -0.00801805593073368, -0.014615035615861416, 0.020752616226673126, 0.016305625438690186, 0.03491437807679176, -0.025309860706329346, -0.013083702884614468, -0.0004953860188834369, -0.003451623022556305, -0.01799621805548668, 0.0285930372774601, -0.01764094829559326, -0.017653198912739754, 0.003107073251157999, -0.01750619150698185, 0.0063519664108753204, -0.0428038015961647, -0.00728914188221097, -0.01565634086728096, 0.016428133472800255, -0.01590135507285595, 0.0363844558596611, 0.02386428229510784, 0.046331990510225296, -0.009800526313483715, -0.011227728798985481, 0.02009107917547226, -0.0067256116308271885, -0.011356360279023647, 0.02614290453493595, 0.007417773827910423, 0.003454685676842928, -0.010137420147657394, -0.0045296810567379, 0.0446413978934288, -0.017983967438340187, 0.02423180267214775, 0.006468347739428282, -0.02064235880970955, 0.02631441503763199, -0.04074569046497345, -0.027882497757673264, -0.0022479959297925234, 0.02311699278652668, 0.0034087456297129393, -0.006890995427966118, 0.006437721196562052, 0.014651787467300892, -0.0685056820511818, -0.010915336199104786, 0.004183599725365639, -0.010082291439175606, -0.01985831744968891, 0.03888358920812607, -0.010780579410493374, 0.021107884123921394, -0.005482169799506664, 0.0011278262827545404, 0.00927987415343523, -0.004548056982457638, 0.020740365609526634, 0.002194399246945977, 0.02010332979261875, 0.012220031581819057, 0.008532583713531494, -0.006664358079433441, -0.026338916271924973, -0.02651042491197586, -0.006253961008042097, 0.021303895860910416, 0.029548587277531624, -0.005411728285253048, 0.045768458396196365, -0.020568855106830597, 0.016685396432876587, -0.050815731287002563, -0.04214226454496384, 0.04799807816743851, -0.008697967045009136, -0.0014471090398728848, -0.021855175495147705, -0.005087085999548435, 0.00875922106206417, 0.025358863174915314, -0.00863058865070343, -0.020446348935365677, 0.009512635879218578, -0.01608511433005333, -0.0048145088367164135, 0.04513142630457878, 0.04371035099029541, 0.002096393844112754, -0.029989611357450485, 0.006091639865189791, -0.00042800739174708724, 0.02834802307188511, -0.020666860044002533, 0.02249220944941044, -0.003079509362578392, 0.04567045345902443, 0.021438652649521828, 0.0220144335180521, -0.038124047219753265, 0.01695491187274456]', 836, NOW());
+Tarikan kap depan putus ,no mesin,no rangka tdk terjangkau, stir kusam, bamper gores renggang, body gores penyok, buku servis TA, dongkrak TA, mesin kasar, rekstir berisik; Note 2: ADA BIAYA TAMBAHAN PPN SEBESAR 1,1 % DARI HARGA TERBENTUK DIBEBANKAN KE PEMENANG LELANG // UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI // NOPOL BLOKIR E.T.L.E // NOTE PENULISAN IDENTITAS PEMILIK PADA STNK & BPKB SAMA, SEDANGKAN PADA COPY SCAN FAKTUR BERBEDA // STNK SOBEK', '[-0.01658739149570465, -0.00785879697650671, 0.011975018307566643, -0.01591360569000244, -0.025971395894885063, -0.011350234970450401, -0.05120775103569031, 0.022161440923810005, -0.0220144335180521, -0.05792111158370972, 0.07497403025627136, -0.05311885476112366, -0.01441902481019497, -0.014896800741553307, 0.048292096704244614, 0.06169431656599045, 0.020948626101017, -0.008679591119289398, -0.04488641396164894, -0.026853444054722786, 0.014566033147275448, 0.02435430884361267, -0.03373831510543823, -0.02516285330057144, 0.04135822132229805, -0.02423180267214775, -0.03447335213422775, -0.0662025585770607, 0.012342538684606552, -0.06654557585716248, -0.0003575660812202841, -0.031655702739953995, 0.04635649174451828, -0.018081972375512123, -0.03217022866010666, 0.052138801664114, 0.0660555511713028, -0.006578603759407997, 0.023717274889349937, -0.04035366699099541, -0.02651042491197586, -0.029205569997429848, 0.0048359474167227745, 0.059881217777729034, 0.03493887931108475, 0.01621987111866474, -0.0017028414877131581, -0.024979092180728912, -0.0014746730448678136, 0.04821859300136566, 0.03413033485412598, 0.027098456397652626, 0.005221843253821135, 0.0689467042684555, -0.016673145815730095, -9.503831097390503e-05, 0.007105381693691015, 0.036580465734004974, 0.06938772648572922, 0.001950917299836874, -0.02626541256904602, -0.02070361189544201, -0.0006799115217290819, 0.03907959908246994, -0.004921702202409506, -0.03756051883101463, -0.03410583361983299, 0.03182721138000488, -0.03555141016840935, 0.0023812216240912676, -0.0005018941592425108, 0.06350741535425186, 0.0216346625238657, 0.001995326019823551, -0.015497082844376564, 0.018277982249855995, 0.021732667461037636, -0.03131268173456192, 0.011080720461905003, 0.010921462439000607, -0.028470531105995178, -0.024121547117829323, -0.033199284225702286, 0.015950357541441917, 0.017775705084204674, -0.05404990538954735, -0.08815573900938034, -0.03611494228243828, -0.039545126259326935, -0.035894427448511124, -0.02219819277524948, -0.028397025540471077, -0.005472981836646795, 0.027833495289087296, 0.029475083574652672, 0.01837598718702793, -0.037021487951278687, -0.023692773655056953, -0.014321019873023033, 0.029475083574652672, 0.02668193355202675, 0.0440288670361042, 0.03719300031661987, -0.026485923677682877, 0.007846546359360218, 0.02854403480887413, -0.007773042656481266, -0.00850808247923851, -0.020005324855446815, 0.019135529175400734, -0.06424245238304138, 0.014198512770235538, 0.016660895198583603, 0.06595754623413086, 0.013071452267467976, -0.009016484953463078, -0.016489386558532715, 0.011515619233250618, -0.016722148284316063, -0.03692348301410675, -0.007338144350796938, 0.028274519369006157, 0.004015153273940086, -0.054637935012578964, -0.02976910024881363, 0.027049453929066658, 0.000561616150662303, -0.022357450798153877, 0.007056379225105047, -0.018290232867002487, -0.0030029425397515297, -0.021377399563789368, 0.011056219227612019, 0.010419185273349285, -0.014345521107316017, -0.032954271882772446, -0.025236357003450394, -0.007313643116503954, -0.025652877986431122, -0.035355400294065475, 0.0016859968891367316, 0.009818903170526028, 0.007485152222216129, 0.003240299178287387, -0.07595407962799072, 0.033689308911561966, -0.0015014712698757648, 0.00016480963677167892, 0.014247516170144081, 0.014566033147275448, -0.005506671033799648, 0.026216408237814903, -0.05057071894407272, -0.08506856858730316, 0.027147458866238594, -0.011668751947581768, -0.009935284033417702, 0.0212548915296793, -0.03390982374548912, 0.03062664531171322, -0.009273748844861984, 0.005794561468064785, 0.019527548924088478, 0.01967455819249153, 0.008177314884960651, 0.019307037815451622, 0.0008659684099256992, -0.019748061895370483, -0.07418999075889587, -0.021426402032375336, 0.0426567941904068, -0.019760312512516975, -0.02668193355202675, -0.05042371153831482, -0.03346879780292511, -0.035110387951135635, 0.027882497757673264, -0.02854403480887413, 0.010774454101920128, 0.006590854376554489, -0.02022583782672882, -0.015203067101538181, 0.01435777172446251, -0.005708806682378054, -0.014945803210139275, -0.013561478815972805, -0.00989240687340498, 0.05238381400704384, 0.020385095849633217, 0.027024952694773674, -0.0037119495682418346, 0.011031717993319035, -0.014345521107316017, 0.02379077859222889, -0.01793496310710907, -0.04498441889882088, 0.011227728798985481, 0.04772856459021568, -0.033444296568632126, -0.011472742073237896, 0.023901034146547318, -0.04356333985924721, 0.031778208911418915, 0.041652239859104156, -0.042068760842084885, 0.023754026740789413, -0.022173691540956497, -0.004995205905288458, 0.07458201050758362, -0.010253801010549068, 0.09339901804924011, 0.0012687088456004858, -0.001310820458456874, 0.021426402032375336, 0.009776025079190731, 0.029573088511824608, -0.016538389027118683, 0.008373324759304523, -0.01130735781043768, 0.02756398171186447, -0.004661375656723976, -0.03253774717450142, -0.021230390295386314, -0.001326133729889989, -0.009053236804902554, 0.011705503799021244, 0.015019306913018227, 0.02780899405479431, 0.009482009336352348, -0.07036778330802917, -0.014566033147275448, -0.008410076610744, 0.03981463983654976, -0.029867105185985565, 0.019307037815451622, -0.004646062385290861, 0.0063703423365950584, 0.02261471562087536, -0.007846546359360218, -0.011533995158970356, -0.005353537853807211, -0.0009578483295626938, -0.006067138630896807, -0.011184850707650185, 0.028372524306178093, -0.004661375656723976, 0.00645609712228179, -0.013794241473078728, 0.020176833495497704, 0.020446348935365677, -0.02022583782672882, 0.021561158820986748, -0.022100187838077545, -0.026828942820429802, 0.004734879359602928, -0.009714771993458271, -0.02785799652338028, -0.007093131076544523, 0.012342538684606552, -0.05444192513823509, -0.0136839859187603, 0.0017487815348431468, -0.007877172902226448, -0.039545126259326935, -0.021793920546770096, -0.03383632004261017, 0.02513835020363331, 0.04738554731011391, 0.0023904098197817802, -0.034669362008571625, -0.011821885593235493, -0.021475404500961304, 0.053412869572639465, 0.033811818808317184, -0.03606593981385231, -0.030308129265904427, 0.01429651863873005, 0.028250018134713173, 0.00696449913084507, 0.026583928614854813, -0.015239818952977657, 0.0026093902997672558, -0.005463793873786926, 0.020495351403951645, 0.006002822425216436, 0.011705503799021244, 0.0007668146281503141, -0.026363417506217957, -0.020997628569602966, 0.015166315250098705, 0.0213283970952034, -0.03655596449971199, 0.014737541787326336, -0.028250018134713173, 0.00912061519920826, -0.02372952550649643, 0.019686808809638023, -0.05287384241819382, 0.009439132176339626, 0.023888783529400826, 0.05659804120659828, 0.0031055419240146875, -0.024195050820708275, -0.03626194968819618, -0.013573729433119297, 0.010658073239028454, -0.01525206957012415, 0.002313842996954918, -0.03070015087723732, -0.03795253857970238, 0.02360701933503151, 0.021230390295386314, 0.018400488421320915, -0.0651244968175888, 0.05591200664639473, -0.02039734646677971, 0.005969133228063583, -0.0067929900251328945, 0.05997922271490097, -0.04099070280790329, 0.0305041391402483, 0.002016764599829912, 0.012875442393124104, 0.008857225999236107, -0.018045220524072647, -0.014076006598770618, 0.016856905072927475, -0.01130735781043768, 0.015999360010027885, -0.005338224582374096, -0.019429543986916542, -0.03229273483157158, 0.03025912679731846, -0.01726117730140686, 0.01174838189035654, 0.02435430884361267, -0.017738953232765198, 0.04013315588235855, 0.004514367785304785, -0.039104100316762924, -0.04856161028146744, 0.01245279423892498, 0.025971395894885063, 0.0010221643606200814, -0.025383364409208298, -0.020630108192563057, 0.03484087437391281, 0.0006802943535149097, 0.01414951030164957, -0.0280540082603693, -0.03618844598531723, -0.008532583713531494, 0.004318356979638338, 0.03315028175711632, -0.00021457794355228543, -0.024746330454945564, 0.008593836799263954, 0.031043168157339096, -0.01830248348414898, 0.04295080900192261, 0.015950357541441917, -0.012507922016084194, 0.0037793281953781843, 0.03126367926597595, 0.011944391764700413, 0.021793920546770096, 0.001578803639858961, 0.012103650718927383, 0.02342325821518898, -0.015386827290058136, 0.06924071907997131, 0.018633252009749413, -0.01886601373553276, 0.013218460604548454, -0.0026109216269105673, -0.045155927538871765, 0.0374625138938427, 0.04371035099029541, -0.017163172364234924, 0.00019294787489343435, 0.03871208056807518, -0.05096273869276047, 0.005760872270911932, -0.012091400101780891, 0.002998348558321595, 0.03834456205368042, 0.025726383551955223, 0.05253082513809204, 0.0030427572783082724, -0.05419691279530525, -0.015141814015805721, -0.012342538684606552, 0.024930089712142944, -0.021830672398209572, 0.013647233135998249, -0.011546245776116848, 0.0342528410255909, 0.02219819277524948, -0.031190175563097, -0.0018712880555540323, -0.0024332869797945023, -0.00545460544526577, -0.008281445130705833, -0.02582438848912716, 0.0016063675284385681, 0.026779938489198685, 0.025995897129178047, 0.06051825359463692, 0.019454045221209526, -0.04199525713920593, 0.020434098318219185, -0.025407865643501282, 0.02545686811208725, 0.003252549795433879, 0.021744918078184128, -0.031214676797389984, -0.028887052088975906, 0.05620602145791054, -0.007062504533678293, -0.008949105627834797, 0.017420437186956406, -0.013414471410214901, -0.0024455375969409943, -0.011252230033278465, -0.07075980305671692, -0.0319497175514698, 0.005895629525184631, -0.016599642112851143, -0.013622731901705265, -0.0343998484313488, -0.005613864399492741, -0.008263069204986095, -0.05110974609851837, 0.021475404500961304, -0.05184478685259819, 0.00275946082547307, 0.010229299776256084, 0.034007828682661057, -0.032758262008428574, -0.06394843757152557, 0.028642039746046066, 0.005641428288072348, 0.007969053462147713, 0.03925111144781113, 0.012097525410354137, -0.028274519369006157, 0.013990252278745174, 0.035526908934116364, -0.020691361278295517, -0.010333430022001266, -0.022406455129384995, 0.0008629057556390762, 0.03660496696829796, 0.03731550648808479, -0.048659615218639374, 0.011503368616104126, -0.004348983522504568, 0.005659804213792086, 0.016722148284316063, 0.019368290901184082, -0.0007262343424372375, 0.01264267973601818, 0.02631441503763199, 0.0006714892224408686, 0.010210923850536346, -0.016918160021305084, -0.06541851907968521, 0.002355189062654972, 0.01451703067868948, 0.019098777323961258, -0.008685716427862644, 0.012526297941803932, -0.007803669199347496, -0.003996777348220348, -0.0207158625125885, 0.04704253003001213, -0.039422620087862015, -0.02878904715180397, -0.030798155814409256, -0.04140722379088402, -0.0024991342797875404, -0.031778208911418915, -0.04346533492207527, -0.013598230667412281, -0.01239154115319252, 0.04030466452240944, 0.041211213916540146, 0.0033566805068403482, -0.06889770179986954, -0.015484832227230072, -0.019490797072649002, 0.00853870902210474, 0.06536951661109924, -0.011466615833342075, -0.014651787467300892, -0.011448239907622337, 0.0343998484313488, -0.0063580917194485664, -0.015484832227230072, -0.005276971030980349, -0.04924764856696129, -0.023778527975082397, -0.005019707139581442, -0.04745905101299286, 0.0024470689240843058, 0.01885376311838627, -0.02224719524383545, 0.011148098856210709, -0.014406774193048477, 0.0008942980784922838, 0.030871659517288208, 0.017040666192770004, -0.023276250809431076, -0.027220962569117546, 0.01837598718702793, -0.012618178501725197, 0.004918639548122883, 0.030798155814409256, -0.024758581072092056, -0.02287197858095169, 0.0280540082603693, 0.016415882855653763, 0.046013474464416504, -0.03925111144781113, 0.019870568066835403, -0.023815279826521873, -0.005381101742386818, -0.11731230467557907, -0.0011201695306226611, -0.005120775196701288, 0.030063115060329437, 0.010584568604826927, 0.01830248348414898, 0.014308769255876541, -0.008930729702115059, -0.014333270490169525, 0.04224026948213577, 0.02138965018093586, -0.01886601373553276, -0.03398332744836807, -0.013916747644543648, 0.00921249482780695, 0.02582438848912716, -0.04378385469317436, -0.02404804341495037, -0.05037470906972885, 0.019686808809638023, 0.014174011535942554, -0.01634237915277481, 0.028446028009057045, 0.046209484338760376, 0.018167726695537567, -0.015484832227230072, 0.014566033147275448, -0.007730165496468544, 0.01531332265585661, -0.012826438993215561, 0.00285746599547565, -0.003993714693933725, -0.0040702810510993, 0.007154384627938271, 0.022479958832263947, 0.02533436194062233, 0.021120134741067886, 0.006061013322323561, -0.0032984898425638676, -0.0035833176225423813, 0.025407865643501282, 0.011356360279023647, -0.0400596521794796, -0.059930220246315, -0.00016557530034333467, -0.00042264771764166653, 0.02495459094643593, 0.033566802740097046, 0.021855175495147705, -0.06140030175447464, 0.017898211255669594, 0.019331539049744606, -0.041260216385126114, -0.025113848969340324, 0.001286319107748568, -0.012336413376033306, 0.03021012432873249, -0.00853870902210474, 0.05302084982395172, -0.009457508102059364, 0.03907959908246994, -0.006848118267953396, 0.026608429849147797, -0.014651787467300892, -0.038246557116508484, -0.014553782530128956, 0.014137259684503078, 0.010921462439000607, -0.02349676378071308, 0.019711310043931007, 0.004033529199659824, -0.006694984622299671, -0.019527548924088478, -0.005724119953811169, 0.029058562591671944, -0.018351485952734947, 0.013781990855932236, 0.012428293004631996, -0.024207301437854767, 0.01886601373553276, 0.008428452536463737, -0.018829261884093285, 0.019809314981102943, 0.02077711746096611, 0.04420037567615509, 0.045033421367406845, 0.007693413645029068, -0.04684651643037796, 0.03783003240823746, -0.020728113129734993, -0.0165138877928257, 0.0010191015899181366, 0.0018345360876992345, -0.010927587747573853, 0.004061093088239431, 0.03322378545999527, -0.0030197873711586, 0.004122346639633179, 0.014455776661634445, 0.02391328476369381, -0.03383632004261017, -0.011043968610465527, 0.038001541048288345, -0.01251404732465744, -0.02854403480887413, 0.01535007543861866, -0.024807583540678024, 0.005647553596645594, -0.015999360010027885, 0.008667340502142906, -0.05086473375558853, 0.005123837850987911, -0.0006293775513768196, -0.038932591676712036, -0.04197075590491295, -0.02355801686644554, -0.029254572466015816, -0.00019888178212568164, -0.01941729336977005, -0.012961196713149548, 0.03322378545999527, -0.029083063825964928, -0.02643692120909691, 0.020764866843819618, -0.03160670027136803, 0.030038613826036453, -0.04290180653333664, 0.0200298260897398, -0.023827530443668365, -0.011154224164783955, 0.0014264360070228577, 0.006449971813708544, 0.02668193355202675, -0.005923193413764238, 0.039667632430791855, -0.003228048561140895, 0.010161921381950378, -0.023165995255112648, 0.005760872270911932, -0.019086526706814766, 0.0037578893825411797, 0.05287384241819382, -0.00016902080096770078, 0.016820153221488, 0.019625555723905563, 0.0011530931806191802, 0.009267623536288738, -0.0012020958820357919, 0.021561158820986748, 0.0011952049098908901, 0.00369051075540483, -0.028495032340288162, -0.008428452536463737, 0.025383364409208298, -0.019490797072649002, -0.03643345832824707, 0.0011209351941943169, -0.002171429106965661, 0.03160670027136803, 0.010468187741935253, 0.037389010190963745, 0.02372952550649643, -0.0008299821056425571, 0.022724971175193787, 0.040721189230680466, 0.06233134865760803, -0.007178885862231255, 0.03530639782547951, 0.051991794258356094, -0.005540360230952501, 0.02004207670688629, -0.02194092981517315, -0.08051132410764694, -0.013941248878836632, -0.010737702250480652, 0.008005805313587189, -0.0020887372083961964, -0.03185171261429787, -0.011405362747609615, -0.0013835587305948138, -0.002110176021233201, 0.035649415105581284, -0.031190175563097, 0.006670483388006687, -0.005613864399492741, -0.009818903170526028, -0.0008070121402852237, -0.008900103159248829, 0.023165995255112648, 0.01155849639326334, -0.025799887254834175, 0.03163120150566101, 0.015705343335866928, -0.02447681501507759, 0.029989611357450485, 0.054588932543992996, -0.04253428429365158, -0.00773629080504179, 0.003684385446831584, 0.009837279096245766, 0.03094516322016716, 0.03351780027151108, 0.01451703067868948, 0.01817997731268406, 0.008207941427826881, 0.00645609712228179, -0.012985697947442532, -0.0017840021755546331, 0.013071452267467976, -0.03212122619152069, 0.01793496310710907, -0.024856586009263992, -0.01609736494719982, 0.033321790397167206, -0.05743108689785004, -0.002823776798322797, 0.0026859568897634745, -0.02680443972349167, 0.000495003187097609, 0.00025171274319291115, 0.015031557530164719, -0.026461422443389893, -0.008183440193533897, -0.012060773558914661, 0.008532583713531494, 0.031018666923046112, -0.0067256116308271885, 0.022639216855168343, -0.05208979919552803, -0.0136839859187603, -0.0009264560649171472, 0.026044899597764015, 0.025481369346380234, 0.005672054830938578, -0.006927747279405594, 0.007711789570748806, 0.0017227488569915295, 0.021242640912532806, -0.004505179822444916, -0.046748511493206024, -0.01868225447833538, -0.03221923112869263, -0.005469919182360172, 0.034056831151247025, -0.027171960100531578, 0.0004835181753151119, -0.00927987415343523, 0.040476176887750626, -0.027833495289087296, -0.0007476729806512594, -0.006106953136622906, 0.0012817251263186336, 0.02866654098033905, 0.003065727185457945, 0.006554102059453726, -0.005427041556686163, 0.01081733126193285, -0.0006619183695875108, 0.024084795266389847, -0.01044368650764227, 0.0033873070497065783, -0.014125009067356586, -0.00856933556497097, 0.002162241144105792, 0.007644410710781813, -0.001261817873455584, -0.017028415575623512, 0.008802098222076893, 0.03207222372293472, -0.0305041391402483, -0.024378810077905655, -0.005148339085280895, 0.016440384089946747, -0.03322378545999527, -0.003662946866825223, -0.029622092843055725, -0.0009103770717047155, 0.026730936020612717, -0.033615805208683014, -0.019809314981102943, 0.03503688424825668, 0.030871659517288208, 0.0016768089262768626, -0.017163172364234924, 0.01724892668426037, 0.0220144335180521, 0.029450582340359688, 0.033444296568632126, 0.003880396019667387, 0.036531463265419006, 0.010400809347629547, -0.018143225461244583, 0.012538548558950424, 0.046626005321741104, -0.021769419312477112, 0.02009107917547226, 0.009916908107697964, -0.009187993593513966, -0.006701109930872917, -0.027098456397652626, 0.017555193975567818, -0.027514979243278503, 0.015337823890149593, 0.012936695478856564, -0.013328716158866882, -0.040647685527801514, -0.04209326207637787, -0.005613864399492741, 0.014994805678725243, 0.003047351259738207, -0.0037609520368278027, -0.001736530801281333, -0.020997628569602966, 0.04420037567615509, 0.03062664531171322, 0.03802604228258133, -0.004520493093878031, 0.026485923677682877, 0.013304214924573898, -0.02626541256904602, -0.010762203484773636, -0.025383364409208298, 0.029548587277531624, -0.026240909472107887, -0.005978321190923452, -0.0034914377611130476, 0.011233854107558727, 0.008881727233529091, 0.026118403300642967, -0.03655596449971199, -0.03778102993965149, 0.024562569335103035, -0.03452235460281372, -0.03403232991695404, 0.00785879697650671, 0.017212174832820892, -0.02829902060329914, 0.013978001661598682, -0.008214066736400127, 0.02267596870660782, 0.007521904073655605, -0.01615861803293228, -0.0004777756694238633, -0.01059681922197342, -0.008483581244945526, 0.03148419037461281, -0.04782656952738762, 0.018032969906926155, -0.006609230302274227, 0.029254572466015816, 0.008618338033556938, -0.013169458135962486, -0.04603797569870949, 0.008244693279266357, -0.013206209987401962, 0.010713201016187668, 0.025799887254834175, 0.021830672398209572, 0.011301232501864433, 0.026951448991894722, 0.004593996796756983, -0.0018651627469807863, -0.014786544255912304, 0.002246464602649212, 0.01506831031292677, 0.008134436793625355, 0.026853444054722786, -0.014529281295835972, -0.010639696381986141, 0.007087005767971277, 0.012134277261793613, 0.04699352756142616, -0.0035312522668391466, 0.012489546090364456, -0.007448400370776653, -0.02687794528901577, 0.0325622484087944, 0.025971395894885063, -0.014468027278780937, -0.035894427448511124, -0.007454525679349899, 0.05444192513823509, 0.010210923850536346, 0.015668591484427452, -0.012367039918899536, 0.0458664633333683, -0.004713440779596567, 0.006621480919420719, -0.0012855534441769123, -0.018032969906926155, 0.023141494020819664, 0.056794051080942154, -0.015509333461523056, -0.033689308911561966, -0.004572558216750622, -0.04652800038456917, 0.04197075590491295, 0.03932461515069008, -0.035110387951135635, -0.01139923743903637, 0.002330687828361988, 0.006431595887988806, 0.0014363897498697042, -0.02146315388381481, 0.014566033147275448, 0.020948626101017, 0.033199284225702286, -0.03721750155091286, -0.021646913141012192, -0.014884550124406815, -0.003963088151067495, 0.023950036615133286, -0.007172760553658009, 0.015668591484427452, 0.018535247072577477, 0.0634584128856659, -0.01519081648439169, -0.011864762753248215, -0.004244853276759386, 0.007681163027882576, -0.03212122619152069, -0.00071857770672068, -0.016550639644265175, 0.007564781699329615, 0.014970304444432259, -0.0014785013627260923, -0.0011408425634726882, -0.010792830027639866, 0.01873125694692135, 0.05566699057817459, 0.004021278582513332, 0.015827851369976997, -0.0024838210083544254, 0.0019279473926872015, -0.0020672986283898354, -0.021744918078184128, -0.04699352756142616, -0.02100987918674946, -0.03518389165401459, 0.002274028491228819, -0.03542890399694443, 0.0470915324985981, 0.010811205953359604, 0.011595248244702816, -0.0016477135941386223, -0.04976217448711395, 0.007313643116503954, -0.010168046690523624, -0.019282536581158638, 0.046209484338760376, -0.029818102717399597, 0.046331990510225296, -0.004624623339623213, -0.008073183707892895, 0.01158912293612957, 0.056941062211990356, 0.002946283435449004, -0.007864922285079956, 0.01824123039841652, 0.031239179894328117, 0.005607739090919495, 0.02410929650068283, 0.019564300775527954, 0.005485232453793287, 0.06889770179986954, 0.01658739149570465, 0.01254467386752367, 0.009390129707753658, -0.017702201381325722, -0.009512635879218578, 0.01602386124432087, 0.004933952819555998, 0.0458664633333683, 0.03224373236298561, 0.0005673586274497211, -0.012538548558950424, -0.025064846500754356, -0.03731550648808479, -0.01392899826169014, 0.011135848239064217, -0.008618338033556938, -0.02016458287835121, -0.01775120384991169, -0.015925856307148933, -0.006468347739428282, -0.026534926146268845, 0.016979413107037544, -0.007687288336455822, 0.010186422616243362, -0.01174838189035654, -0.052481818944215775, -0.017408184707164764, -0.03437534719705582, 0.02047085016965866, 0.0013368531363084912, -0.00037287941086106, 0.009904657490551472, -0.01609736494719982, -0.013536977581679821, 0.013671734370291233, -0.03070015087723732, -0.020826119929552078, 0.005301472265273333, 0.009451382793486118, -0.003644570941105485, -0.036335453391075134, -0.007025752682238817, 0.0004471490392461419, 0.025848889723420143, -0.01603611186146736, 0.03280726447701454, 0.001615555607713759, 0.015448080375790596, -0.007178885862231255, -0.005482169799506664, 0.020728113129734993, 0.012324162758886814, 0.03224373236298561, -0.024084795266389847, 0.0299651101231575, 0.01078670471906662, 2.8305719752097502e-05, 0.0017824708484113216, -0.06409544497728348, -0.01764094829559326, -0.013451223261654377, -0.005479107145220041, 0.010688699781894684, 0.008716343902051449, -0.005243281833827496, 0.00738102151080966, -0.0023199685383588076, 0.022700469940900803, -0.0016660895198583603, -0.004116221331059933, 0.05066872388124466, -0.03025912679731846, 0.027147458866238594, 0.020078828558325768, -0.004051905125379562, 0.011013342067599297, -0.010872459039092064, -0.008587711490690708, -0.02896055579185486, -0.03770752623677254, 0.0034148709382861853, 0.024746330454945564, 0.019613303244113922, 0.032513245940208435, 0.002955471398308873, 0.017800206318497658, -0.028764545917510986, 0.020299341529607773, -0.025652877986431122, -0.010688699781894684, -0.04581746086478233, -0.041456226259469986, -0.018461741507053375, 0.018020719289779663, -0.026338916271924973, 0.035967934876680374, 0.019956322386860847, 0.0022372764069586992, 0.03488987684249878, -0.052187804132699966, -0.0016905908705666661, 0.043244823813438416, 0.03341979533433914, -0.004952328745275736, 0.015889104455709457, 0.040280163288116455, 0.004584808833897114, -0.00436429725959897, -0.018571998924016953, 0.022651467472314835, 0.021499905735254288, 0.023692773655056953, -0.0044623021967709064, -0.021781669929623604, 0.010498814284801483, -0.017040666192770004, 0.02088737301528454, -0.01044368650764227, -0.02039734646677971, -0.02236970141530037, 0.04258329048752785, 0.008900103159248829, -0.002466976409777999, 0.031288180500268936, 0.010590693913400173, 0.011454365216195583, -0.019466295838356018, 0.0090593621134758, -0.06434045732021332, 0.003687448101118207, -0.019441794604063034, -0.00908998865634203, 0.009218620136380196, 0.01127673126757145, -0.02619190700352192, 0.006033449433743954, 0.027196461334824562, 0.013818742707371712, 0.007497402839362621, -0.0020917998626828194, -0.026069400832057, 0.0011645782506093383, 0.016305625438690186, -0.04880662262439728, -0.014382272958755493, 0.06169431656599045, 0.007773042656481266, -0.03724200278520584, -0.012287409976124763, 0.03212122619152069, -0.010749952867627144, -0.012250658124685287, 0.015999360010027885, 0.015497082844376564, 0.00986178033053875, 0.09261497855186462, 0.002491477644070983, 0.002074955264106393, 0.016244372352957726, -0.03292977064847946, -0.020936375483870506, 0.013475724495947361, -0.038932591676712036, -0.01103784330189228, 0.0188047606498003, 0.038810085505247116, 0.005240219179540873, 0.029426081106066704, 0.03273376077413559, 0.03221923112869263, -0.015827851369976997, -0.032390739768743515, -0.026926947757601738, 0.02212468907237053, -0.01121547818183899, 0.007007376756519079, 0.02417054958641529, -0.0033628058154135942, -0.04057418182492256, -0.0028406213968992233, 0.0002633891417644918, -0.015105062164366245, 0.0035435028839856386, 0.009396255016326904, 0.048488106578588486, 0.03687448054552078, 0.03339529410004616, 0.014076006598770618, 0.0007798309670761228, -0.0005512796342372894, -0.01479879580438137, 0.001545879989862442, 0.011313483119010925, -0.019637806341052055, -0.030675647780299187, -0.006094702519476414, -0.0012120495084673166, -0.02187967672944069, 0.03542890399694443, 0.013794241473078728, 0.018535247072577477, -0.002805400872603059, -0.019160030409693718, 0.030063115060329437, 0.007834295742213726, 0.002854403341189027, 0.0009364096913486719, -0.03964313119649887, 0.0004777756694238633, 0.012379290536046028, -0.03903059661388397, -0.0071421340107917786, -0.003929398488253355, 0.0017640948062762618, 0.017591945827007294, -0.036825478076934814, -0.010633571073412895, 0.010860208421945572, 0.027906998991966248, 0.01670989766716957, -0.021377399563789368, -0.03802604228258133, 0.015239818952977657, 0.011295107193291187, -0.014223014004528522, -0.003647633595392108, 0.012948946096003056, -0.011288981884717941, -0.03724200278520584, -0.024023540318012238, 0.015742095187306404, -0.008116060867905617, -0.020666860044002533, -0.00034014717675745487, 0.027392473071813583, 0.00795680284500122, 0.03925111144781113, 0.04035366699099541, 0.006468347739428282, -0.004979892633855343, -0.04037817195057869, 0.01891501620411873, -0.006884870119392872, 0.004538869019597769, -0.029793601483106613, -0.012066898867487907, -0.007656661327928305, -0.023435508832335472, -0.006511224899441004, 0.0007044128724373877, 0.014235265552997589, -0.03866307809948921, -0.007019627373665571, 0.05404990538954735, -0.04054968059062958, 0.06566353142261505, -0.010633571073412895, -0.005157527048140764, -0.0063887182623147964, 0.009898532181978226, 0.015876853838562965, -0.00131694576703012, 0.019209032878279686, 0.002871248172596097, -0.006688859313726425, -0.022541211917996407, -0.01014354545623064, 0.035110387951135635, -0.028617538511753082, 0.022761723026633263, -0.048169590532779694, 0.01492130197584629, -0.013340966776013374, 0.007944552227854729, 0.0331747829914093, 0.0019432606641203165, 0.012385415844619274, 0.0212548915296793, 0.012195530347526073, 0.013414471410214901, -0.041652239859104156, -0.004094782751053572, 0.029867105185985565, -0.033811818808317184, -0.012654930353164673, 0.009169617667794228, -0.0018115660641342402, 0.029450582340359688, 0.011184850707650185, 0.03285626694560051, 0.018596500158309937, -0.015448080375790596, -0.01973581127822399, -0.0007939958013594151, 0.007019627373665571, 0.00038302448228932917, -0.021352898329496384, 0.004593996796756983, 0.013647233135998249, -0.010762203484773636, 0.004698127508163452, -0.014847798272967339, -0.02817651443183422, -0.01158912293612957, 0.022528961300849915, 0.012654930353164673, -0.0006557930610142648, 0.0037180748768150806, -0.029548587277531624, -0.051746781915426254, 0.030479637905955315, 0.007871047593653202, 0.010437561199069023, 0.0318027101457119, -0.02281072549521923, -0.04211776331067085, -0.010774454101920128, -0.03390982374548912, -0.0090593621134758, -0.021181387826800346, 0.007619909476488829, 0.01873125694692135, 0.016256622970104218, 0.039226606488227844, 0.0337873175740242, 0.006137579679489136, -0.004707315471023321, 0.0010305866599082947, 0.021095633506774902, 0.01492130197584629, 0.012036272324621677, 0.00025228699087165296, 0.03697248548269272, -0.03474286571145058, -0.0048175714910030365, 0.03711949661374092, -0.022663718089461327, -0.020189085975289345, -0.014835547655820847, -0.014174011535942554, 0.037756528705358505, 0.02016458287835121, -0.000625166401732713, 0.012250658124685287, -0.012177154421806335, 0.00943300686776638, -0.0007021158817224205, -0.024501316249370575, 0.045768458396196365, -0.023386506363749504, -0.013144956901669502, 0.01817997731268406, 0.002655330114066601, -0.007062504533678293, 0.04432288184762001, 0.02700045146048069, 0.037389010190963745, -0.016538389027118683, -0.012348663993179798, 0.023202747106552124, -0.015827851369976997, -0.027465976774692535, -0.02070361189544201, 0.0033260537311434746, -0.009328876622021198, 0.02311699278652668, 0.007681163027882576, -0.03809954598546028, 0.0030121307354420424, 0.012489546090364456, -0.007013502065092325, 0.025407865643501282, 0.030185623094439507, -0.00011427567369537428, 0.01242216769605875, 0.010198673233389854, 0.044102370738983154, -0.017395934090018272, 0.010462062433362007, -0.009610641747713089, 0.007031877990812063, 0.028152013197541237, 0.016538389027118683, 0.01984606683254242, -0.011239979416131973, 0.016415882855653763, 0.01187088806182146, 0.0006577072199434042, 0.0037823908496648073, 0.009488134644925594, -0.028691042214632034, -0.003249487141147256, 0.012526297941803932, 0.02152440696954727, -0.007687288336455822, -0.018768008798360825, 0.01297344733029604, -0.012618178501725197, -0.02655942738056183, 0.01297344733029604, 0.03876108303666115, 0.0012143464991822839, -0.005087085999548435, -0.03496338054537773, -0.01386774517595768, 0.004407174419611692, 0.01787371002137661, -0.007822045125067234, -0.012158778496086597, -0.032513245940208435, -0.013022449798882008, 0.016244372352957726, -0.012269034050405025, -0.0020734239369630814, -0.0067684887908399105, -0.002526698401197791, -0.020189085975289345, 0.016844654455780983, -0.024084795266389847, 0.036580465734004974, 0.0029661906883120537, 0.009665769524872303, 0.008655089884996414, 0.03998614847660065, -0.014529281295835972, -0.027588482946157455, 0.023386506363749504, 0.0168936587870121, -0.015166315250098705, 0.0008261537877842784, -0.004125409293919802, -0.01498255506157875, -0.019870568066835403, -0.003666009521111846, 0.002367439679801464, -0.0016063675284385681, 0.04253428429365158, 0.009965910576283932, 0.00403965450823307, -0.020066577941179276, -0.00801805593073368, -0.014615035615861416, 0.020752616226673126, 0.016305625438690186, 0.03491437807679176, -0.025309860706329346, -0.013083702884614468, -0.0004953860188834369, -0.003451623022556305, -0.01799621805548668, 0.0285930372774601, -0.01764094829559326, -0.017653198912739754, 0.003107073251157999, -0.01750619150698185, 0.0063519664108753204, -0.0428038015961647, -0.00728914188221097, -0.01565634086728096, 0.016428133472800255, -0.01590135507285595, 0.0363844558596611, 0.02386428229510784, 0.046331990510225296, -0.009800526313483715, -0.011227728798985481, 0.02009107917547226, -0.0067256116308271885, -0.011356360279023647, 0.02614290453493595, 0.007417773827910423, 0.003454685676842928, -0.010137420147657394, -0.0045296810567379, 0.0446413978934288, -0.017983967438340187, 0.02423180267214775, 0.006468347739428282, -0.02064235880970955, 0.02631441503763199, -0.04074569046497345, -0.027882497757673264, -0.0022479959297925234, 0.02311699278652668, 0.0034087456297129393, -0.006890995427966118, 0.006437721196562052, 0.014651787467300892, -0.0685056820511818, -0.010915336199104786, 0.004183599725365639, -0.010082291439175606, -0.01985831744968891, 0.03888358920812607, -0.010780579410493374, 0.021107884123921394, -0.005482169799506664, 0.0011278262827545404, 0.00927987415343523, -0.004548056982457638, 0.020740365609526634, 0.002194399246945977, 0.02010332979261875, 0.012220031581819057, 0.008532583713531494, -0.006664358079433441, -0.026338916271924973, -0.02651042491197586, -0.006253961008042097, 0.021303895860910416, 0.029548587277531624, -0.005411728285253048, 0.045768458396196365, -0.020568855106830597, 0.016685396432876587, -0.050815731287002563, -0.04214226454496384, 0.04799807816743851, -0.008697967045009136, -0.0014471090398728848, -0.021855175495147705, -0.005087085999548435, 0.00875922106206417, 0.025358863174915314, -0.00863058865070343, -0.020446348935365677, 0.009512635879218578, -0.01608511433005333, -0.0048145088367164135, 0.04513142630457878, 0.04371035099029541, 0.002096393844112754, -0.029989611357450485, 0.006091639865189791, -0.00042800739174708724, 0.02834802307188511, -0.020666860044002533, 0.02249220944941044, -0.003079509362578392, 0.04567045345902443, 0.021438652649521828, 0.0220144335180521, -0.038124047219753265, 0.01695491187274456]', 836, NOW()) ON CONFLICT (id) DO NOTHING;
 INSERT INTO embeddings (id, scope, combined, embeddings, n_tokens, created_at) VALUES (5, 'lelang', 'Stock No: BA00001123K14; ID Lelang: BEKASI-CAR-2311150404; Cabang: Caready Bekasi; Tanggal: 15/11/23; Bulan: November; Lot: 0    226
 1    227
 2    120
@@ -115,4 +116,6 @@ Name: odometer, dtype: int64; Grade: C; No Mesin: 1NRF437889; No Rangka: MHKV5EA
 2    31-Aug-24
 Name: stnk_exp_date, dtype: object; Faktur: Tidak Ada; Kwitansi Blank: Tidak Ada; FC KTP: Tidak Ada; Form A: Tidak Ada; Status Keur: Tidak Ada; Note 1: D 1167 AGX
 KM 139396
-Interior kotor,Bodi full repaint.jok kanan depan sobek.full Bodi baret penyok.bemper depan & belakang renggang,baret.ex ripaint.dop roda TA.dongkrak tool TA; Note 2: UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // BPKB MENYUSUL 14HK // AN PERUSAHAAN SPH TA // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI', '[-0.0038630005437880754, 0.011181256733834743, -0.010395972058176994, -0.004346253350377083, -0.02897099405527115, -0.015959417447447777, -0.04934009164571762, 0.02409014292061329, -0.011507452465593815, -0.05668552964925766, 0.06813861429691315, -0.04325110837817192, -0.006523909978568554, -0.018424006178975105, 0.03505997732281685, 0.06209795922040939, 0.015814442187547684, -0.003847898915410042, -0.04351689666509628, -0.013361934572458267, 0.014618391171097755, 0.0208040252327919, -0.015705710276961327, -0.03556739166378975, 0.040351592004299164, -0.018532738089561462, -0.030275775119662285, -0.07616060972213745, 0.005409408826380968, -0.06915345042943954, 0.013241121545433998, -0.0330786406993866, 0.04991999268531799, -0.012045071460306644, -0.025588225573301315, 0.029019318521022797, 0.055139120668172836, -0.013265283778309822, 0.005204026587307453, -0.047213777899742126, -0.025249948725104332, -0.0279078371822834, 0.014183464460074902, 0.06398264318704605, 0.030034149065613747, 0.01655140146613121, -0.011060443706810474, -0.04528076946735382, 0.012045071460306644, 0.028125301003456116, 0.017771614715456963, 0.01458214782178402, 0.010492621921002865, 0.0630161389708519, -0.02270079031586647, 0.016829272732138634, 0.004358334466814995, 0.04090733453631401, 0.056782182306051254, 0.013192796148359776, -0.02834276482462883, -0.02631310373544693, -0.005786949768662453, 0.055767349898815155, 0.006403096951544285, -0.03344108164310455, -0.01641850732266903, 0.029260944575071335, -0.035156626254320145, -0.0048355464823544025, 0.0053399414755403996, 0.06765536218881607, -0.0018937462009489536, -0.0025642591062933207, -0.005687279161065817, 0.004098586272448301, 0.03462504968047142, -0.05847356468439102, 0.009882516227662563, 0.014159301295876503, -0.029309270903468132, -0.004038179758936167, -0.058231938630342484, -0.00039604061748832464, 0.00548491720110178, -0.046947989612817764, -0.07770702242851257, -0.02193966880440712, -0.04129393398761749, -0.048929326236248016, -0.01947508007287979, -0.009604645892977715, -0.007345439400523901, 0.017288362607359886, 0.02802865207195282, 0.00244042556732893, -0.036485571414232254, -0.03278869017958641, 0.0016762824961915612, 0.03822528198361397, 0.018871014937758446, 0.043782684952020645, 0.021891342476010323, 0.0036666791420429945, -0.0003754646168090403, 0.024959996342658997, -0.005176843609660864, -0.0020296608563512564, -0.021323520690202713, 0.029212620109319687, -0.062436237931251526, -0.004282826092094183, 0.018689794465899467, 0.05523577332496643, 0.007653512991964817, -0.0030384508427232504, -0.006747414357960224, 0.005252351984381676, -0.04044824466109276, -0.050644874572753906, -0.001988886622712016, 0.04484584182500839, 0.00407442357391119, -0.0680902898311615, -0.0381527915596962, 0.029333433136343956, -0.013337772339582443, -0.021830936893820763, -0.021226869896054268, -0.005137579049915075, -0.018424006178975105, -0.03547074273228645, 0.002500832313671708, 0.013120308518409729, -0.010987956076860428, -0.06509412825107574, -0.008209253661334515, -0.025660714134573936, -0.03849107027053833, -0.04547407105565071, 0.01738501340150833, -0.014328439719974995, -0.007430008612573147, -0.008390473201870918, -0.06896014511585236, 0.020526153966784477, 0.001963213784620166, -0.00817904993891716, 0.01191821787506342, 0.022869929671287537, -0.007351480424404144, 0.040424082428216934, -0.06378934532403946, -0.08809695392847061, 0.028101138770580292, 0.0009340366814285517, -0.0020266405772417784, -0.004938237369060516, -0.03919178619980812, 0.027787024155259132, -0.024114305153489113, -0.004071403294801712, -0.007550822105258703, 0.00818509142845869, -0.012588730081915855, 0.01914888434112072, 0.005152680911123753, -0.023667296394705772, -0.07683716714382172, -0.009997288696467876, 0.05093482509255409, -0.014775448478758335, -0.020441586151719093, -0.028511904180049896, -0.019499242305755615, -0.02168596163392067, 0.03189467266201973, -0.02384851686656475, -0.0031139589846134186, 0.04310613125562668, -0.006215836852788925, -0.013180715031921864, 0.007206504698842764, 0.004590899683535099, -0.02074361778795719, -0.026337267830967903, -1.1768664080591407e-05, 0.04868770018219948, 0.008052196353673935, 0.03138725832104683, -0.015596977435052395, 0.017457500100135803, -0.007750163786113262, 0.04458005353808403, -0.018291112035512924, -0.05194965377449989, 0.0031079184263944626, 0.034649211913347244, -0.03803198039531708, -0.007611228618770838, 0.03518078848719597, -0.028874343261122704, 0.026917170733213425, 0.04117312282323837, -0.042453739792108536, 0.012504161335527897, -0.02232627011835575, -0.013229040428996086, 0.06267786026000977, -0.020949000492691994, 0.0934610515832901, 0.0033133006654679775, 0.0018272988963872194, 0.031991321593523026, 0.007877017371356487, 0.022531652823090553, -0.00313208089210093, 0.0205503161996603, -0.026772193610668182, 0.02674803137779236, -0.01871395669877529, -0.019040152430534363, -0.0013032718561589718, -0.0029282087925821543, -0.0039022648707032204, 0.013796862214803696, 0.018786445260047913, 0.027811188250780106, -0.001993417041376233, -0.05214295536279678, -0.028560228645801544, -0.018146134912967682, 0.03798365592956543, -0.015814442187547684, 0.0152466194704175, -0.029768360778689384, 0.030517403036355972, 0.020574480295181274, 0.011368517763912678, -0.008203213103115559, -0.01572987250983715, -0.0017895448254421353, 0.005820173770189285, 0.009459669701755047, 0.0558156743645668, -0.012117559090256691, 0.0021670858841389418, -0.01762663945555687, 0.00015252659795805812, 0.02099732495844364, -0.0065782759338617325, 0.019692543894052505, -0.012854519300162792, -0.01877436414361, 0.0029357594903558493, 0.004186175763607025, -0.034069307148456573, -0.007943464443087578, -0.01647891476750374, -0.03931260108947754, -0.021794691681861877, 0.001880154712125659, 0.005844336003065109, -0.025854013860225677, -0.016309775412082672, -0.02834276482462883, 0.0436377115547657, 0.03994082659482956, 0.004243561998009682, -0.03948173671960831, -0.03126644343137741, -0.02962338551878929, 0.05407596752047539, 0.02529827319085598, -0.036678873002529144, -0.03286117687821388, 0.01239542942494154, 0.04375852271914482, 0.009314694441854954, -0.002828537952154875, -0.010202670469880104, -0.008879766799509525, 0.0075991470366716385, 0.017095061019062996, 0.0011024200357496738, 0.01309614535421133, 0.01438884623348713, -0.014642554335296154, -0.02441633865237236, 0.018218623474240303, 0.0165393203496933, -0.03634059429168701, 0.009973125532269478, -0.02415054850280285, -0.005569486413151026, -0.015512408688664436, 0.026917170733213425, -0.05078984797000885, 0.024935834109783173, 0.00830590445548296, 0.07437257468700409, 0.006523909978568554, -0.017928671091794968, -0.04141474887728691, -0.0063668531365692616, 0.008191131986677647, -0.010462419129908085, -0.01580236107110977, -0.02384851686656475, -0.025080811232328415, 0.02068321220576763, 0.032426249235868454, 0.015198294073343277, -0.08205629140138626, 0.06151805818080902, -0.010057695209980011, 0.009876474738121033, -0.0036727197002619505, 0.0599716491997242, -0.029091807082295418, 0.019813356921076775, -0.004225439857691526, -0.002325653098523617, 0.006946756038814783, -0.03721044957637787, -0.04298532009124756, 0.0015886928886175156, -0.0053550428710877895, 0.018810607492923737, -0.00874687172472477, -0.023353181779384613, -0.0329819917678833, 0.03600231930613518, -0.026554731652140617, 0.016901759430766106, 0.01813405379652977, -0.03377935662865639, 0.015572815202176571, -0.007194423116743565, -0.032498739659786224, -0.026337267830967903, -0.008191131986677647, -0.004726814571768045, 0.006457462906837463, -0.027690375223755836, -0.015101644210517406, 0.03228127583861351, -0.00437645660713315, 0.004699631594121456, -0.02561238780617714, -0.02587817795574665, 0.006348730996251106, -0.0005119457491673529, 0.036098968237638474, -0.016406426206231117, -0.02744874730706215, 0.007877017371356487, 0.03264371305704117, -0.04230876639485359, 0.04107647016644478, 0.013845187611877918, 0.009133473969995975, 0.007176301442086697, 0.0426228791475296, 0.021927587687969208, 0.02079194411635399, -0.00976170226931572, 0.030227450653910637, 0.006535991560667753, 0.014823773875832558, 0.06262953579425812, 0.0022093704901635647, -0.019607974216341972, 0.013531072996556759, -0.003567008301615715, -0.033199455589056015, 0.039095137268304825, 0.03708963841199875, -0.01661180891096592, -0.02365521527826786, 0.05973002314567566, -0.025781527161598206, 0.01871395669877529, 0.005436591804027557, 0.01978919468820095, 0.008909969590604305, 0.005774868652224541, 0.061034806072711945, 0.00649974774569273, -0.06113145500421524, -0.008734790608286858, -0.022785359993577003, 0.029405921697616577, -0.04102814570069313, -0.007351480424404144, 0.00029334943974390626, 0.03786284103989601, 0.02865687943994999, -0.03141142055392265, 0.002111209789291024, -0.007206504698842764, -0.014823773875832558, -0.014413009397685528, -0.002114230068400502, -0.015645302832126617, 0.045184120535850525, 0.01220816932618618, 0.06557738035917282, -0.005164762027561665, -0.03841858357191086, 0.047141291201114655, -0.03411763533949852, 0.028101138770580292, 0.023377344012260437, 0.001969254342839122, -0.007647472433745861, -0.032885339111089706, 0.05726543441414833, -0.010867143049836159, 0.005808092188090086, 0.026264779269695282, -0.00494427839294076, -0.010885264724493027, -0.008360270410776138, -0.05789366364479065, -0.018556900322437286, 0.00705548794940114, -0.028511904180049896, -0.01819446124136448, -0.020767780020833015, -0.004367395304143429, 0.011404761113226414, -0.05605730414390564, 0.032692037522792816, -0.04808363318443298, 0.001055604894645512, 0.005092274397611618, 0.009308652952313423, -0.0279078371822834, -0.04470086470246315, 0.026168128475546837, -0.013204877264797688, 0.00783473253250122, 0.044362589716911316, 0.0034371342044323683, -0.029889173805713654, 0.023751866072416306, 0.050451572984457016, -0.007629350759088993, -0.011682631447911263, -0.034649211913347244, 0.004793261643499136, 0.007170260418206453, 0.04279201850295067, -0.04837358742952347, 0.012286697514355183, -0.013084064237773418, -0.013615641742944717, 0.016974247992038727, 0.010577191598713398, 0.002991635585203767, 0.03136309236288071, 0.03322361782193184, 0.018146134912967682, -0.0070856912061572075, -0.023884760215878487, -0.06896014511585236, -0.02104565128684044, 0.041438911110162735, 0.01864147000014782, -0.03428677096962929, 0.012075274251401424, -0.0032710160594433546, -0.0072850328870117664, -0.026168128475546837, 0.044483400881290436, -0.04646473750472069, -0.01662389002740383, -0.03136309236288071, -0.03977169096469879, -0.01622520573437214, -0.027980325743556023, -0.031870510429143906, -0.014630472287535667, -0.00855961162596941, 0.051079802215099335, 0.030589889734983444, -0.0023800190538167953, -0.05552572384476662, -0.016950085759162903, -0.01846024952828884, 0.028681041672825813, 0.0518530048429966, -0.011296030133962631, -0.025636550039052963, -0.013047819957137108, 0.034335099160671234, -0.019946251064538956, -0.015705710276961327, -0.004606001544743776, -0.03733126446604729, -0.02822195179760456, 0.0011832137824967504, -0.0334894061088562, -0.0005632913089357316, 0.015053318813443184, -0.007430008612573147, 0.02117854543030262, -0.01800115965306759, -0.02270079031586647, 0.02117854543030262, 0.02270079031586647, -0.02619229070842266, -0.02599899098277092, 0.014413009397685528, -0.01185781043022871, 0.020574480295181274, 0.034504234790802, -0.031218118965625763, -0.008734790608286858, 0.026651380583643913, 0.008656262420117855, 0.0568305067718029, -0.04622311145067215, 0.015331189148128033, -0.027980325743556023, 0.0014814712340012193, -0.12690213322639465, -0.008088440634310246, -0.013808943331241608, 0.037258777767419815, 0.0017819940112531185, 0.020646966993808746, 0.0036636588629335165, -0.0198375191539526, -0.017590394243597984, 0.043468572199344635, 0.02434385009109974, -0.022604141384363174, -0.022652465850114822, -0.013108227401971817, 0.010957753285765648, 0.013422341085970402, -0.032112136483192444, -0.0228336863219738, -0.023389426991343498, -0.005315778777003288, 0.016720540821552277, 0.00017819939239416271, 0.028487741947174072, 0.05750706046819687, -0.002074965974316001, -0.008952254429459572, 0.030299939215183258, -0.0007022264180704951, 0.0011280927574262023, -0.0008562632137909532, 0.0015222457004711032, 0.0012874151580035686, -0.009810027666389942, 0.009212003089487553, 0.015935255214571953, 0.03158055618405342, 0.022205457091331482, 0.004358334466814995, -0.015222457237541676, -0.001426350325345993, 0.0019420713651925325, 0.00022105031530372798, -0.04395182430744171, -0.035349927842617035, -0.005530221853405237, -0.005001664627343416, 0.0053580631501972675, 0.01592317409813404, 0.0205503161996603, -0.06731709092855453, 0.01781993918120861, 0.020393259823322296, -0.05397931486368179, -0.01914888434112072, -0.0070796506479382515, 0.009218043647706509, 0.046440575271844864, -0.01411097589880228, 0.06050322577357292, -0.013084064237773418, 0.04032742977142334, 0.006173552013933659, 0.02174636721611023, -0.008287781849503517, -0.022604141384363174, -0.012147762812674046, 0.008088440634310246, 0.012939088977873325, -0.010722166858613491, 0.01769912615418434, 0.005140599794685841, -0.004430822562426329, -0.01699841022491455, 0.011906135827302933, 0.018037403002381325, -0.030662378296256065, 0.014884180389344692, 0.012576648965477943, -0.014002243988215923, 0.006517869420349598, 0.005789970513433218, -0.02416262961924076, 0.019873764365911484, 0.02479085884988308, 0.05992332473397255, 0.025394923985004425, 0.011018159799277782, -0.03428677096962929, 0.05407596752047539, -0.030469076707959175, -0.02720712125301361, 0.005883600562810898, 0.010728207416832447, -0.009079108014702797, 0.0006516359280794859, 0.021794691681861877, -0.00043605995597317815, 0.00015526378410868347, 0.01738501340150833, 0.022906173020601273, -0.03184634819626808, -0.009495913982391357, 0.03184634819626808, -0.014642554335296154, -0.03365854546427727, 0.02016371488571167, -0.005493978038430214, 0.004116707947105169, -0.008444839157164097, 0.012866600416600704, -0.05542907491326332, -0.007224626373499632, -0.0045214323326945305, -0.03271619975566864, -0.026409754529595375, -0.002145943697541952, -0.038781020790338516, 0.01992208883166313, -0.01738501340150833, -0.0043251109309494495, 0.028366928920149803, -0.03936092555522919, -0.021480578929185867, 0.008764994330704212, -0.052287932485342026, 0.018424006178975105, -0.03334442898631096, 0.01844816841185093, -0.019813356921076775, 0.00013695303641725332, 0.0039958949200809, 0.005783929489552975, 0.02061072364449501, -0.011006077751517296, 0.03044491447508335, -0.01055906992405653, 0.008299863897264004, -0.019040152430534363, 0.007224626373499632, -0.015754034742712975, 0.010480540804564953, 0.05223960801959038, 0.01239542942494154, 0.020622804760932922, 0.012975332327187061, 0.0027575602289289236, 0.0017155467066913843, 0.027279609814286232, 0.011749079450964928, 0.021069813519716263, 0.01164034754037857, -0.029381757602095604, -0.006632641889154911, 0.0027711517177522182, -0.01789242774248123, -0.03786284103989601, 0.002863271627575159, 0.01889517717063427, 0.03324778005480766, -0.005829234607517719, 0.042139627039432526, 0.027738699689507484, 0.007152138743549585, 0.020840268582105637, 0.03708963841199875, 0.045884836465120316, 0.010395972058176994, 0.03576069325208664, 0.06219461187720299, -0.0033857885282486677, 0.014195545576512814, -0.019946251064538956, -0.06475584954023361, -0.014364684000611305, -0.022217538207769394, 0.007707878947257996, -0.005370144732296467, -0.030734864994883537, -0.0075749848037958145, -0.0004081219376530498, -0.004216379020363092, 0.024815021082758904, -0.020501991733908653, 0.0012911905068904161, -0.010885264724493027, -0.008698547258973122, -0.006182612851262093, -0.009858353063464165, 0.024078061804175377, 0.0062581212259829044, -0.02662721835076809, 0.031169792637228966, 0.01370021142065525, -0.01825486682355404, 0.032426249235868454, 0.046827178448438644, -0.020115390419960022, -0.02009122632443905, 0.006125226616859436, 0.02213296853005886, 0.02814946509897709, 0.024766696617007256, 0.004627143498510122, 0.01731252484023571, 0.02213296853005886, 0.009876474738121033, -0.017783695831894875, 0.0053641037084162235, 0.028270278126001358, -0.035229116678237915, 0.013458585366606712, -0.012516242451965809, -0.009948963299393654, 0.015621140599250793, -0.04953339323401451, -0.005086233839392662, -0.002822497161105275, -0.018424006178975105, 0.006004413589835167, -0.005155701190233231, 0.01284243818372488, -0.02415054850280285, 0.0008487123996019363, 0.0033555852714926004, 0.008197172544896603, 0.028584390878677368, -0.007061528507620096, 0.01668429560959339, -0.045304931700229645, -0.010957753285765648, -0.007852855138480663, 0.014944586902856827, 0.023353181779384613, 0.005219127982854843, -0.019003909081220627, 0.008354229852557182, 0.017614558339118958, 0.023039067164063454, -0.013724373653531075, -0.04383101314306259, -0.018315274268388748, -0.04375852271914482, -0.006535991560667753, 0.016660133376717567, -0.022978661581873894, -0.0040170373395085335, -0.01997041329741478, 0.019354267045855522, -0.02674803137779236, -0.020695293322205544, -0.011888014152646065, -0.004572778008878231, 0.028366928920149803, 0.004989583045244217, 0.012697461992502213, -0.018858933821320534, 0.007756204344332218, 0.005956088192760944, 0.018158216029405594, -0.00259144208393991, 0.011169175617396832, 0.0004934461903758347, -0.020465748384594917, 0.002115740207955241, -0.007327317725867033, 0.007182342000305653, -0.021601391956210136, 0.0017457500798627734, 0.02885018102824688, -0.014328439719974995, -0.03641308471560478, -0.016696376726031303, 0.03254706412553787, -0.04537741839885712, 0.011712835170328617, -0.03317528963088989, 0.009302612394094467, 0.024017654359340668, -0.029381757602095604, -0.008922051638364792, 0.03786284103989601, 0.032377924770116806, 0.0012209679698571563, -0.02068321220576763, 0.014280114322900772, 0.015427839942276478, 0.02771453745663166, 0.026264779269695282, -0.014630472287535667, 0.038080304861068726, 0.02125103399157524, -0.02587817795574665, -0.006620560772716999, 0.047914497554302216, -0.030154962092638016, 0.017650801688432693, -0.0050288476049900055, -0.005197986029088497, 0.008173009380698204, -0.012733706273138523, 0.03525327891111374, -0.02332901954650879, -0.0016355080297216773, 0.025249948725104332, -0.025950664654374123, -0.03716212511062622, -0.0446525402367115, 0.014521741308271885, 0.032377924770116806, 0.010395972058176994, -0.007037366274744272, -0.0012209679698571563, -0.0315563939511776, 0.05765203759074211, 0.035035815089941025, 0.029720034450292587, -0.0021399029064923525, 0.020248284563422203, 0.014690878801047802, -0.034528397023677826, -0.015947336331009865, -0.03771786764264107, 0.043903499841690063, -0.02923678234219551, -0.0035972115583717823, 0.011422883719205856, 0.01585068553686142, 0.004053281154483557, 0.03264371305704117, -0.021347684785723686, -0.03660638630390167, 0.0134465042501688, -0.01825486682355404, -0.0279078371822834, 0.010553028434515, 0.019547568634152412, -0.032885339111089706, -0.008076359517872334, -0.000303920591250062, 0.016454750671982765, 0.00296596297994256, 0.0015418778639286757, -0.017300443723797798, -0.018871014937758446, -0.01565738394856453, 0.019426755607128143, -0.046706363558769226, 0.0019360308069735765, 0.0011205419432371855, 0.021710123866796494, 0.003346524201333523, -0.01971670612692833, -0.05364103987812996, 0.0012421102728694677, -0.029889173805713654, 0.01243167370557785, 0.015886928886175156, 0.009628808125853539, 0.011972582899034023, 0.012455835938453674, 0.0046422453597188, 0.0028013549745082855, -0.01579027809202671, 0.004690570756793022, 0.004575798287987709, 0.021263115108013153, 0.01825486682355404, -0.015512408688664436, -0.0019752951338887215, 0.005397327709943056, 0.00610408466309309, 0.04578818380832672, -0.002396630821749568, -0.000998973730020225, -0.004545595031231642, -0.025781527161598206, 0.03708963841199875, 0.029019318521022797, -0.016635971143841743, -0.04622311145067215, -0.019607974216341972, 0.050499897450208664, 0.012310859747231007, 0.013518991880118847, 0.009592563845217228, 0.02860855497419834, 0.010855061933398247, 0.02023620344698429, -0.01705881766974926, -0.019003909081220627, 0.026530567556619644, 0.061228103935718536, -0.012830357067286968, -0.028318602591753006, -0.0020085186697542667, -0.031121468171477318, 0.024440500885248184, 0.03783867880702019, -0.020381178706884384, 0.0036636588629335165, 0.007037366274744272, 0.01737293042242527, -0.0006421973812393844, -0.03194299712777138, 0.011730956844985485, 0.02206048183143139, 0.032619550824165344, -0.02244708314538002, -0.03361021727323532, -0.011579941026866436, 0.0014799610944464803, 0.025854013860225677, -0.01243167370557785, 0.006983000319451094, 0.008450879715383053, 0.07785199582576752, -0.02662721835076809, -0.01185781043022871, 0.003875081893056631, 0.00837235152721405, -0.025322437286376953, -0.0025974828749895096, -0.009381141513586044, 0.028632717207074165, 0.010172467678785324, 0.00871666893362999, -0.005690299440175295, -0.0053610834293067455, 0.024126386269927025, 0.04044824466109276, 0.011012119241058826, 0.030614051967859268, 0.012492080219089985, -0.006735333241522312, -2.505929114704486e-05, -0.022990742698311806, -0.03317528963088989, -0.019064314663410187, -0.02471837028861046, 0.005255372263491154, -0.032619550824165344, 0.0528678335249424, 0.018943501636385918, 0.0020039882510900497, 0.006152409594506025, -0.039070975035429, 0.012467917054891586, -0.003947569523006678, -0.0175058264285326, 0.046513061970472336, -0.023993492126464844, 0.04547407105565071, -0.0029221680015325546, -0.00852940883487463, 0.01946299895644188, 0.06712378561496735, -0.002949350979179144, 0.00012543803313747048, 0.008009911514818668, 0.04699631780385971, 0.003530764253810048, 0.018375679850578308, 0.009459669701755047, 0.010722166858613491, 0.07089316099882126, 0.012455835938453674, 0.027062145993113518, 0.002127821557223797, 0.006182612851262093, -0.013615641742944717, 0.03431093692779541, 0.014413009397685528, 0.036437246948480606, 0.011966542340815067, -0.010033532045781612, -0.007321277167648077, -0.014509659260511398, -0.034455910325050354, -0.01972878724336624, -0.007526659406721592, -0.007230666931718588, -0.034504234790802, -0.011978624388575554, -0.015029155649244785, -0.00950799509882927, -0.026168128475546837, 0.025974826887249947, -0.0018726038979366422, 0.02016371488571167, -0.003364646341651678, -0.05871519073843956, -0.01074633002281189, -0.036751359701156616, 0.01800115965306759, 0.00523422984406352, -0.00296596297994256, -0.005092274397611618, -0.0075991470366716385, -0.028270278126001358, 0.023860597983002663, -0.015041237697005272, -0.010420134291052818, 0.003098857356235385, 0.006397056393325329, 0.01647891476750374, -0.05929509550333023, 0.009375100955367088, 0.0006010454380884767, 0.036292269825935364, -0.01871395669877529, 0.02371562086045742, -0.008106562308967113, 0.0033948495984077454, -0.010891305282711983, -0.014461333863437176, 0.015270782634615898, 0.027062145993113518, 0.03677552193403244, -0.03177385777235031, 0.020646966993808746, 0.01655140146613121, -0.001973784761503339, -0.004898973274976015, -0.05866686627268791, -0.01220816932618618, -0.016285613179206848, -0.012316901236772537, 0.01138059888035059, 0.014086813665926456, -0.014352602884173393, 0.007417927496135235, -0.002278838073834777, 0.014666716568171978, -0.005451693665236235, 3.3105636248365045e-05, 0.03996498882770538, -0.028101138770580292, 0.030904004350304604, 0.025539901107549667, 0.003923407290130854, 0.02193966880440712, -0.003983813803642988, 0.005385246127843857, -0.03583317995071411, -0.03853939473628998, -0.020900676026940346, 0.01958381198346615, 0.014485497027635574, 0.030928166583180428, 0.011869892477989197, 0.016382263973355293, -0.018677713349461555, 0.016152719035744667, -0.014364684000611305, -0.01208131480962038, -0.052481234073638916, -0.03735542669892311, -0.022857848554849625, 0.026868844404816628, -0.01871395669877529, 0.03264371305704117, 0.01058927271515131, -0.0036727197002619505, 0.030082475394010544, -0.056588880717754364, 0.00950799509882927, 0.03443174809217453, 0.0274004228413105, -0.008613977581262589, 0.0031955079175531864, 0.036944661289453506, 0.012588730081915855, -0.0013863309286534786, -0.017107142135500908, 0.018858933821320534, 0.017070898786187172, 0.020067064091563225, -0.002706214552745223, -0.01820654235780239, 0.001807666732929647, -0.024839183315634727, 0.0072608706541359425, -0.007115894462913275, -0.01077653281390667, -0.02200007438659668, 0.035736531019210815, -0.0021836976520717144, 0.0009144045179709792, 0.025201624259352684, 0.015198294073343277, 0.009646929800510406, -0.018146134912967682, 0.02834276482462883, -0.054510895162820816, 0.0032861176878213882, -0.02353440225124359, -0.008988498710095882, -0.0035579472314566374, 0.00783473253250122, -0.027279609814286232, 0.0030022067949175835, 0.03375519439578056, 0.017324605956673622, 0.019994577392935753, 0.005098314955830574, -0.03532576560974121, -0.005977230612188578, 0.006825943011790514, -0.04134226217865944, -0.0037391670048236847, 0.05214295536279678, 0.0017019552178680897, -0.04148723557591438, -0.010613434948027134, 0.028632717207074165, -0.011229582130908966, -0.016599727794528008, 0.006082942243665457, 0.011338314041495323, 0.010963793843984604, 0.08751704543828964, 0.014884180389344692, 0.004403639584779739, 0.019873764365911484, -0.036171458661556244, -0.02364313416182995, 0.00036621486651711166, -0.021021489053964615, -0.014545903541147709, 0.004672448616474867, 0.02009122632443905, 0.0023845494724810123, 0.03614729642868042, 0.014509659260511398, 0.03341691941022873, -0.01922137290239334, -0.0274004228413105, -0.04361354932188988, 0.013458585366606712, -0.03600231930613518, 0.006391015835106373, 0.021154383197426796, -0.008299863897264004, -0.045812346041202545, 0.008154887706041336, 0.008191131986677647, -0.004557676147669554, -0.02099732495844364, 0.01592317409813404, 0.048349425196647644, 0.04305780678987503, 0.029188457876443863, 0.02155306562781334, 0.002547647338360548, 0.006650764029473066, -0.010341606102883816, -0.014860017225146294, -0.011054403148591518, 0.005789970513433218, -0.040158290416002274, 0.002894985256716609, -0.009344897232949734, -0.011453086510300636, 0.033513568341732025, 0.024633802473545074, 0.008867685683071613, -0.01223837211728096, -0.023570645600557327, 0.011658469215035439, -0.0013893512077629566, 0.02137184701859951, 0.006590357515960932, -0.04508746787905693, -0.00729107391089201, 0.0017140365671366453, -0.0274004228413105, -0.010009369812905788, 0.01825486682355404, -0.015476164408028126, 0.0029236783739179373, -0.03774202987551689, -0.01846024952828884, 0.016829272732138634, 0.021468497812747955, 0.01604398712515831, -0.006632641889154911, -0.0371379628777504, 0.014171382412314415, -0.002232023049145937, -0.00020236203272361308, -0.010069776326417923, 0.018170297145843506, -0.004143890924751759, -0.032571226358413696, -0.014509659260511398, 0.02022412233054638, -0.0007063794182613492, -0.01877436414361, 0.014799610711634159, 0.016273532062768936, -0.0024223036598414183, 0.03228127583861351, 0.046585552394390106, 0.020876511931419373, -0.010063735768198967, -0.04692382737994194, 0.017010491341352463, 0.012878681533038616, 0.008734790608286858, -0.03100065514445305, -0.0073998053558170795, -0.005074152257293463, -0.029188457876443863, -0.0007951015722937882, 0.012794112786650658, 0.009332816116511822, -0.04153556004166603, 0.004941258113831282, 0.04332359507679939, -0.02923678234219551, 0.05146640166640282, -0.009659011848270893, -0.024138467386364937, -0.01325320266187191, 0.012951170094311237, 0.028560228645801544, 0.005805071908980608, 0.007375643122941256, 0.006662845145910978, -0.02872936800122261, -0.026772193610668182, -0.016213124617934227, 0.03037242591381073, -0.0284635778516531, 0.01965630054473877, -0.05383434146642685, 0.017010491341352463, -0.01566946506500244, 0.00588058028370142, 0.028391091153025627, 0.0035156626254320145, 0.007025284692645073, 0.01698632910847664, -0.0011998256668448448, 0.005614791065454483, -0.05484917014837265, 0.009381141513586044, 0.021081894636154175, -0.034842513501644135, -0.0016898739850148559, -0.018109891563653946, -0.006010454148054123, 0.04013412818312645, 0.003346524201333523, 0.033634383231401443, 0.029889173805713654, -0.019233454018831253, -0.003878102172166109, 0.014594228938221931, -0.009900637902319431, -0.015355351381003857, -0.03158055618405342, 0.02428344264626503, 0.01889517717063427, -0.011265826411545277, -0.006185633596032858, 0.0068863495253026485, -0.03339275345206261, -0.003292158478870988, 0.01926969736814499, -0.008094481192529202, -0.005774868652224541, -0.0014127588365226984, -0.02498416043817997, -0.04610230028629303, 0.013639804907143116, -0.004146911203861237, 6.380444392561913e-05, 0.014702960848808289, -0.006164491176605225, -0.03817695751786232, -0.026530567556619644, -0.03380351886153221, -0.005913803819566965, -0.017131304368376732, 0.00871666893362999, 0.008450879715383053, 0.015947336331009865, 0.034214284271001816, 0.03068654052913189, 0.01622520573437214, -0.00016224828141275793, 0.0006263406830839813, 0.01325320266187191, 0.019426755607128143, 0.005503038875758648, -0.00076489825733006, 0.04242957755923271, -0.013506910763680935, 0.0026760112959891558, 0.031483907252550125, -0.010353687219321728, -0.02143225260078907, -0.013229040428996086, 0.00593192595988512, 0.03571236878633499, 0.02098524384200573, 0.004071403294801712, -0.0006437075790017843, -0.022350432351231575, -0.00015601885388605297, -0.01024495530873537, -0.01579027809202671, 0.04849439859390259, -0.029889173805713654, -0.009586523286998272, 0.027376260608434677, 0.016116473823785782, -0.003298199037089944, 0.026868844404816628, 0.019426755607128143, 0.03498748689889908, -0.007321277167648077, -0.024815021082758904, 0.012612893246114254, -0.0192817784845829, -0.04095565900206566, -0.023425670340657234, -0.0020945980213582516, -0.012214209884405136, 0.007182342000305653, 0.0008124684682115912, -0.038394421339035034, -0.011024200357496738, 0.006013474427163601, -0.0005923619610257447, 0.01952340453863144, 0.03184634819626808, 0.007478334009647369, 0.013736455701291561, 0.010510744526982307, 0.033948495984077454, -0.016756784170866013, -0.00664472347125411, -0.02314779907464981, 0.025636550039052963, 0.043081969022750854, 0.010710085742175579, 0.03119395487010479, -0.007194423116743565, 0.024114305153489113, 0.009906678460538387, 0.0020206000190228224, -0.0033888088073581457, 0.001276843948289752, -0.032426249235868454, -0.005968169774860144, -0.003458276391029358, 0.017783695831894875, -0.013651886023581028, -0.025757363066077232, 0.002392100403085351, -0.010861102491617203, -0.03344108164310455, 0.017723290249705315, 0.027980325743556023, 0.0017986057791858912, -0.0004039689665660262, -0.029647547751665115, -0.023256530985236168, 0.006656804587692022, 0.019994577392935753, -0.011881973594427109, -0.008414636366069317, -0.03259538859128952, -0.007798488717526197, 0.013011576607823372, -0.017034653574228287, -0.00698904087767005, 0.0062581212259829044, 0.005110396537929773, -0.01643058843910694, 0.018556900322437286, -0.021456414833664894, 0.028705205768346786, -0.002283368492498994, -0.005602709949016571, 0.017203792929649353, 0.036485571414232254, -0.007049447391182184, -0.028560228645801544, 0.016019823029637337, 0.0253707617521286, 0.0011379088973626494, -0.0028798833955079317, 0.003476398531347513, -0.014896261505782604, -0.017070898786187172, -0.009369059465825558, 4.009014446637593e-05, 0.0004496514447964728, 0.05504247173666954, 0.003838837845250964, 0.0015146948862820864, -0.025153297930955887, -0.00878311600536108, -0.006203755270689726, 0.03481835126876831, 0.028801854699850082, 0.02701382152736187, -0.01255248673260212, -0.017904508858919144, -0.010220793075859547, 0.007152138743549585, 0.0023830393329262733, 0.03689633682370186, -0.023051150143146515, -0.02326861396431923, 0.0035126423463225365, -0.011386639438569546, 0.0259265024214983, -0.05765203759074211, -0.004859709180891514, -0.01679302752017975, 0.016841353848576546, -0.005095294676721096, 0.034069307148456573, 0.04407263919711113, 0.051079802215099335, 0.00817904993891716, -0.008535449393093586, 0.029212620109319687, -0.00278927362523973, -0.0152466194704175, 0.009828150272369385, 0.011326232925057411, 0.0024268340785056353, -0.009556320495903492, -0.008287781849503517, 0.04726210609078407, -0.015874847769737244, 0.04013412818312645, 0.017457500100135803, -0.02162555418908596, 0.012383348308503628, -0.03853939473628998, -0.01902807131409645, 0.01635809987783432, 0.022555815055966377, -0.0014814712340012193, 0.010124142281711102, 0.009091190062463284, 0.010202670469880104, -0.058811843395233154, -0.00945362914353609, -0.007683716248720884, -0.03416595980525017, -0.014074732549488544, 0.03266787528991699, -0.007514577824622393, 0.03467337414622307, 0.00032072115573100746, -0.006324568297713995, 0.0007830202230252326, 0.005056030582636595, 0.022350432351231575, 0.015355351381003857, 0.008686465211212635, 0.017844103276729584, 0.017155468463897705, -0.00570842158049345, -0.0053278598934412, -0.014171382412314415, 0.021420171484351158, 0.006191674154251814, 0.022809522226452827, -0.0100214509293437, 0.054317593574523926, -0.02669970691204071, 0.01442509051412344, -0.03841858357191086, -0.05281950905919075, 0.03936092555522919, -0.014243870973587036, -0.0077864076010882854, 0.0005727298557758331, -0.010842980816960335, 0.0038871632423251867, 0.02093691937625408, -0.00642121909186244, -0.02383643575012684, 0.0027545399498194456, -0.009290531277656555, -0.014847936108708382, 0.037065476179122925, 0.041825514286756516, 0.00019179088121745735, -0.0324745737016201, -0.0024132428225129843, 0.0026518485974520445, 0.03327194228768349, -0.016140637919306755, 0.011368517763912678, 0.0067534553818404675, 0.0339968204498291, 0.01788034662604332, 0.03462504968047142, -0.03950589895248413, 0.011362477205693722]', 753, NOW());
\ No newline at end of file
+Interior kotor,Bodi full repaint.jok kanan depan sobek.full Bodi baret penyok.bemper depan & belakang renggang,baret.ex ripaint.dop roda TA.dongkrak tool TA; Note 2: UNIT BERADA DI BEKASI // PIC MUCHTAR 081295630707 / 087724219292 / SATRINAL 082388227234 // BPKB MENYUSUL 14HK // AN PERUSAHAAN SPH TA // PESERTA LELANG WAJIB CEK SAMSAT ONLINE DAN CEK UNIT DI LOKASI', '[-0.0038630005437880754, 0.011181256733834743, -0.010395972058176994, -0.004346253350377083, -0.02897099405527115, -0.015959417447447777, -0.04934009164571762, 0.02409014292061329, -0.011507452465593815, -0.05668552964925766, 0.06813861429691315, -0.04325110837817192, -0.006523909978568554, -0.018424006178975105, 0.03505997732281685, 0.06209795922040939, 0.015814442187547684, -0.003847898915410042, -0.04351689666509628, -0.013361934572458267, 0.014618391171097755, 0.0208040252327919, -0.015705710276961327, -0.03556739166378975, 0.040351592004299164, -0.018532738089561462, -0.030275775119662285, -0.07616060972213745, 0.005409408826380968, -0.06915345042943954, 0.013241121545433998, -0.0330786406993866, 0.04991999268531799, -0.012045071460306644, -0.025588225573301315, 0.029019318521022797, 0.055139120668172836, -0.013265283778309822, 0.005204026587307453, -0.047213777899742126, -0.025249948725104332, -0.0279078371822834, 0.014183464460074902, 0.06398264318704605, 0.030034149065613747, 0.01655140146613121, -0.011060443706810474, -0.04528076946735382, 0.012045071460306644, 0.028125301003456116, 0.017771614715456963, 0.01458214782178402, 0.010492621921002865, 0.0630161389708519, -0.02270079031586647, 0.016829272732138634, 0.004358334466814995, 0.04090733453631401, 0.056782182306051254, 0.013192796148359776, -0.02834276482462883, -0.02631310373544693, -0.005786949768662453, 0.055767349898815155, 0.006403096951544285, -0.03344108164310455, -0.01641850732266903, 0.029260944575071335, -0.035156626254320145, -0.0048355464823544025, 0.0053399414755403996, 0.06765536218881607, -0.0018937462009489536, -0.0025642591062933207, -0.005687279161065817, 0.004098586272448301, 0.03462504968047142, -0.05847356468439102, 0.009882516227662563, 0.014159301295876503, -0.029309270903468132, -0.004038179758936167, -0.058231938630342484, -0.00039604061748832464, 0.00548491720110178, -0.046947989612817764, -0.07770702242851257, -0.02193966880440712, -0.04129393398761749, -0.048929326236248016, -0.01947508007287979, -0.009604645892977715, -0.007345439400523901, 0.017288362607359886, 0.02802865207195282, 0.00244042556732893, -0.036485571414232254, -0.03278869017958641, 0.0016762824961915612, 0.03822528198361397, 0.018871014937758446, 0.043782684952020645, 0.021891342476010323, 0.0036666791420429945, -0.0003754646168090403, 0.024959996342658997, -0.005176843609660864, -0.0020296608563512564, -0.021323520690202713, 0.029212620109319687, -0.062436237931251526, -0.004282826092094183, 0.018689794465899467, 0.05523577332496643, 0.007653512991964817, -0.0030384508427232504, -0.006747414357960224, 0.005252351984381676, -0.04044824466109276, -0.050644874572753906, -0.001988886622712016, 0.04484584182500839, 0.00407442357391119, -0.0680902898311615, -0.0381527915596962, 0.029333433136343956, -0.013337772339582443, -0.021830936893820763, -0.021226869896054268, -0.005137579049915075, -0.018424006178975105, -0.03547074273228645, 0.002500832313671708, 0.013120308518409729, -0.010987956076860428, -0.06509412825107574, -0.008209253661334515, -0.025660714134573936, -0.03849107027053833, -0.04547407105565071, 0.01738501340150833, -0.014328439719974995, -0.007430008612573147, -0.008390473201870918, -0.06896014511585236, 0.020526153966784477, 0.001963213784620166, -0.00817904993891716, 0.01191821787506342, 0.022869929671287537, -0.007351480424404144, 0.040424082428216934, -0.06378934532403946, -0.08809695392847061, 0.028101138770580292, 0.0009340366814285517, -0.0020266405772417784, -0.004938237369060516, -0.03919178619980812, 0.027787024155259132, -0.024114305153489113, -0.004071403294801712, -0.007550822105258703, 0.00818509142845869, -0.012588730081915855, 0.01914888434112072, 0.005152680911123753, -0.023667296394705772, -0.07683716714382172, -0.009997288696467876, 0.05093482509255409, -0.014775448478758335, -0.020441586151719093, -0.028511904180049896, -0.019499242305755615, -0.02168596163392067, 0.03189467266201973, -0.02384851686656475, -0.0031139589846134186, 0.04310613125562668, -0.006215836852788925, -0.013180715031921864, 0.007206504698842764, 0.004590899683535099, -0.02074361778795719, -0.026337267830967903, -1.1768664080591407e-05, 0.04868770018219948, 0.008052196353673935, 0.03138725832104683, -0.015596977435052395, 0.017457500100135803, -0.007750163786113262, 0.04458005353808403, -0.018291112035512924, -0.05194965377449989, 0.0031079184263944626, 0.034649211913347244, -0.03803198039531708, -0.007611228618770838, 0.03518078848719597, -0.028874343261122704, 0.026917170733213425, 0.04117312282323837, -0.042453739792108536, 0.012504161335527897, -0.02232627011835575, -0.013229040428996086, 0.06267786026000977, -0.020949000492691994, 0.0934610515832901, 0.0033133006654679775, 0.0018272988963872194, 0.031991321593523026, 0.007877017371356487, 0.022531652823090553, -0.00313208089210093, 0.0205503161996603, -0.026772193610668182, 0.02674803137779236, -0.01871395669877529, -0.019040152430534363, -0.0013032718561589718, -0.0029282087925821543, -0.0039022648707032204, 0.013796862214803696, 0.018786445260047913, 0.027811188250780106, -0.001993417041376233, -0.05214295536279678, -0.028560228645801544, -0.018146134912967682, 0.03798365592956543, -0.015814442187547684, 0.0152466194704175, -0.029768360778689384, 0.030517403036355972, 0.020574480295181274, 0.011368517763912678, -0.008203213103115559, -0.01572987250983715, -0.0017895448254421353, 0.005820173770189285, 0.009459669701755047, 0.0558156743645668, -0.012117559090256691, 0.0021670858841389418, -0.01762663945555687, 0.00015252659795805812, 0.02099732495844364, -0.0065782759338617325, 0.019692543894052505, -0.012854519300162792, -0.01877436414361, 0.0029357594903558493, 0.004186175763607025, -0.034069307148456573, -0.007943464443087578, -0.01647891476750374, -0.03931260108947754, -0.021794691681861877, 0.001880154712125659, 0.005844336003065109, -0.025854013860225677, -0.016309775412082672, -0.02834276482462883, 0.0436377115547657, 0.03994082659482956, 0.004243561998009682, -0.03948173671960831, -0.03126644343137741, -0.02962338551878929, 0.05407596752047539, 0.02529827319085598, -0.036678873002529144, -0.03286117687821388, 0.01239542942494154, 0.04375852271914482, 0.009314694441854954, -0.002828537952154875, -0.010202670469880104, -0.008879766799509525, 0.0075991470366716385, 0.017095061019062996, 0.0011024200357496738, 0.01309614535421133, 0.01438884623348713, -0.014642554335296154, -0.02441633865237236, 0.018218623474240303, 0.0165393203496933, -0.03634059429168701, 0.009973125532269478, -0.02415054850280285, -0.005569486413151026, -0.015512408688664436, 0.026917170733213425, -0.05078984797000885, 0.024935834109783173, 0.00830590445548296, 0.07437257468700409, 0.006523909978568554, -0.017928671091794968, -0.04141474887728691, -0.0063668531365692616, 0.008191131986677647, -0.010462419129908085, -0.01580236107110977, -0.02384851686656475, -0.025080811232328415, 0.02068321220576763, 0.032426249235868454, 0.015198294073343277, -0.08205629140138626, 0.06151805818080902, -0.010057695209980011, 0.009876474738121033, -0.0036727197002619505, 0.0599716491997242, -0.029091807082295418, 0.019813356921076775, -0.004225439857691526, -0.002325653098523617, 0.006946756038814783, -0.03721044957637787, -0.04298532009124756, 0.0015886928886175156, -0.0053550428710877895, 0.018810607492923737, -0.00874687172472477, -0.023353181779384613, -0.0329819917678833, 0.03600231930613518, -0.026554731652140617, 0.016901759430766106, 0.01813405379652977, -0.03377935662865639, 0.015572815202176571, -0.007194423116743565, -0.032498739659786224, -0.026337267830967903, -0.008191131986677647, -0.004726814571768045, 0.006457462906837463, -0.027690375223755836, -0.015101644210517406, 0.03228127583861351, -0.00437645660713315, 0.004699631594121456, -0.02561238780617714, -0.02587817795574665, 0.006348730996251106, -0.0005119457491673529, 0.036098968237638474, -0.016406426206231117, -0.02744874730706215, 0.007877017371356487, 0.03264371305704117, -0.04230876639485359, 0.04107647016644478, 0.013845187611877918, 0.009133473969995975, 0.007176301442086697, 0.0426228791475296, 0.021927587687969208, 0.02079194411635399, -0.00976170226931572, 0.030227450653910637, 0.006535991560667753, 0.014823773875832558, 0.06262953579425812, 0.0022093704901635647, -0.019607974216341972, 0.013531072996556759, -0.003567008301615715, -0.033199455589056015, 0.039095137268304825, 0.03708963841199875, -0.01661180891096592, -0.02365521527826786, 0.05973002314567566, -0.025781527161598206, 0.01871395669877529, 0.005436591804027557, 0.01978919468820095, 0.008909969590604305, 0.005774868652224541, 0.061034806072711945, 0.00649974774569273, -0.06113145500421524, -0.008734790608286858, -0.022785359993577003, 0.029405921697616577, -0.04102814570069313, -0.007351480424404144, 0.00029334943974390626, 0.03786284103989601, 0.02865687943994999, -0.03141142055392265, 0.002111209789291024, -0.007206504698842764, -0.014823773875832558, -0.014413009397685528, -0.002114230068400502, -0.015645302832126617, 0.045184120535850525, 0.01220816932618618, 0.06557738035917282, -0.005164762027561665, -0.03841858357191086, 0.047141291201114655, -0.03411763533949852, 0.028101138770580292, 0.023377344012260437, 0.001969254342839122, -0.007647472433745861, -0.032885339111089706, 0.05726543441414833, -0.010867143049836159, 0.005808092188090086, 0.026264779269695282, -0.00494427839294076, -0.010885264724493027, -0.008360270410776138, -0.05789366364479065, -0.018556900322437286, 0.00705548794940114, -0.028511904180049896, -0.01819446124136448, -0.020767780020833015, -0.004367395304143429, 0.011404761113226414, -0.05605730414390564, 0.032692037522792816, -0.04808363318443298, 0.001055604894645512, 0.005092274397611618, 0.009308652952313423, -0.0279078371822834, -0.04470086470246315, 0.026168128475546837, -0.013204877264797688, 0.00783473253250122, 0.044362589716911316, 0.0034371342044323683, -0.029889173805713654, 0.023751866072416306, 0.050451572984457016, -0.007629350759088993, -0.011682631447911263, -0.034649211913347244, 0.004793261643499136, 0.007170260418206453, 0.04279201850295067, -0.04837358742952347, 0.012286697514355183, -0.013084064237773418, -0.013615641742944717, 0.016974247992038727, 0.010577191598713398, 0.002991635585203767, 0.03136309236288071, 0.03322361782193184, 0.018146134912967682, -0.0070856912061572075, -0.023884760215878487, -0.06896014511585236, -0.02104565128684044, 0.041438911110162735, 0.01864147000014782, -0.03428677096962929, 0.012075274251401424, -0.0032710160594433546, -0.0072850328870117664, -0.026168128475546837, 0.044483400881290436, -0.04646473750472069, -0.01662389002740383, -0.03136309236288071, -0.03977169096469879, -0.01622520573437214, -0.027980325743556023, -0.031870510429143906, -0.014630472287535667, -0.00855961162596941, 0.051079802215099335, 0.030589889734983444, -0.0023800190538167953, -0.05552572384476662, -0.016950085759162903, -0.01846024952828884, 0.028681041672825813, 0.0518530048429966, -0.011296030133962631, -0.025636550039052963, -0.013047819957137108, 0.034335099160671234, -0.019946251064538956, -0.015705710276961327, -0.004606001544743776, -0.03733126446604729, -0.02822195179760456, 0.0011832137824967504, -0.0334894061088562, -0.0005632913089357316, 0.015053318813443184, -0.007430008612573147, 0.02117854543030262, -0.01800115965306759, -0.02270079031586647, 0.02117854543030262, 0.02270079031586647, -0.02619229070842266, -0.02599899098277092, 0.014413009397685528, -0.01185781043022871, 0.020574480295181274, 0.034504234790802, -0.031218118965625763, -0.008734790608286858, 0.026651380583643913, 0.008656262420117855, 0.0568305067718029, -0.04622311145067215, 0.015331189148128033, -0.027980325743556023, 0.0014814712340012193, -0.12690213322639465, -0.008088440634310246, -0.013808943331241608, 0.037258777767419815, 0.0017819940112531185, 0.020646966993808746, 0.0036636588629335165, -0.0198375191539526, -0.017590394243597984, 0.043468572199344635, 0.02434385009109974, -0.022604141384363174, -0.022652465850114822, -0.013108227401971817, 0.010957753285765648, 0.013422341085970402, -0.032112136483192444, -0.0228336863219738, -0.023389426991343498, -0.005315778777003288, 0.016720540821552277, 0.00017819939239416271, 0.028487741947174072, 0.05750706046819687, -0.002074965974316001, -0.008952254429459572, 0.030299939215183258, -0.0007022264180704951, 0.0011280927574262023, -0.0008562632137909532, 0.0015222457004711032, 0.0012874151580035686, -0.009810027666389942, 0.009212003089487553, 0.015935255214571953, 0.03158055618405342, 0.022205457091331482, 0.004358334466814995, -0.015222457237541676, -0.001426350325345993, 0.0019420713651925325, 0.00022105031530372798, -0.04395182430744171, -0.035349927842617035, -0.005530221853405237, -0.005001664627343416, 0.0053580631501972675, 0.01592317409813404, 0.0205503161996603, -0.06731709092855453, 0.01781993918120861, 0.020393259823322296, -0.05397931486368179, -0.01914888434112072, -0.0070796506479382515, 0.009218043647706509, 0.046440575271844864, -0.01411097589880228, 0.06050322577357292, -0.013084064237773418, 0.04032742977142334, 0.006173552013933659, 0.02174636721611023, -0.008287781849503517, -0.022604141384363174, -0.012147762812674046, 0.008088440634310246, 0.012939088977873325, -0.010722166858613491, 0.01769912615418434, 0.005140599794685841, -0.004430822562426329, -0.01699841022491455, 0.011906135827302933, 0.018037403002381325, -0.030662378296256065, 0.014884180389344692, 0.012576648965477943, -0.014002243988215923, 0.006517869420349598, 0.005789970513433218, -0.02416262961924076, 0.019873764365911484, 0.02479085884988308, 0.05992332473397255, 0.025394923985004425, 0.011018159799277782, -0.03428677096962929, 0.05407596752047539, -0.030469076707959175, -0.02720712125301361, 0.005883600562810898, 0.010728207416832447, -0.009079108014702797, 0.0006516359280794859, 0.021794691681861877, -0.00043605995597317815, 0.00015526378410868347, 0.01738501340150833, 0.022906173020601273, -0.03184634819626808, -0.009495913982391357, 0.03184634819626808, -0.014642554335296154, -0.03365854546427727, 0.02016371488571167, -0.005493978038430214, 0.004116707947105169, -0.008444839157164097, 0.012866600416600704, -0.05542907491326332, -0.007224626373499632, -0.0045214323326945305, -0.03271619975566864, -0.026409754529595375, -0.002145943697541952, -0.038781020790338516, 0.01992208883166313, -0.01738501340150833, -0.0043251109309494495, 0.028366928920149803, -0.03936092555522919, -0.021480578929185867, 0.008764994330704212, -0.052287932485342026, 0.018424006178975105, -0.03334442898631096, 0.01844816841185093, -0.019813356921076775, 0.00013695303641725332, 0.0039958949200809, 0.005783929489552975, 0.02061072364449501, -0.011006077751517296, 0.03044491447508335, -0.01055906992405653, 0.008299863897264004, -0.019040152430534363, 0.007224626373499632, -0.015754034742712975, 0.010480540804564953, 0.05223960801959038, 0.01239542942494154, 0.020622804760932922, 0.012975332327187061, 0.0027575602289289236, 0.0017155467066913843, 0.027279609814286232, 0.011749079450964928, 0.021069813519716263, 0.01164034754037857, -0.029381757602095604, -0.006632641889154911, 0.0027711517177522182, -0.01789242774248123, -0.03786284103989601, 0.002863271627575159, 0.01889517717063427, 0.03324778005480766, -0.005829234607517719, 0.042139627039432526, 0.027738699689507484, 0.007152138743549585, 0.020840268582105637, 0.03708963841199875, 0.045884836465120316, 0.010395972058176994, 0.03576069325208664, 0.06219461187720299, -0.0033857885282486677, 0.014195545576512814, -0.019946251064538956, -0.06475584954023361, -0.014364684000611305, -0.022217538207769394, 0.007707878947257996, -0.005370144732296467, -0.030734864994883537, -0.0075749848037958145, -0.0004081219376530498, -0.004216379020363092, 0.024815021082758904, -0.020501991733908653, 0.0012911905068904161, -0.010885264724493027, -0.008698547258973122, -0.006182612851262093, -0.009858353063464165, 0.024078061804175377, 0.0062581212259829044, -0.02662721835076809, 0.031169792637228966, 0.01370021142065525, -0.01825486682355404, 0.032426249235868454, 0.046827178448438644, -0.020115390419960022, -0.02009122632443905, 0.006125226616859436, 0.02213296853005886, 0.02814946509897709, 0.024766696617007256, 0.004627143498510122, 0.01731252484023571, 0.02213296853005886, 0.009876474738121033, -0.017783695831894875, 0.0053641037084162235, 0.028270278126001358, -0.035229116678237915, 0.013458585366606712, -0.012516242451965809, -0.009948963299393654, 0.015621140599250793, -0.04953339323401451, -0.005086233839392662, -0.002822497161105275, -0.018424006178975105, 0.006004413589835167, -0.005155701190233231, 0.01284243818372488, -0.02415054850280285, 0.0008487123996019363, 0.0033555852714926004, 0.008197172544896603, 0.028584390878677368, -0.007061528507620096, 0.01668429560959339, -0.045304931700229645, -0.010957753285765648, -0.007852855138480663, 0.014944586902856827, 0.023353181779384613, 0.005219127982854843, -0.019003909081220627, 0.008354229852557182, 0.017614558339118958, 0.023039067164063454, -0.013724373653531075, -0.04383101314306259, -0.018315274268388748, -0.04375852271914482, -0.006535991560667753, 0.016660133376717567, -0.022978661581873894, -0.0040170373395085335, -0.01997041329741478, 0.019354267045855522, -0.02674803137779236, -0.020695293322205544, -0.011888014152646065, -0.004572778008878231, 0.028366928920149803, 0.004989583045244217, 0.012697461992502213, -0.018858933821320534, 0.007756204344332218, 0.005956088192760944, 0.018158216029405594, -0.00259144208393991, 0.011169175617396832, 0.0004934461903758347, -0.020465748384594917, 0.002115740207955241, -0.007327317725867033, 0.007182342000305653, -0.021601391956210136, 0.0017457500798627734, 0.02885018102824688, -0.014328439719974995, -0.03641308471560478, -0.016696376726031303, 0.03254706412553787, -0.04537741839885712, 0.011712835170328617, -0.03317528963088989, 0.009302612394094467, 0.024017654359340668, -0.029381757602095604, -0.008922051638364792, 0.03786284103989601, 0.032377924770116806, 0.0012209679698571563, -0.02068321220576763, 0.014280114322900772, 0.015427839942276478, 0.02771453745663166, 0.026264779269695282, -0.014630472287535667, 0.038080304861068726, 0.02125103399157524, -0.02587817795574665, -0.006620560772716999, 0.047914497554302216, -0.030154962092638016, 0.017650801688432693, -0.0050288476049900055, -0.005197986029088497, 0.008173009380698204, -0.012733706273138523, 0.03525327891111374, -0.02332901954650879, -0.0016355080297216773, 0.025249948725104332, -0.025950664654374123, -0.03716212511062622, -0.0446525402367115, 0.014521741308271885, 0.032377924770116806, 0.010395972058176994, -0.007037366274744272, -0.0012209679698571563, -0.0315563939511776, 0.05765203759074211, 0.035035815089941025, 0.029720034450292587, -0.0021399029064923525, 0.020248284563422203, 0.014690878801047802, -0.034528397023677826, -0.015947336331009865, -0.03771786764264107, 0.043903499841690063, -0.02923678234219551, -0.0035972115583717823, 0.011422883719205856, 0.01585068553686142, 0.004053281154483557, 0.03264371305704117, -0.021347684785723686, -0.03660638630390167, 0.0134465042501688, -0.01825486682355404, -0.0279078371822834, 0.010553028434515, 0.019547568634152412, -0.032885339111089706, -0.008076359517872334, -0.000303920591250062, 0.016454750671982765, 0.00296596297994256, 0.0015418778639286757, -0.017300443723797798, -0.018871014937758446, -0.01565738394856453, 0.019426755607128143, -0.046706363558769226, 0.0019360308069735765, 0.0011205419432371855, 0.021710123866796494, 0.003346524201333523, -0.01971670612692833, -0.05364103987812996, 0.0012421102728694677, -0.029889173805713654, 0.01243167370557785, 0.015886928886175156, 0.009628808125853539, 0.011972582899034023, 0.012455835938453674, 0.0046422453597188, 0.0028013549745082855, -0.01579027809202671, 0.004690570756793022, 0.004575798287987709, 0.021263115108013153, 0.01825486682355404, -0.015512408688664436, -0.0019752951338887215, 0.005397327709943056, 0.00610408466309309, 0.04578818380832672, -0.002396630821749568, -0.000998973730020225, -0.004545595031231642, -0.025781527161598206, 0.03708963841199875, 0.029019318521022797, -0.016635971143841743, -0.04622311145067215, -0.019607974216341972, 0.050499897450208664, 0.012310859747231007, 0.013518991880118847, 0.009592563845217228, 0.02860855497419834, 0.010855061933398247, 0.02023620344698429, -0.01705881766974926, -0.019003909081220627, 0.026530567556619644, 0.061228103935718536, -0.012830357067286968, -0.028318602591753006, -0.0020085186697542667, -0.031121468171477318, 0.024440500885248184, 0.03783867880702019, -0.020381178706884384, 0.0036636588629335165, 0.007037366274744272, 0.01737293042242527, -0.0006421973812393844, -0.03194299712777138, 0.011730956844985485, 0.02206048183143139, 0.032619550824165344, -0.02244708314538002, -0.03361021727323532, -0.011579941026866436, 0.0014799610944464803, 0.025854013860225677, -0.01243167370557785, 0.006983000319451094, 0.008450879715383053, 0.07785199582576752, -0.02662721835076809, -0.01185781043022871, 0.003875081893056631, 0.00837235152721405, -0.025322437286376953, -0.0025974828749895096, -0.009381141513586044, 0.028632717207074165, 0.010172467678785324, 0.00871666893362999, -0.005690299440175295, -0.0053610834293067455, 0.024126386269927025, 0.04044824466109276, 0.011012119241058826, 0.030614051967859268, 0.012492080219089985, -0.006735333241522312, -2.505929114704486e-05, -0.022990742698311806, -0.03317528963088989, -0.019064314663410187, -0.02471837028861046, 0.005255372263491154, -0.032619550824165344, 0.0528678335249424, 0.018943501636385918, 0.0020039882510900497, 0.006152409594506025, -0.039070975035429, 0.012467917054891586, -0.003947569523006678, -0.0175058264285326, 0.046513061970472336, -0.023993492126464844, 0.04547407105565071, -0.0029221680015325546, -0.00852940883487463, 0.01946299895644188, 0.06712378561496735, -0.002949350979179144, 0.00012543803313747048, 0.008009911514818668, 0.04699631780385971, 0.003530764253810048, 0.018375679850578308, 0.009459669701755047, 0.010722166858613491, 0.07089316099882126, 0.012455835938453674, 0.027062145993113518, 0.002127821557223797, 0.006182612851262093, -0.013615641742944717, 0.03431093692779541, 0.014413009397685528, 0.036437246948480606, 0.011966542340815067, -0.010033532045781612, -0.007321277167648077, -0.014509659260511398, -0.034455910325050354, -0.01972878724336624, -0.007526659406721592, -0.007230666931718588, -0.034504234790802, -0.011978624388575554, -0.015029155649244785, -0.00950799509882927, -0.026168128475546837, 0.025974826887249947, -0.0018726038979366422, 0.02016371488571167, -0.003364646341651678, -0.05871519073843956, -0.01074633002281189, -0.036751359701156616, 0.01800115965306759, 0.00523422984406352, -0.00296596297994256, -0.005092274397611618, -0.0075991470366716385, -0.028270278126001358, 0.023860597983002663, -0.015041237697005272, -0.010420134291052818, 0.003098857356235385, 0.006397056393325329, 0.01647891476750374, -0.05929509550333023, 0.009375100955367088, 0.0006010454380884767, 0.036292269825935364, -0.01871395669877529, 0.02371562086045742, -0.008106562308967113, 0.0033948495984077454, -0.010891305282711983, -0.014461333863437176, 0.015270782634615898, 0.027062145993113518, 0.03677552193403244, -0.03177385777235031, 0.020646966993808746, 0.01655140146613121, -0.001973784761503339, -0.004898973274976015, -0.05866686627268791, -0.01220816932618618, -0.016285613179206848, -0.012316901236772537, 0.01138059888035059, 0.014086813665926456, -0.014352602884173393, 0.007417927496135235, -0.002278838073834777, 0.014666716568171978, -0.005451693665236235, 3.3105636248365045e-05, 0.03996498882770538, -0.028101138770580292, 0.030904004350304604, 0.025539901107549667, 0.003923407290130854, 0.02193966880440712, -0.003983813803642988, 0.005385246127843857, -0.03583317995071411, -0.03853939473628998, -0.020900676026940346, 0.01958381198346615, 0.014485497027635574, 0.030928166583180428, 0.011869892477989197, 0.016382263973355293, -0.018677713349461555, 0.016152719035744667, -0.014364684000611305, -0.01208131480962038, -0.052481234073638916, -0.03735542669892311, -0.022857848554849625, 0.026868844404816628, -0.01871395669877529, 0.03264371305704117, 0.01058927271515131, -0.0036727197002619505, 0.030082475394010544, -0.056588880717754364, 0.00950799509882927, 0.03443174809217453, 0.0274004228413105, -0.008613977581262589, 0.0031955079175531864, 0.036944661289453506, 0.012588730081915855, -0.0013863309286534786, -0.017107142135500908, 0.018858933821320534, 0.017070898786187172, 0.020067064091563225, -0.002706214552745223, -0.01820654235780239, 0.001807666732929647, -0.024839183315634727, 0.0072608706541359425, -0.007115894462913275, -0.01077653281390667, -0.02200007438659668, 0.035736531019210815, -0.0021836976520717144, 0.0009144045179709792, 0.025201624259352684, 0.015198294073343277, 0.009646929800510406, -0.018146134912967682, 0.02834276482462883, -0.054510895162820816, 0.0032861176878213882, -0.02353440225124359, -0.008988498710095882, -0.0035579472314566374, 0.00783473253250122, -0.027279609814286232, 0.0030022067949175835, 0.03375519439578056, 0.017324605956673622, 0.019994577392935753, 0.005098314955830574, -0.03532576560974121, -0.005977230612188578, 0.006825943011790514, -0.04134226217865944, -0.0037391670048236847, 0.05214295536279678, 0.0017019552178680897, -0.04148723557591438, -0.010613434948027134, 0.028632717207074165, -0.011229582130908966, -0.016599727794528008, 0.006082942243665457, 0.011338314041495323, 0.010963793843984604, 0.08751704543828964, 0.014884180389344692, 0.004403639584779739, 0.019873764365911484, -0.036171458661556244, -0.02364313416182995, 0.00036621486651711166, -0.021021489053964615, -0.014545903541147709, 0.004672448616474867, 0.02009122632443905, 0.0023845494724810123, 0.03614729642868042, 0.014509659260511398, 0.03341691941022873, -0.01922137290239334, -0.0274004228413105, -0.04361354932188988, 0.013458585366606712, -0.03600231930613518, 0.006391015835106373, 0.021154383197426796, -0.008299863897264004, -0.045812346041202545, 0.008154887706041336, 0.008191131986677647, -0.004557676147669554, -0.02099732495844364, 0.01592317409813404, 0.048349425196647644, 0.04305780678987503, 0.029188457876443863, 0.02155306562781334, 0.002547647338360548, 0.006650764029473066, -0.010341606102883816, -0.014860017225146294, -0.011054403148591518, 0.005789970513433218, -0.040158290416002274, 0.002894985256716609, -0.009344897232949734, -0.011453086510300636, 0.033513568341732025, 0.024633802473545074, 0.008867685683071613, -0.01223837211728096, -0.023570645600557327, 0.011658469215035439, -0.0013893512077629566, 0.02137184701859951, 0.006590357515960932, -0.04508746787905693, -0.00729107391089201, 0.0017140365671366453, -0.0274004228413105, -0.010009369812905788, 0.01825486682355404, -0.015476164408028126, 0.0029236783739179373, -0.03774202987551689, -0.01846024952828884, 0.016829272732138634, 0.021468497812747955, 0.01604398712515831, -0.006632641889154911, -0.0371379628777504, 0.014171382412314415, -0.002232023049145937, -0.00020236203272361308, -0.010069776326417923, 0.018170297145843506, -0.004143890924751759, -0.032571226358413696, -0.014509659260511398, 0.02022412233054638, -0.0007063794182613492, -0.01877436414361, 0.014799610711634159, 0.016273532062768936, -0.0024223036598414183, 0.03228127583861351, 0.046585552394390106, 0.020876511931419373, -0.010063735768198967, -0.04692382737994194, 0.017010491341352463, 0.012878681533038616, 0.008734790608286858, -0.03100065514445305, -0.0073998053558170795, -0.005074152257293463, -0.029188457876443863, -0.0007951015722937882, 0.012794112786650658, 0.009332816116511822, -0.04153556004166603, 0.004941258113831282, 0.04332359507679939, -0.02923678234219551, 0.05146640166640282, -0.009659011848270893, -0.024138467386364937, -0.01325320266187191, 0.012951170094311237, 0.028560228645801544, 0.005805071908980608, 0.007375643122941256, 0.006662845145910978, -0.02872936800122261, -0.026772193610668182, -0.016213124617934227, 0.03037242591381073, -0.0284635778516531, 0.01965630054473877, -0.05383434146642685, 0.017010491341352463, -0.01566946506500244, 0.00588058028370142, 0.028391091153025627, 0.0035156626254320145, 0.007025284692645073, 0.01698632910847664, -0.0011998256668448448, 0.005614791065454483, -0.05484917014837265, 0.009381141513586044, 0.021081894636154175, -0.034842513501644135, -0.0016898739850148559, -0.018109891563653946, -0.006010454148054123, 0.04013412818312645, 0.003346524201333523, 0.033634383231401443, 0.029889173805713654, -0.019233454018831253, -0.003878102172166109, 0.014594228938221931, -0.009900637902319431, -0.015355351381003857, -0.03158055618405342, 0.02428344264626503, 0.01889517717063427, -0.011265826411545277, -0.006185633596032858, 0.0068863495253026485, -0.03339275345206261, -0.003292158478870988, 0.01926969736814499, -0.008094481192529202, -0.005774868652224541, -0.0014127588365226984, -0.02498416043817997, -0.04610230028629303, 0.013639804907143116, -0.004146911203861237, 6.380444392561913e-05, 0.014702960848808289, -0.006164491176605225, -0.03817695751786232, -0.026530567556619644, -0.03380351886153221, -0.005913803819566965, -0.017131304368376732, 0.00871666893362999, 0.008450879715383053, 0.015947336331009865, 0.034214284271001816, 0.03068654052913189, 0.01622520573437214, -0.00016224828141275793, 0.0006263406830839813, 0.01325320266187191, 0.019426755607128143, 0.005503038875758648, -0.00076489825733006, 0.04242957755923271, -0.013506910763680935, 0.0026760112959891558, 0.031483907252550125, -0.010353687219321728, -0.02143225260078907, -0.013229040428996086, 0.00593192595988512, 0.03571236878633499, 0.02098524384200573, 0.004071403294801712, -0.0006437075790017843, -0.022350432351231575, -0.00015601885388605297, -0.01024495530873537, -0.01579027809202671, 0.04849439859390259, -0.029889173805713654, -0.009586523286998272, 0.027376260608434677, 0.016116473823785782, -0.003298199037089944, 0.026868844404816628, 0.019426755607128143, 0.03498748689889908, -0.007321277167648077, -0.024815021082758904, 0.012612893246114254, -0.0192817784845829, -0.04095565900206566, -0.023425670340657234, -0.0020945980213582516, -0.012214209884405136, 0.007182342000305653, 0.0008124684682115912, -0.038394421339035034, -0.011024200357496738, 0.006013474427163601, -0.0005923619610257447, 0.01952340453863144, 0.03184634819626808, 0.007478334009647369, 0.013736455701291561, 0.010510744526982307, 0.033948495984077454, -0.016756784170866013, -0.00664472347125411, -0.02314779907464981, 0.025636550039052963, 0.043081969022750854, 0.010710085742175579, 0.03119395487010479, -0.007194423116743565, 0.024114305153489113, 0.009906678460538387, 0.0020206000190228224, -0.0033888088073581457, 0.001276843948289752, -0.032426249235868454, -0.005968169774860144, -0.003458276391029358, 0.017783695831894875, -0.013651886023581028, -0.025757363066077232, 0.002392100403085351, -0.010861102491617203, -0.03344108164310455, 0.017723290249705315, 0.027980325743556023, 0.0017986057791858912, -0.0004039689665660262, -0.029647547751665115, -0.023256530985236168, 0.006656804587692022, 0.019994577392935753, -0.011881973594427109, -0.008414636366069317, -0.03259538859128952, -0.007798488717526197, 0.013011576607823372, -0.017034653574228287, -0.00698904087767005, 0.0062581212259829044, 0.005110396537929773, -0.01643058843910694, 0.018556900322437286, -0.021456414833664894, 0.028705205768346786, -0.002283368492498994, -0.005602709949016571, 0.017203792929649353, 0.036485571414232254, -0.007049447391182184, -0.028560228645801544, 0.016019823029637337, 0.0253707617521286, 0.0011379088973626494, -0.0028798833955079317, 0.003476398531347513, -0.014896261505782604, -0.017070898786187172, -0.009369059465825558, 4.009014446637593e-05, 0.0004496514447964728, 0.05504247173666954, 0.003838837845250964, 0.0015146948862820864, -0.025153297930955887, -0.00878311600536108, -0.006203755270689726, 0.03481835126876831, 0.028801854699850082, 0.02701382152736187, -0.01255248673260212, -0.017904508858919144, -0.010220793075859547, 0.007152138743549585, 0.0023830393329262733, 0.03689633682370186, -0.023051150143146515, -0.02326861396431923, 0.0035126423463225365, -0.011386639438569546, 0.0259265024214983, -0.05765203759074211, -0.004859709180891514, -0.01679302752017975, 0.016841353848576546, -0.005095294676721096, 0.034069307148456573, 0.04407263919711113, 0.051079802215099335, 0.00817904993891716, -0.008535449393093586, 0.029212620109319687, -0.00278927362523973, -0.0152466194704175, 0.009828150272369385, 0.011326232925057411, 0.0024268340785056353, -0.009556320495903492, -0.008287781849503517, 0.04726210609078407, -0.015874847769737244, 0.04013412818312645, 0.017457500100135803, -0.02162555418908596, 0.012383348308503628, -0.03853939473628998, -0.01902807131409645, 0.01635809987783432, 0.022555815055966377, -0.0014814712340012193, 0.010124142281711102, 0.009091190062463284, 0.010202670469880104, -0.058811843395233154, -0.00945362914353609, -0.007683716248720884, -0.03416595980525017, -0.014074732549488544, 0.03266787528991699, -0.007514577824622393, 0.03467337414622307, 0.00032072115573100746, -0.006324568297713995, 0.0007830202230252326, 0.005056030582636595, 0.022350432351231575, 0.015355351381003857, 0.008686465211212635, 0.017844103276729584, 0.017155468463897705, -0.00570842158049345, -0.0053278598934412, -0.014171382412314415, 0.021420171484351158, 0.006191674154251814, 0.022809522226452827, -0.0100214509293437, 0.054317593574523926, -0.02669970691204071, 0.01442509051412344, -0.03841858357191086, -0.05281950905919075, 0.03936092555522919, -0.014243870973587036, -0.0077864076010882854, 0.0005727298557758331, -0.010842980816960335, 0.0038871632423251867, 0.02093691937625408, -0.00642121909186244, -0.02383643575012684, 0.0027545399498194456, -0.009290531277656555, -0.014847936108708382, 0.037065476179122925, 0.041825514286756516, 0.00019179088121745735, -0.0324745737016201, -0.0024132428225129843, 0.0026518485974520445, 0.03327194228768349, -0.016140637919306755, 0.011368517763912678, 0.0067534553818404675, 0.0339968204498291, 0.01788034662604332, 0.03462504968047142, -0.03950589895248413, 0.011362477205693722]', 753, NOW()) ON CONFLICT (id) DO NOTHING;
+-- the rows above set their ids, move the sequence past them
+SELECT setval('embeddings_id_seq', (SELECT MAX(id) FROM embeddings));
@@ -0,0 +1 @@
+DELETE FROM scopes WHERE name = 'lelang';
@@ -0,0 +1 @@
+INSERT INTO scopes (name, created_at) VALUES ('lelang', NOW()) ON CONFLICT (name) DO NOTHING;
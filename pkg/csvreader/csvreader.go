@@ -0,0 +1,191 @@
+package csvreader
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+const (
+	EncodingAuto        = "auto"
+	EncodingUTF8        = "utf-8"
+	EncodingWindows1252 = "windows-1252"
+	EncodingLatin1      = "latin-1"
+)
+
+// candidates are the delimiters tried when the dialect has none.
+var candidates = []rune{';', ',', '\t', '|'}
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// Dialect describes how a CSV file is written.
+type Dialect struct {
+	// Delimiter separates the fields. Empty means sniffed from the header line.
+	Delimiter string `json:"delimiter" yaml:"delimiter"`
+	// Encoding of the file. Empty or "auto" reads UTF-8 and falls back to
+	// Windows-1252 when the content is not valid UTF-8.
+	Encoding string `json:"encoding" yaml:"encoding"`
+	// Headers overrides the column names of the header line.
+	Headers []string `json:"headers" yaml:"headers"`
+	// NoHeaderRow means the first line is data, Headers must name the columns.
+	NoHeaderRow bool `json:"no_header_row" yaml:"no_header_row"`
+	// Strict fails on the first malformed line instead of skipping it.
+	Strict bool `json:"strict" yaml:"strict"`
+}
+
+// SkippedLine is a line left out of the result in lenient mode.
+type SkippedLine struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+// Result is the content of a CSV file.
+type Result struct {
+	Headers []string
+	Records [][]string
+	// Lines holds the line number where each record starts.
+	Lines   []int
+	Skipped []SkippedLine
+}
+
+// Read decodes and parses a whole CSV file with the dialect.
+func Read(r io.Reader, d Dialect) (*Result, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = decode(data, d.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	delimiter, err := delimiterOf(data, d.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = delimiter
+	reader.LazyQuotes = true
+
+	result := &Result{Headers: d.Headers}
+	if !d.NoHeaderRow {
+		headers, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("header: %w", err)
+		}
+
+		if len(result.Headers) == 0 {
+			result.Headers = headers
+		}
+	} else if len(result.Headers) == 0 {
+		return nil, errors.New("headers are required when the file has no header row")
+	}
+
+	// every record must have as many fields as there are headers
+	reader.FieldsPerRecord = len(result.Headers)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			line, reason := describe(err)
+			if d.Strict {
+				return nil, fmt.Errorf("line %d: %s", line, reason)
+			}
+
+			result.Skipped = append(result.Skipped, SkippedLine{Line: line, Reason: reason})
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		result.Records = append(result.Records, record)
+		result.Lines = append(result.Lines, line)
+	}
+
+	return result, nil
+}
+
+// decode strips the UTF-8 BOM and converts the content to UTF-8.
+func decode(data []byte, encoding string) ([]byte, error) {
+	switch strings.ToLower(encoding) {
+	case "", EncodingAuto:
+		data = bytes.TrimPrefix(data, utf8BOM)
+		if utf8.Valid(data) {
+			return data, nil
+		}
+
+		return charmap.Windows1252.NewDecoder().Bytes(data)
+	case EncodingUTF8, "utf8":
+		return bytes.TrimPrefix(data, utf8BOM), nil
+	case EncodingWindows1252, "cp1252":
+		return charmap.Windows1252.NewDecoder().Bytes(data)
+	case EncodingLatin1, "iso-8859-1":
+		return charmap.ISO8859_1.NewDecoder().Bytes(data)
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q", encoding)
+	}
+}
+
+// delimiterOf returns the configured delimiter, or the candidate found most
+// often outside quotes on the first line.
+func delimiterOf(data []byte, delimiter string) (rune, error) {
+	if delimiter != "" {
+		if delimiter == `\t` {
+			return '\t', nil
+		}
+
+		r, size := utf8.DecodeRuneInString(delimiter)
+		if size != len(delimiter) {
+			return 0, fmt.Errorf("delimiter must be a single character, got %q", delimiter)
+		}
+
+		return r, nil
+	}
+
+	line := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		line = data[:i]
+	}
+
+	counts := make(map[rune]int)
+	quoted := false
+	for _, r := range string(line) {
+		if r == '"' {
+			quoted = !quoted
+			continue
+		}
+
+		if !quoted {
+			counts[r]++
+		}
+	}
+
+	best := candidates[0]
+	for _, c := range candidates[1:] {
+		if counts[c] > counts[best] {
+			best = c
+		}
+	}
+
+	return best, nil
+}
+
+func describe(err error) (int, string) {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return parseErr.StartLine, parseErr.Err.Error()
+	}
+
+	return 0, err.Error()
+}
@@ -0,0 +1,103 @@
+package csvreader_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/pkg/csvreader"
+)
+
+func TestRead(t *testing.T) {
+	type args struct {
+		input   string
+		dialect csvreader.Dialect
+	}
+
+	type test struct {
+		args        args
+		wantHeaders []string
+		wantRecords [][]string
+		wantSkipped []csvreader.SkippedLine
+		wantErr     bool
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a semicolon file with BOM, When read, Return headers without BOM": func(t *testing.T) test {
+			return test{
+				args: args{
+					input: "\ufeffstock_no;ppn_1,1%\nBA1;11\n",
+				},
+				wantHeaders: []string{"stock_no", "ppn_1,1%"},
+				wantRecords: [][]string{{"BA1", "11"}},
+			}
+		},
+		"Given a comma file, When read, Return sniffed fields": func(t *testing.T) test {
+			return test{
+				args: args{
+					input: "a,b\n1,2\n",
+				},
+				wantHeaders: []string{"a", "b"},
+				wantRecords: [][]string{{"1", "2"}},
+			}
+		},
+		"Given a Windows-1252 file, When read, Return UTF-8 fields": func(t *testing.T) test {
+			return test{
+				args: args{
+					input: "nama;kota\nJos\xe9;Bekasi\n",
+				},
+				wantHeaders: []string{"nama", "kota"},
+				wantRecords: [][]string{{"Jos\u00e9", "Bekasi"}},
+			}
+		},
+		"Given a malformed line in lenient mode, When read, Return remaining rows and skipped line": func(t *testing.T) test {
+			return test{
+				args: args{
+					input: "a;b\n1;2\n3\n4;5\n",
+				},
+				wantHeaders: []string{"a", "b"},
+				wantRecords: [][]string{{"1", "2"}, {"4", "5"}},
+				wantSkipped: []csvreader.SkippedLine{{Line: 3, Reason: "wrong number of fields"}},
+			}
+		},
+		"Given a malformed line in strict mode, When read, Return error": func(t *testing.T) test {
+			return test{
+				args: args{
+					input:   "a;b\n1;2\n3\n",
+					dialect: csvreader.Dialect{Strict: true},
+				},
+				wantErr: true,
+			}
+		},
+		"Given a header override without header row, When read, Return every line as record": func(t *testing.T) test {
+			return test{
+				args: args{
+					input:   "1|2\n3|4\n",
+					dialect: csvreader.Dialect{Headers: []string{"x", "y"}, NoHeaderRow: true},
+				},
+				wantHeaders: []string{"x", "y"},
+				wantRecords: [][]string{{"1", "2"}, {"3", "4"}},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			got, err := csvreader.Read(strings.NewReader(tt.args.input), tt.args.dialect)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.wantHeaders, got.Headers)
+			assert.Equal(t, tt.wantRecords, got.Records)
+			assert.Equal(t, tt.wantSkipped, got.Skipped)
+		})
+	}
+}
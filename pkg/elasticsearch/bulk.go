@@ -0,0 +1,194 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	defaultFlushDocuments = 500
+	defaultFlushBytes     = 5 << 20
+)
+
+// BulkDocument is a document to index under a stable ID, so indexing it
+// again overwrites it.
+type BulkDocument struct {
+	ID     string
+	Source any
+}
+
+// BulkOptions limits the size of every _bulk request.
+type BulkOptions struct {
+	// FlushDocuments is the maximum number of documents in one request.
+	FlushDocuments int
+	// FlushBytes is the maximum body size of one request. A single larger
+	// document is still sent, on its own.
+	FlushBytes int
+}
+
+// BulkItemError is a document Elasticsearch refused.
+type BulkItemError struct {
+	ID     string `json:"id"`
+	Status int    `json:"status"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+// BulkSummary counts the outcome of a BulkIndex call.
+type BulkSummary struct {
+	Indexed  int
+	Failed   int
+	Requests int
+	Errors   []BulkItemError
+}
+
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		ID     string `json:"_id"`
+		Status int    `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+}
+
+// BulkIndex indexes the documents through the _bulk API, flushing whenever
+// a request reaches the document or byte limit. Refused documents are
+// reported in the summary, only transport and request level failures are
+// returned as error.
+func (es *ESClient) BulkIndex(ctx context.Context, documents []BulkDocument, opts BulkOptions) (*BulkSummary, error) {
+	if opts.FlushDocuments <= 0 {
+		opts.FlushDocuments = defaultFlushDocuments
+	}
+
+	if opts.FlushBytes <= 0 {
+		opts.FlushBytes = defaultFlushBytes
+	}
+
+	summary := &BulkSummary{}
+
+	var body bytes.Buffer
+	pending := 0
+	for _, document := range documents {
+		item, err := bulkItem(es.index, document)
+		if err != nil {
+			return summary, err
+		}
+
+		if pending > 0 && body.Len()+len(item) > opts.FlushBytes {
+			if err := es.flushBulk(ctx, &body, summary); err != nil {
+				return summary, err
+			}
+			pending = 0
+		}
+
+		body.Write(item)
+		pending++
+
+		if pending >= opts.FlushDocuments {
+			if err := es.flushBulk(ctx, &body, summary); err != nil {
+				return summary, err
+			}
+			pending = 0
+		}
+	}
+
+	if pending > 0 {
+		if err := es.flushBulk(ctx, &body, summary); err != nil {
+			return summary, err
+		}
+	}
+
+	return summary, nil
+}
+
+// bulkItem returns the action and source lines of a document.
+func bulkItem(index string, document BulkDocument) ([]byte, error) {
+	action := map[string]map[string]string{
+		"index": {"_index": index, "_id": document.ID},
+	}
+
+	actionLine, err := json.Marshal(action)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceLine, err := json.Marshal(document.Source)
+	if err != nil {
+		return nil, fmt.Errorf("document %s: %w", document.ID, err)
+	}
+
+	item := make([]byte, 0, len(actionLine)+len(sourceLine)+2)
+	item = append(item, actionLine...)
+	item = append(item, '\n')
+	item = append(item, sourceLine...)
+	item = append(item, '\n')
+
+	return item, nil
+}
+
+func (es *ESClient) flushBulk(ctx context.Context, body *bytes.Buffer, summary *BulkSummary) error {
+	defer body.Reset()
+
+	res, err := es.client.Bulk(
+		bytes.NewReader(body.Bytes()),
+		es.client.Bulk.WithContext(ctx),
+		es.client.Bulk.WithIndex(es.index),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	summary.Requests++
+
+	if err := responseError(res); err != nil {
+		return err
+	}
+
+	var response bulkResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return err
+	}
+
+	for _, item := range response.Items {
+		for _, result := range item {
+			if result.Error == nil && result.Status < 300 {
+				summary.Indexed++
+				continue
+			}
+
+			summary.Failed++
+			itemErr := BulkItemError{ID: result.ID, Status: result.Status}
+			if result.Error != nil {
+				itemErr.Type = result.Error.Type
+				itemErr.Reason = result.Error.Reason
+			}
+			summary.Errors = append(summary.Errors, itemErr)
+		}
+	}
+
+	return nil
+}
+
+// IndexExists reports whether the index exists.
+func (es *ESClient) IndexExists(ctx context.Context) (bool, error) {
+	res, err := es.client.Indices.Exists([]string{es.index}, es.client.Indices.Exists.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case 200:
+		return true, nil
+	case 404:
+		return false, nil
+	default:
+		return false, &Error{Status: res.StatusCode, Reason: res.Status()}
+	}
+}
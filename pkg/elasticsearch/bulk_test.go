@@ -0,0 +1,121 @@
+package elasticsearch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient returns a client of a fake cluster refusing documents whose
+// ID starts with "bad", and the number of documents of every _bulk request.
+func newTestClient(t *testing.T) (*ESClient, *[]int) {
+	var requests []int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+
+		var items []string
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var action map[string]map[string]string
+			if err := json.Unmarshal(scanner.Bytes(), &action); err != nil {
+				t.Errorf("invalid action line: %s", scanner.Text())
+				return
+			}
+			scanner.Scan() // source line
+
+			id := action["index"]["_id"]
+			if strings.HasPrefix(id, "bad") {
+				items = append(items, fmt.Sprintf(
+					`{"index":{"_id":%q,"status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse"}}}`, id,
+				))
+				continue
+			}
+			items = append(items, fmt.Sprintf(`{"index":{"_id":%q,"status":201}}`, id))
+		}
+		requests = append(requests, len(items))
+
+		_, _ = fmt.Fprintf(w, `{"errors":true,"items":[%s]}`, strings.Join(items, ","))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{server.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &ESClient{client: client, index: "test"}, &requests
+}
+
+func TestESClient_BulkIndex(t *testing.T) {
+	type test struct {
+		documents    []BulkDocument
+		opts         BulkOptions
+		wantIndexed  int
+		wantFailed   int
+		wantRequests []int
+	}
+
+	documents := func(ids ...string) []BulkDocument {
+		var docs []BulkDocument
+		for _, id := range ids {
+			docs = append(docs, BulkDocument{ID: id, Source: map[string]string{"combined": "Stock No: " + id}})
+		}
+		return docs
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given five documents and a flush size of two, When indexed, Return three requests": func(t *testing.T) test {
+			return test{
+				documents:    documents("a", "b", "c", "d", "e"),
+				opts:         BulkOptions{FlushDocuments: 2},
+				wantIndexed:  5,
+				wantRequests: []int{2, 2, 1},
+			}
+		},
+		"Given a byte limit below one document, When indexed, Return one request per document": func(t *testing.T) test {
+			return test{
+				documents:    documents("a", "b", "c"),
+				opts:         BulkOptions{FlushBytes: 10},
+				wantIndexed:  3,
+				wantRequests: []int{1, 1, 1},
+			}
+		},
+		"Given refused documents, When indexed, Return them in the summary": func(t *testing.T) test {
+			return test{
+				documents:    documents("a", "bad1", "b", "bad2"),
+				wantIndexed:  2,
+				wantFailed:   2,
+				wantRequests: []int{4},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			sut, requests := newTestClient(t)
+
+			got, err := sut.BulkIndex(context.Background(), tt.documents, tt.opts)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantIndexed, got.Indexed)
+			assert.Equal(t, tt.wantFailed, got.Failed)
+			assert.Equal(t, tt.wantRequests, *requests)
+			assert.Len(t, got.Errors, tt.wantFailed)
+			for _, itemErr := range got.Errors {
+				assert.Equal(t, "mapper_parsing_exception", itemErr.Type)
+			}
+		})
+	}
+}
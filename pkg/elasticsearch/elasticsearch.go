@@ -5,15 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/elastic/go-elasticsearch/v8"
-	"log"
 	"os"
-	"strconv"
-	"strings"
 )
 
 type ESClient struct {
-	client *elasticsearch.Client
-	index  string
+	client    *elasticsearch.Client
+	index     string
+	queryMode string
+	config    IndexConfig
 }
 
 type ESSearchResponse struct {
@@ -45,7 +44,9 @@ type ESSearchResponse struct {
 	}
 }
 
-func NewElasticsearch() *ESClient {
+// NewElasticsearch returns a client of the cluster of the environment. The
+// config drives the mapping of created indices and the fields searched.
+func NewElasticsearch(config IndexConfig) *ESClient {
 	cfg := elasticsearch.Config{
 		Addresses: []string{
 			fmt.Sprintf("%s:%s", os.Getenv("ELASTICSEARCH_HOST"), os.Getenv("ELASTICSEARCH_PORT")),
@@ -56,8 +57,25 @@ func NewElasticsearch() *ESClient {
 		panic(err)
 	}
 
+	index := os.Getenv("ELASTICSEARCH_INDEX")
+	if index == "" {
+		index = "research"
+	}
+
+	queryMode := os.Getenv("ELASTICSEARCH_QUERY_MODE")
+	if queryMode == "" {
+		queryMode = QueryModeSimple
+	}
+
+	if err := ValidateQueryMode(queryMode); err != nil {
+		panic(err)
+	}
+
 	return &ESClient{
-		client: es,
+		client:    es,
+		index:     index,
+		queryMode: queryMode,
+		config:    config.withDefaults(),
 	}
 }
 
@@ -69,45 +87,56 @@ func (es *ESClient) SetIndex(index string) {
 	es.index = index
 }
 
+// WithIndex returns a copy of the client working on another index. Unlike
+// SetIndex it is safe to use from concurrent requests.
+func (es *ESClient) WithIndex(index string) *ESClient {
+	c := *es
+	c.index = index
+	return &c
+}
+
+// WithQueryMode returns a copy of the client using another full text query mode.
+func (es *ESClient) WithQueryMode(mode string) (*ESClient, error) {
+	if err := ValidateQueryMode(mode); err != nil {
+		return nil, err
+	}
+
+	c := *es
+	c.queryMode = mode
+	return &c, nil
+}
+
 func (es *ESClient) GetIndex() string {
 	return es.index
 }
 
+// CreateIndex creates the index with the mapping of the index config. It
+// returns an error matching ErrIndexExists when the index exists.
 func (es *ESClient) CreateIndex() error {
-	mapping := `
-    {
-      "settings": {
-        "number_of_shards": 1
-      },
-      "mappings": {
-        "properties": {
-          "embedding": {
-            "type": "dense_vector",
-			"dims": 1536,
-			"index": "true",
-			"similarity": "cosine"
-          },
-		  "combined": "text",
-		  "raw": "text",
-        }
-      }
-    }`
+	body, err := json.Marshal(es.config.IndexBody())
+	if err != nil {
+		return err
+	}
 
-	_, err := es.client.Indices.Create(es.index, es.client.Indices.Create.WithBody(strings.NewReader(mapping)))
+	res, err := es.client.Indices.Create(es.index, es.client.Indices.Create.WithBody(bytes.NewReader(body)))
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 
-	return nil
+	return responseError(res)
 }
 
+// DeleteIndex deletes the index. It returns an error matching
+// ErrIndexNotFound when the index does not exist.
 func (es *ESClient) DeleteIndex() error {
-	_, err := es.client.Indices.Delete([]string{es.index})
+	res, err := es.client.Indices.Delete([]string{es.index})
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 
-	return nil
+	return responseError(res)
 }
 
 func (es *ESClient) IndexDocument(document map[string]interface{}) error {
@@ -116,157 +145,92 @@ func (es *ESClient) IndexDocument(document map[string]interface{}) error {
 		return err
 	}
 
-	_, err = es.client.Index(es.index, bytes.NewBuffer(documentByte))
+	res, err := es.client.Index(es.index, bytes.NewBuffer(documentByte))
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 
-	return nil
+	return responseError(res)
 }
 
-func (es *ESClient) VectorSearch(vector []float64) (*ESSearchResponse, error) {
-	var strArr []string
-	for _, v := range vector {
-		strArr = append(strArr, strconv.FormatFloat(v, 'f', -1, 64))
-	}
-
-	log.Println("vector: ", strings.Join(strArr, ", "))
-
-	//query := `
-	//{
-	// "query": {
-	//	"script_score": {
-	//	  "query": {
-	//		"match_all": {}
-	//	  },
-	//	  "script": {
-	//		"source": "cosineSimilarity(params.query_vector, 'embedding') + 1.0",
-	//		"params": {
-	//		  "query_vector": [` + strings.Join(strArr, ", ") + `]
-	//		}
-	//	  }
-	//	}
-	// }
-	//}`
-
-	query := `
-	{
-	 "knn": {	
-		"field": "embedding",
-		"query_vector": [` + strings.Join(strArr, ", ") + `],
-		"k": 3,
-		"num_candidates": 3
-	 },
-	 "rescore": {
-		"window_size": 3,
-		"query": {
-			"rescore_query": {
-				"script_score": {
-					"query": {
-						"match_all": {}
+// VectorSearch returns the k nearest documents of the vector, rescored by
+// exact cosine similarity.
+func (es *ESClient) VectorSearch(vector []float64, k int) (*ESSearchResponse, error) {
+	return es.search(&SearchRequest{
+		Source: &SourceFilter{Excludes: []string{"embedding"}},
+		KNN: &KNNQuery{
+			Field:         "embedding",
+			QueryVector:   vector,
+			K:             k,
+			NumCandidates: k,
+		},
+		Size: k,
+		Rescore: &Rescore{
+			WindowSize: k,
+			Query: RescoreQuery{
+				RescoreQuery: Query{
+					ScriptScore: &ScriptScore{
+						Query: Query{MatchAll: &MatchAll{}},
+						Script: Script{
+							Source: "cosineSimilarity(params.query_vector, 'embedding') + 1.0",
+							Params: map[string]any{"query_vector": vector},
+						},
 					},
-					"script": {
-						"source": "cosineSimilarity(params.query_vector, 'embedding') + 1.0",
-						"params": {
-							"query_vector": [` + strings.Join(strArr, ", ") + `]
-						}
-					}
-				}
-			}
-		}
-	 }
-	}`
+				},
+			},
+		},
+	})
+}
 
-	res, err := es.client.Search(
-		es.client.Search.WithIndex(es.index),
-		es.client.Search.WithBody(strings.NewReader(query)),
-	)
+// IndexSearch returns the size best documents matching any word of the
+// question in the search fields.
+func (es *ESClient) IndexSearch(question string, size int) (*ESSearchResponse, error) {
+	query, err := TextQuery(es.queryMode, question, es.config.SearchFields, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	var result *ESSearchResponse
-	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
-		return nil, err
-	}
+	return es.search(&SearchRequest{
+		Source: &SourceFilter{Excludes: []string{"embedding"}},
+		Query:  query,
+		Size:   size,
+	})
+}
 
-	return result, nil
+// TermsSearch returns up to size documents whose field holds exactly one of
+// the values, e.g. the KeywordField of a column.
+func (es *ESClient) TermsSearch(field string, values []string, size int) (*ESSearchResponse, error) {
+	return es.search(&SearchRequest{
+		Source: &SourceFilter{Excludes: []string{"embedding"}},
+		Query:  &Query{Terms: map[string][]string{field: values}},
+		Size:   size,
+	})
 }
 
-func (es *ESClient) IndexSearch(question string) (*ESSearchResponse, error) {
-	query := `
-	{
-	 "_source": {
-			"excludes": ["embedding"]	
-		},
-		"query": {
-			"query_string": {
-				"fields": [
-					"raw"
-				],
-				"query": "` + question + `",
-				"minimum_should_match": 1
-			}
-		}
-	}`
-	res, err := es.client.Search(
-		es.client.Search.WithIndex(es.index),
-		es.client.Search.WithBody(strings.NewReader(query)),
-	)
+func (es *ESClient) search(request *SearchRequest) (*ESSearchResponse, error) {
+	body, err := json.Marshal(request)
 	if err != nil {
 		return nil, err
 	}
 
-	var result *ESSearchResponse
-	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-
-	return result, nil
-}
-
-func (es *ESClient) HybridSearch(vector []float64, question string) (*ESSearchResponse, error) {
-	var strArr []string
-	for _, v := range vector {
-		strArr = append(strArr, strconv.FormatFloat(v, 'f', -1, 64))
-	}
-
-	log.Println("vector: ", strings.Join(strArr, ", "))
-
-	query := `
-	{
-	 "knn": {	
-		"field": "embedding",
-		"query_vector": [` + strings.Join(strArr, ", ") + `],
-		"k": 3,
-		"num_candidates": 3,
-		"boost": 0.1
-	 },
-	"query": {
-		"query_string": {
-			"fields": [
-				"raw"
-			],
-			"query": "` + question + `",
-			"minimum_should_match": 1,
-			"boost": 0.9
-		}
-	},
-	"size": 3
-	}`
-
 	res, err := es.client.Search(
 		es.client.Search.WithIndex(es.index),
-		es.client.Search.WithBody(strings.NewReader(query)),
+		es.client.Search.WithBody(bytes.NewReader(body)),
 	)
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
 
-	var result *ESSearchResponse
-	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+	if err := responseError(res); err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	var result ESSearchResponse
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode search response: %w", err)
+	}
+
+	return &result, nil
 }
@@ -0,0 +1,96 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+)
+
+var (
+	// ErrIndexNotFound matches an Error about a missing index.
+	ErrIndexNotFound = errors.New("index not found")
+	// ErrIndexExists matches an Error about creating an existing index.
+	ErrIndexExists = errors.New("index already exists")
+)
+
+// Error is an error response of Elasticsearch.
+type Error struct {
+	Status int
+	Type   string
+	Reason string
+	Index  string
+}
+
+func (e *Error) Error() string {
+	if e.Type == "" {
+		return fmt.Sprintf("elasticsearch: status %d: %s", e.Status, e.Reason)
+	}
+
+	return fmt.Sprintf("elasticsearch: status %d: %s: %s", e.Status, e.Type, e.Reason)
+}
+
+// Is makes errors.Is match ErrIndexNotFound and ErrIndexExists.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrIndexNotFound:
+		return e.Type == "index_not_found_exception"
+	case ErrIndexExists:
+		return e.Type == "resource_already_exists_exception"
+	default:
+		return false
+	}
+}
+
+type errorResponse struct {
+	Error json.RawMessage `json:"error"`
+}
+
+type errorCause struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+	Index  string `json:"index"`
+}
+
+// responseError returns nil for a successful response, else an *Error read
+// from the body. The caller still closes the body.
+func responseError(res *esapi.Response) error {
+	if !res.IsError() {
+		return nil
+	}
+
+	e := &Error{Status: res.StatusCode}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		e.Reason = err.Error()
+		return e
+	}
+
+	var response errorResponse
+	if err := json.Unmarshal(body, &response); err != nil || len(response.Error) == 0 {
+		// HEAD requests and proxies answer without an error object
+		e.Reason = string(body)
+		if e.Reason == "" {
+			e.Reason = res.Status()
+		}
+		return e
+	}
+
+	var cause errorCause
+	if err := json.Unmarshal(response.Error, &cause); err != nil {
+		// some errors are a plain string
+		var reason string
+		_ = json.Unmarshal(response.Error, &reason)
+		e.Reason = reason
+		return e
+	}
+
+	e.Type = cause.Type
+	e.Reason = cause.Reason
+	e.Index = cause.Index
+
+	return e
+}
@@ -0,0 +1,125 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeClient returns a client of a fake cluster answering with handler.
+func newFakeClient(t *testing.T, handler http.HandlerFunc) *ESClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{server.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &ESClient{client: client, index: "research", queryMode: QueryModeSimple}
+}
+
+func respond(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func TestESClient_Errors(t *testing.T) {
+	const indexNotFound = `{"error":{"root_cause":[],"type":"index_not_found_exception",` +
+		`"reason":"no such index [research]","index":"research"},"status":404}`
+
+	type test struct {
+		handler    http.HandlerFunc
+		call       func(es *ESClient) error
+		wantStatus int
+		wantType   string
+		wantIs     error
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a missing index, When deleted, Return index not found": func(t *testing.T) test {
+			return test{
+				handler:    respond(404, indexNotFound),
+				call:       func(es *ESClient) error { return es.DeleteIndex() },
+				wantStatus: 404,
+				wantType:   "index_not_found_exception",
+				wantIs:     ErrIndexNotFound,
+			}
+		},
+		"Given an existing index, When created, Return index exists": func(t *testing.T) test {
+			return test{
+				handler: respond(400, `{"error":{"type":"resource_already_exists_exception",`+
+					`"reason":"index [research] already exists"},"status":400}`),
+				call:       func(es *ESClient) error { return es.CreateIndex() },
+				wantStatus: 400,
+				wantType:   "resource_already_exists_exception",
+				wantIs:     ErrIndexExists,
+			}
+		},
+		"Given a missing index, When searched, Return an error and no response": func(t *testing.T) test {
+			return test{
+				handler: respond(404, indexNotFound),
+				call: func(es *ESClient) error {
+					res, err := es.VectorSearch([]float64{0.1}, 3)
+					assert.Nil(t, res)
+					return err
+				},
+				wantStatus: 404,
+				wantType:   "index_not_found_exception",
+				wantIs:     ErrIndexNotFound,
+			}
+		},
+		"Given a plain string error, When indexed, Return its reason": func(t *testing.T) test {
+			return test{
+				handler:    respond(500, `{"error":"something broke","status":500}`),
+				call:       func(es *ESClient) error { return es.IndexDocument(map[string]interface{}{"raw": "x"}) },
+				wantStatus: 500,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			err := tt.call(newFakeClient(t, tt.handler))
+
+			var esErr *Error
+			if !assert.True(t, errors.As(err, &esErr), "want *Error, got %v", err) {
+				return
+			}
+
+			assert.Equal(t, tt.wantStatus, esErr.Status)
+			assert.Equal(t, tt.wantType, esErr.Type)
+			if tt.wantIs != nil {
+				assert.ErrorIs(t, err, tt.wantIs)
+			} else {
+				assert.NotErrorIs(t, err, ErrIndexNotFound)
+			}
+		})
+	}
+}
+
+func TestESClient_CreateIndex(t *testing.T) {
+	var body []byte
+
+	sut := newFakeClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	})
+
+	assert.NoError(t, sut.CreateIndex())
+	assert.True(t, json.Valid(body), "mapping must be valid JSON: %s", body)
+}
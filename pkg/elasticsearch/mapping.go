@@ -0,0 +1,158 @@
+package elasticsearch
+
+const (
+	defaultShards       = 1
+	defaultTextAnalyzer = "indonesian"
+	defaultPrefixMin    = 2
+	defaultPrefixMax    = 15
+
+	// identifierAnalyzer keeps identifiers like T8324AP or BA00001023J09 whole.
+	identifierAnalyzer = "identifier"
+	// prefixAnalyzer indexes the edge n-grams of identifiers for partial matches.
+	prefixAnalyzer = "identifier_prefix"
+	// lowercaseNormalizer makes keyword subfields match regardless of case.
+	lowercaseNormalizer = "lowercase"
+)
+
+// defaultSearchFields weighs whole identifiers above their prefixes and the
+// free text.
+var defaultSearchFields = []string{"raw^3", "raw.prefix", "combined"}
+
+// IndexConfig drives the mapping of the indices and the fields full text
+// queries run on.
+type IndexConfig struct {
+	// Dims is the length of the embedding vectors.
+	Dims int
+	// Shards and Replicas of every index.
+	Shards   int
+	Replicas int
+	// TextAnalyzer analyzes the combined free text, a built-in language
+	// analyzer name.
+	TextAnalyzer string
+	// PrefixMinGram and PrefixMaxGram bound the edge n-grams of identifiers.
+	PrefixMinGram int
+	PrefixMaxGram int
+	// SearchFields are the fields of full text queries, with optional ^boost.
+	SearchFields []string
+}
+
+func (c IndexConfig) withDefaults() IndexConfig {
+	if c.Shards <= 0 {
+		c.Shards = defaultShards
+	}
+
+	if c.TextAnalyzer == "" {
+		c.TextAnalyzer = defaultTextAnalyzer
+	}
+
+	if c.PrefixMinGram <= 0 {
+		c.PrefixMinGram = defaultPrefixMin
+	}
+
+	if c.PrefixMaxGram < c.PrefixMinGram {
+		c.PrefixMaxGram = max(defaultPrefixMax, c.PrefixMinGram)
+	}
+
+	if len(c.SearchFields) == 0 {
+		c.SearchFields = defaultSearchFields
+	}
+
+	return c
+}
+
+// IndexBody returns the settings and mappings of a new index.
+//
+//   - embedding is a dense_vector of Dims compared by cosine.
+//   - combined is free text analyzed with TextAnalyzer.
+//   - raw holds the identifiers of a row, with a prefix subfield of edge
+//     n-grams so T8324 finds T8324AP.
+//   - fields.* are the columns of the row, each with a keyword subfield for
+//     exact lookups and a prefix subfield.
+func (c IndexConfig) IndexBody() map[string]any {
+	c = c.withDefaults()
+
+	identifier := func() map[string]any {
+		return map[string]any{
+			"type":     "text",
+			"analyzer": identifierAnalyzer,
+			"fields": map[string]any{
+				"keyword": map[string]any{
+					"type":         "keyword",
+					"normalizer":   lowercaseNormalizer,
+					"ignore_above": 256,
+				},
+				"prefix": map[string]any{
+					"type":            "text",
+					"analyzer":        prefixAnalyzer,
+					"search_analyzer": identifierAnalyzer,
+				},
+			},
+		}
+	}
+
+	raw := identifier()
+	// raw joins every identifier of the row, a keyword of it matches nothing
+	delete(raw["fields"].(map[string]any), "keyword")
+
+	return map[string]any{
+		"settings": map[string]any{
+			"number_of_shards":   c.Shards,
+			"number_of_replicas": c.Replicas,
+			"analysis": map[string]any{
+				"normalizer": map[string]any{
+					lowercaseNormalizer: map[string]any{
+						"type":   "custom",
+						"filter": []string{"lowercase", "asciifolding"},
+					},
+				},
+				"tokenizer": map[string]any{
+					prefixAnalyzer: map[string]any{
+						"type":        "edge_ngram",
+						"min_gram":    c.PrefixMinGram,
+						"max_gram":    c.PrefixMaxGram,
+						"token_chars": []string{"letter", "digit"},
+					},
+				},
+				"analyzer": map[string]any{
+					identifierAnalyzer: map[string]any{
+						"type":      "custom",
+						"tokenizer": "standard",
+						"filter":    []string{"lowercase", "asciifolding"},
+					},
+					prefixAnalyzer: map[string]any{
+						"type":      "custom",
+						"tokenizer": prefixAnalyzer,
+						"filter":    []string{"lowercase", "asciifolding"},
+					},
+				},
+			},
+		},
+		"mappings": map[string]any{
+			"dynamic_templates": []any{
+				map[string]any{
+					"row_fields": map[string]any{
+						"path_match":         "fields.*",
+						"match_mapping_type": "string",
+						"mapping":            identifier(),
+					},
+				},
+			},
+			"properties": map[string]any{
+				"embedding": map[string]any{
+					"type":       "dense_vector",
+					"dims":       c.Dims,
+					"index":      true,
+					"similarity": "cosine",
+				},
+				"combined": map[string]any{
+					"type":     "text",
+					"analyzer": c.TextAnalyzer,
+				},
+				"raw": raw,
+				"fields": map[string]any{
+					"type": "object",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexConfig_IndexBody(t *testing.T) {
+	type test struct {
+		config       IndexConfig
+		wantDims     float64
+		wantShards   float64
+		wantAnalyzer string
+		wantMinGram  float64
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given only dims, When built, Return the defaults": func(t *testing.T) test {
+			return test{
+				config:       IndexConfig{Dims: 3072},
+				wantDims:     3072,
+				wantShards:   1,
+				wantAnalyzer: "indonesian",
+				wantMinGram:  2,
+			}
+		},
+		"Given a full config, When built, Return it": func(t *testing.T) test {
+			return test{
+				config:       IndexConfig{Dims: 8, Shards: 3, TextAnalyzer: "english", PrefixMinGram: 3},
+				wantDims:     8,
+				wantShards:   3,
+				wantAnalyzer: "english",
+				wantMinGram:  3,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			body, err := json.Marshal(tt.config.IndexBody())
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			// read it back the way Elasticsearch would
+			var got struct {
+				Settings struct {
+					Shards   float64 `json:"number_of_shards"`
+					Analysis struct {
+						Analyzer  map[string]map[string]any `json:"analyzer"`
+						Tokenizer map[string]map[string]any `json:"tokenizer"`
+					} `json:"analysis"`
+				} `json:"settings"`
+				Mappings struct {
+					Properties map[string]struct {
+						Type     string                    `json:"type"`
+						Dims     float64                   `json:"dims"`
+						Analyzer string                    `json:"analyzer"`
+						Fields   map[string]map[string]any `json:"fields"`
+					} `json:"properties"`
+				} `json:"mappings"`
+			}
+			if !assert.NoError(t, json.Unmarshal(body, &got)) {
+				return
+			}
+
+			assert.Equal(t, tt.wantShards, got.Settings.Shards)
+			assert.Equal(t, tt.wantDims, got.Mappings.Properties["embedding"].Dims)
+			assert.Equal(t, tt.wantAnalyzer, got.Mappings.Properties["combined"].Analyzer)
+			assert.Equal(t, tt.wantMinGram, got.Settings.Analysis.Tokenizer[prefixAnalyzer]["min_gram"])
+
+			// every custom analyzer used by raw is defined
+			raw := got.Mappings.Properties["raw"]
+			assert.Contains(t, got.Settings.Analysis.Analyzer, raw.Analyzer)
+			assert.Contains(t, got.Settings.Analysis.Analyzer, raw.Fields["prefix"]["analyzer"])
+			assert.NotContains(t, raw.Fields, "keyword")
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package elasticsearch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Modes of the full text part of a search.
+const (
+	// QueryModeSimple uses simple_query_string, which never fails on syntax.
+	QueryModeSimple = "simple_query_string"
+	// QueryModeMultiMatch analyzes the text as plain words.
+	QueryModeMultiMatch = "multi_match"
+	// QueryModeQueryString uses query_string with every Lucene operator escaped.
+	QueryModeQueryString = "query_string"
+)
+
+// SearchRequest is the body of a _search request.
+type SearchRequest struct {
+	Source  *SourceFilter `json:"_source,omitempty"`
+	Query   *Query        `json:"query,omitempty"`
+	KNN     *KNNQuery     `json:"knn,omitempty"`
+	Rescore *Rescore      `json:"rescore,omitempty"`
+	Size    int           `json:"size,omitempty"`
+}
+
+// SourceFilter selects the fields of _source in the hits.
+type SourceFilter struct {
+	Includes []string `json:"includes,omitempty"`
+	Excludes []string `json:"excludes,omitempty"`
+}
+
+// Query holds exactly one query clause.
+type Query struct {
+	MatchAll          *MatchAll          `json:"match_all,omitempty"`
+	SimpleQueryString *SimpleQueryString `json:"simple_query_string,omitempty"`
+	MultiMatch        *MultiMatch        `json:"multi_match,omitempty"`
+	QueryString       *QueryString       `json:"query_string,omitempty"`
+	ScriptScore       *ScriptScore       `json:"script_score,omitempty"`
+	// Terms maps a field to the exact values it may hold.
+	Terms map[string][]string `json:"terms,omitempty"`
+}
+
+// MatchAll matches every document.
+type MatchAll struct{}
+
+type SimpleQueryString struct {
+	Query              string   `json:"query"`
+	Fields             []string `json:"fields,omitempty"`
+	DefaultOperator    string   `json:"default_operator,omitempty"`
+	MinimumShouldMatch string   `json:"minimum_should_match,omitempty"`
+	Boost              float64  `json:"boost,omitempty"`
+}
+
+type MultiMatch struct {
+	Query              string   `json:"query"`
+	Fields             []string `json:"fields,omitempty"`
+	Type               string   `json:"type,omitempty"`
+	Operator           string   `json:"operator,omitempty"`
+	MinimumShouldMatch string   `json:"minimum_should_match,omitempty"`
+	Boost              float64  `json:"boost,omitempty"`
+}
+
+type QueryString struct {
+	Query              string   `json:"query"`
+	Fields             []string `json:"fields,omitempty"`
+	DefaultOperator    string   `json:"default_operator,omitempty"`
+	MinimumShouldMatch string   `json:"minimum_should_match,omitempty"`
+	Boost              float64  `json:"boost,omitempty"`
+}
+
+type ScriptScore struct {
+	Query  Query  `json:"query"`
+	Script Script `json:"script"`
+}
+
+type Script struct {
+	Source string         `json:"source"`
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// KNNQuery is an approximate nearest neighbour search on a dense_vector field.
+type KNNQuery struct {
+	Field         string    `json:"field"`
+	QueryVector   []float64 `json:"query_vector"`
+	K             int       `json:"k"`
+	NumCandidates int       `json:"num_candidates"`
+	Boost         float64   `json:"boost,omitempty"`
+}
+
+type Rescore struct {
+	WindowSize int          `json:"window_size"`
+	Query      RescoreQuery `json:"query"`
+}
+
+type RescoreQuery struct {
+	RescoreQuery Query `json:"rescore_query"`
+}
+
+// KeywordField returns the keyword subfield of a column of the row, which
+// matches its whole value regardless of case.
+func KeywordField(column string) string {
+	return "fields." + column + ".keyword"
+}
+
+// ValidateQueryMode checks that mode is one of the supported query modes.
+func ValidateQueryMode(mode string) error {
+	switch mode {
+	case QueryModeSimple, QueryModeMultiMatch, QueryModeQueryString:
+		return nil
+	default:
+		return fmt.Errorf("unknown query mode %q", mode)
+	}
+}
+
+// TextQuery returns the full text clause of the mode matching any word of
+// text in fields. Any user text is safe: it is never parsed as query syntax
+// except by simple_query_string, which ignores invalid syntax.
+func TextQuery(mode, text string, fields []string, boost float64) (*Query, error) {
+	switch mode {
+	case QueryModeSimple:
+		return &Query{SimpleQueryString: &SimpleQueryString{
+			Query:              text,
+			Fields:             fields,
+			DefaultOperator:    "or",
+			MinimumShouldMatch: "1",
+			Boost:              boost,
+		}}, nil
+	case QueryModeMultiMatch:
+		return &Query{MultiMatch: &MultiMatch{
+			Query:              text,
+			Fields:             fields,
+			Type:               "best_fields",
+			Operator:           "or",
+			MinimumShouldMatch: "1",
+			Boost:              boost,
+		}}, nil
+	case QueryModeQueryString:
+		return &Query{QueryString: &QueryString{
+			Query:              EscapeQueryString(text),
+			Fields:             fields,
+			DefaultOperator:    "or",
+			MinimumShouldMatch: "1",
+			Boost:              boost,
+		}}, nil
+	default:
+		return nil, ValidateQueryMode(mode)
+	}
+}
+
+// queryStringEscaper escapes the Lucene reserved characters. < and > cannot
+// be escaped and are replaced by spaces.
+var queryStringEscaper = strings.NewReplacer(
+	`\`, `\\`, `+`, `\+`, `-`, `\-`, `=`, `\=`, `&`, `\&`, `|`, `\|`, `!`, `\!`,
+	`(`, `\(`, `)`, `\)`, `{`, `\{`, `}`, `\}`, `[`, `\[`, `]`, `\]`, `^`, `\^`,
+	`"`, `\"`, `~`, `\~`, `*`, `\*`, `?`, `\?`, `:`, `\:`, `/`, `\/`,
+	`<`, ` `, `>`, ` `,
+)
+
+// booleanOperators are the words query_string reads as operators.
+var booleanOperators = regexp.MustCompile(`\b(AND|OR|NOT)\b`)
+
+// EscapeQueryString makes text match literally in a query_string query.
+// Boolean operators are lowercased, the analyzer lowercases them anyway.
+func EscapeQueryString(text string) string {
+	text = booleanOperators.ReplaceAllStringFunc(text, strings.ToLower)
+	return queryStringEscaper.Replace(text)
+}
@@ -0,0 +1,134 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeQueryString(t *testing.T) {
+	tests := map[string]struct {
+		text string
+		want string
+	}{
+		"Given plain words, Return them unchanged": {
+			text: "plat nomor T8324AP",
+			want: "plat nomor T8324AP",
+		},
+		"Given Lucene operators, Return them escaped": {
+			text: `harga (awal) AND "B 1207" -KDZ`,
+			want: `harga \(awal\) and \"B 1207\" \-KDZ`,
+		},
+		"Given a backslash and a slash, Return them escaped": {
+			text: `a\b/c`,
+			want: `a\\b\/c`,
+		},
+		"Given angle brackets, Return spaces": {
+			text: "<script>",
+			want: " script ",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EscapeQueryString(tt.text))
+		})
+	}
+}
+
+func TestESClient_IndexSearch(t *testing.T) {
+	question := `mobil "B1207KDZ" \ harga: [awal]?`
+
+	tests := map[string]struct {
+		mode      string
+		wantQuery string
+	}{
+		"Given simple_query_string mode, Return the question verbatim": {
+			mode:      QueryModeSimple,
+			wantQuery: question,
+		},
+		"Given multi_match mode, Return the question verbatim": {
+			mode:      QueryModeMultiMatch,
+			wantQuery: question,
+		},
+		"Given query_string mode, Return the question escaped": {
+			mode:      QueryModeQueryString,
+			wantQuery: `mobil \"B1207KDZ\" \\ harga\: \[awal\]\?`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SearchRequest
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.NoError(t, json.Unmarshal(body, &got), "request body must be valid JSON")
+
+				w.Header().Set("X-Elastic-Product", "Elasticsearch")
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"hits":{"hits":[]}}`))
+			}))
+			defer server.Close()
+
+			client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{server.URL}})
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			sut := &ESClient{client: client, index: "test", queryMode: tt.mode}
+
+			_, err = sut.IndexSearch(question, 5)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, 5, got.Size)
+
+			switch tt.mode {
+			case QueryModeSimple:
+				assert.Equal(t, tt.wantQuery, got.Query.SimpleQueryString.Query)
+			case QueryModeMultiMatch:
+				assert.Equal(t, tt.wantQuery, got.Query.MultiMatch.Query)
+			case QueryModeQueryString:
+				assert.Equal(t, tt.wantQuery, got.Query.QueryString.Query)
+			}
+		})
+	}
+}
+
+func TestESClient_TermsSearch(t *testing.T) {
+	var got map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &got), "request body must be valid JSON")
+
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"hits":{"hits":[]}}`))
+	}))
+	defer server.Close()
+
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{server.URL}})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	sut := &ESClient{client: client, index: "test"}
+
+	_, err = sut.TermsSearch(KeywordField("plat_no"), []string{"T8324AP"}, 5)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, map[string]any{
+		"terms": map[string]any{"fields.plat_no.keyword": []any{"T8324AP"}},
+	}, got["query"])
+	assert.Equal(t, float64(5), got["size"])
+}
@@ -0,0 +1,45 @@
+package embedder
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// ErrEmptyEmbedding is returned when the provider answers without any vector.
+var ErrEmptyEmbedding = errors.New("error getting embedding")
+
+// Embedder turns text into a vector.
+type Embedder interface {
+	// Embed returns the vector of the input and the number of tokens used.
+	Embed(ctx context.Context, input string) ([]float64, int, error)
+	// EmbedBatch returns one vector per input, in input order, and the total
+	// number of tokens used. A nil vector means the provider skipped that input.
+	EmbedBatch(ctx context.Context, inputs []string) ([][]float64, int, error)
+	// Model returns the name of the embedding model.
+	Model() string
+	// Dimensions returns the length of the vectors, 0 when unknown.
+	Dimensions() int
+}
+
+// IsRetryable reports whether the error is a rate limit (429) or a server
+// error (5xx) returned by the provider, which is worth retrying later.
+func IsRetryable(err error) bool {
+	var apiErr *openai.APIError
+	if errors.As(err, &apiErr) {
+		return isRetryableStatus(apiErr.HTTPStatusCode)
+	}
+
+	var reqErr *openai.RequestError
+	if errors.As(err, &reqErr) {
+		return isRetryableStatus(reqErr.HTTPStatusCode)
+	}
+
+	return false
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
@@ -0,0 +1,71 @@
+package embedder_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/pkg/embedder"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Given a 429 API error, Return true": {
+			err:  &openai.APIError{HTTPStatusCode: 429},
+			want: true,
+		},
+		"Given a wrapped 503 request error, Return true": {
+			err:  fmt.Errorf("embed batch: %w", &openai.RequestError{HTTPStatusCode: 503}),
+			want: true,
+		},
+		"Given a 400 API error, Return false": {
+			err:  &openai.APIError{HTTPStatusCode: 400},
+			want: false,
+		},
+		"Given a non provider error, Return false": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, embedder.IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestDimensions(t *testing.T) {
+	tests := map[string]struct {
+		sut  embedder.Embedder
+		want int
+	}{
+		"Given ada-002, Return 1536": {
+			sut:  embedder.NewOpenAIEmbedder("test", "text-embedding-ada-002"),
+			want: 1536,
+		},
+		"Given text-embedding-3-large, Return 3072": {
+			sut:  embedder.NewOpenAIEmbedder("test", "text-embedding-3-large"),
+			want: 3072,
+		},
+		"Given an unknown compatible model, Return 0": {
+			sut:  embedder.NewCompatibleEmbedder("http://localhost:8080/v1", "", "nomic-embed-text"),
+			want: 0,
+		},
+		"Given the hash embedder, Return its dimensions": {
+			sut:  embedder.NewHashEmbedder(64),
+			want: 64,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.sut.Dimensions())
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package embedder
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+const hashModel = "hash"
+
+type hashEmbedder struct {
+	dimensions int
+}
+
+// NewHashEmbedder returns a deterministic, offline Embedder based on feature
+// hashing. Each lower-cased word is hashed into one of the dimensions with a
+// signed weight and the result is L2 normalised, so texts sharing words get a
+// positive cosine similarity. It is meant for tests and local development.
+func NewHashEmbedder(dimensions int) Embedder {
+	return &hashEmbedder{
+		dimensions: dimensions,
+	}
+}
+
+func (e *hashEmbedder) Model() string {
+	return hashModel
+}
+
+func (e *hashEmbedder) Dimensions() int {
+	return e.dimensions
+}
+
+func (e *hashEmbedder) Embed(_ context.Context, input string) ([]float64, int, error) {
+	words := strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	if len(words) == 0 || e.dimensions <= 0 {
+		return nil, 0, ErrEmptyEmbedding
+	}
+
+	vector := make([]float64, e.dimensions)
+	for _, word := range words {
+		h := fnv.New64a()
+		h.Write([]byte(word))
+		sum := h.Sum64()
+
+		sign := 1.0
+		if sum&(1<<63) != 0 {
+			sign = -1.0
+		}
+
+		vector[sum%uint64(e.dimensions)] += sign
+	}
+
+	var norm float64
+	for _, v := range vector {
+		norm += v * v
+	}
+	norm = math.Sqrt(norm)
+
+	if norm == 0 {
+		return nil, 0, ErrEmptyEmbedding
+	}
+
+	for i := range vector {
+		vector[i] /= norm
+	}
+
+	return vector, len(words), nil
+}
+
+func (e *hashEmbedder) EmbedBatch(ctx context.Context, inputs []string) ([][]float64, int, error) {
+	vectors := make([][]float64, len(inputs))
+	total := 0
+	for i, input := range inputs {
+		vector, nTokens, err := e.Embed(ctx, input)
+		if err != nil {
+			if errors.Is(err, ErrEmptyEmbedding) {
+				continue
+			}
+			return nil, 0, err
+		}
+
+		vectors[i] = vector
+		total += nTokens
+	}
+
+	return vectors, total, nil
+}
@@ -0,0 +1,74 @@
+package embedder_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/pkg/embedder"
+)
+
+func TestHashEmbedder_Embed(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input string
+	}
+
+	type test struct {
+		args       args
+		wantTokens int
+		wantErr    error
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given text input, When embedded, Return normalised vector and token count": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx:   context.Background(),
+					input: "stok nomor BA00001023J09 plat T8324AP",
+				},
+				wantTokens: 5,
+				wantErr:    nil,
+			}
+		},
+		"Given input without words, When embedded, Return empty embedding error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx:   context.Background(),
+					input: " ;; - ",
+				},
+				wantErr: embedder.ErrEmptyEmbedding,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			sut := embedder.NewHashEmbedder(64)
+
+			got, tokens, err := sut.Embed(tt.args.ctx, tt.args.input)
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Len(t, got, 64)
+			assert.Equal(t, tt.wantTokens, tokens)
+
+			again, _, err := sut.Embed(tt.args.ctx, tt.args.input)
+			assert.NoError(t, err)
+			assert.Equal(t, got, again)
+
+			var norm float64
+			for _, v := range got {
+				norm += v * v
+			}
+			assert.InDelta(t, 1.0, norm, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package embedder
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// modelDimensions are the vector lengths of the OpenAI embedding models.
+var modelDimensions = map[string]int{
+	"text-embedding-ada-002": 1536,
+	"text-embedding-3-small": 1536,
+	"text-embedding-3-large": 3072,
+}
+
+type openAIEmbedder struct {
+	client *openai.Client
+	model  string
+}
+
+// NewOpenAIEmbedder returns an Embedder backed by the OpenAI embeddings API.
+func NewOpenAIEmbedder(apiKey, model string) Embedder {
+	return &openAIEmbedder{
+		client: openai.NewClient(apiKey),
+		model:  model,
+	}
+}
+
+// NewCompatibleEmbedder returns an Embedder for any server exposing an
+// OpenAI-compatible /embeddings endpoint, e.g. a local inference server.
+// baseURL must include the API version prefix, e.g. http://localhost:8080/v1.
+func NewCompatibleEmbedder(baseURL, apiKey, model string) Embedder {
+	config := openai.DefaultConfig(apiKey)
+	config.BaseURL = strings.TrimRight(baseURL, "/")
+
+	return &openAIEmbedder{
+		client: openai.NewClientWithConfig(config),
+		model:  model,
+	}
+}
+
+func (e *openAIEmbedder) Model() string {
+	return e.model
+}
+
+// Dimensions returns the vector length of known OpenAI models, 0 for any
+// other model.
+func (e *openAIEmbedder) Dimensions() int {
+	return modelDimensions[e.model]
+}
+
+func (e *openAIEmbedder) Embed(ctx context.Context, input string) ([]float64, int, error) {
+	vectors, nTokens, err := e.EmbedBatch(ctx, []string{input})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if vectors[0] == nil {
+		return nil, 0, ErrEmptyEmbedding
+	}
+
+	return vectors[0], nTokens, nil
+}
+
+func (e *openAIEmbedder) EmbedBatch(ctx context.Context, inputs []string) ([][]float64, int, error) {
+	resp, err := e.client.CreateEmbeddings(ctx, openai.EmbeddingRequestStrings{
+		Input: inputs,
+		Model: openai.EmbeddingModel(e.model),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(resp.Data) == 0 {
+		return nil, 0, ErrEmptyEmbedding
+	}
+
+	// the API does not guarantee ordering, so map every vector back by index
+	vectors := make([][]float64, len(inputs))
+	for _, data := range resp.Data {
+		if data.Index < 0 || data.Index >= len(inputs) {
+			continue
+		}
+
+		vectors[data.Index] = convertToFloat64(data.Embedding)
+	}
+
+	return vectors, resp.Usage.PromptTokens, nil
+}
+
+func convertToFloat64(embedding []float32) []float64 {
+	ret := make([]float64, 0, len(embedding))
+	for _, v := range embedding {
+		ret = append(ret, float64(v))
+	}
+	return ret
+}
@@ -0,0 +1,266 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrNoDownMigration is returned when rolling back a migration without a .down.sql file.
+var ErrNoDownMigration = errors.New("migration has no down file")
+
+var filePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration is a numbered pair of up and down SQL scripts.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Load reads the migrations of the top level of fsys, named
+// NNN_name.up.sql and NNN_name.down.sql, ordered by version.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		match := filePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("version %d is used by %q and %q", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("version %d %s has no up file", m.Version, m.Name)
+		}
+
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Migrator applies the migrations of a file system and records the applied
+// versions in its own table.
+type Migrator struct {
+	pool  *pgxpool.Pool
+	fsys  fs.FS
+	table string
+}
+
+// New returns a Migrator tracking its versions in table.
+func New(pool *pgxpool.Pool, fsys fs.FS, table string) *Migrator {
+	return &Migrator{
+		pool:  pool,
+		fsys:  fsys,
+		table: table,
+	}
+}
+
+// Up applies every pending migration in order and returns the applied ones.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	migrations, err := Load(m.fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	err = m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		done, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range migrations {
+			if done[migration.Version] {
+				continue
+			}
+
+			insert := fmt.Sprintf(`INSERT INTO %s(version, name, applied_at) VALUES($1, $2, NOW())`, m.tableName())
+			if err := m.run(ctx, conn, migration, migration.Up, insert); err != nil {
+				return err
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down rolls back the last steps applied migrations, newest first, and
+// returns the rolled back ones.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	migrations, err := Load(m.fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	var rolledBack []Migration
+	err = m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		done, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(migrations) - 1; i >= 0 && len(rolledBack) < steps; i-- {
+			migration := migrations[i]
+			if !done[migration.Version] {
+				continue
+			}
+
+			if migration.Down == "" {
+				return fmt.Errorf("version %d %s: %w", migration.Version, migration.Name, ErrNoDownMigration)
+			}
+
+			remove := fmt.Sprintf(`DELETE FROM %s WHERE version = $1 AND name = $2`, m.tableName())
+			if err := m.run(ctx, conn, migration, migration.Down, remove); err != nil {
+				return err
+			}
+
+			rolledBack = append(rolledBack, migration)
+		}
+
+		return nil
+	})
+
+	return rolledBack, err
+}
+
+// Version returns the highest applied version, 0 when none is applied.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	var version int64
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		query := fmt.Sprintf(`SELECT COALESCE(MAX(version), 0) FROM %s`, m.tableName())
+
+		return conn.QueryRow(ctx, query).Scan(&version)
+	})
+
+	return version, err
+}
+
+// run executes the script and the bookkeeping statement in one transaction.
+func (m *Migrator) run(ctx context.Context, conn *pgxpool.Conn, migration Migration, script, track string) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	// no arguments, so the script is sent with the simple protocol and may
+	// hold several statements
+	if _, err := tx.Exec(ctx, script); err != nil {
+		return fmt.Errorf("version %d %s: %w", migration.Version, migration.Name, err)
+	}
+
+	if _, err := tx.Exec(ctx, track, migration.Version, migration.Name); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// withLock runs fn on a single connection holding an advisory lock, so
+// instances starting together do not apply the same migration twice.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	key := m.lockKey()
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, key); err != nil {
+		return err
+	}
+	defer conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, key) //nolint:errcheck
+
+	create := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL
+	)`, m.tableName())
+	if _, err := conn.Exec(ctx, create); err != nil {
+		return err
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context, conn *pgxpool.Conn) (map[int64]bool, error) {
+	rows, err := conn.Query(ctx, fmt.Sprintf(`SELECT version FROM %s`, m.tableName()))
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(map[int64]bool, len(versions))
+	for _, version := range versions {
+		done[version] = true
+	}
+
+	return done, nil
+}
+
+func (m *Migrator) tableName() string {
+	return pgx.Identifier{m.table}.Sanitize()
+}
+
+func (m *Migrator) lockKey() int64 {
+	h := fnv.New64a()
+	h.Write([]byte("migrate:" + m.table))
+	return int64(h.Sum64())
+}
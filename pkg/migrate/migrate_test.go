@@ -0,0 +1,100 @@
+package migrate_test
+
+import (
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yonisaka/similarity/migrations"
+	"github.com/yonisaka/similarity/pkg/migrate"
+)
+
+func TestLoad(t *testing.T) {
+	type test struct {
+		fsys         fstest.MapFS
+		wantVersions []int64
+		wantErr      bool
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given up and down files, When loaded, Return migrations ordered by version": func(t *testing.T) test {
+			return test{
+				fsys: fstest.MapFS{
+					"010_add_index.up.sql":    {Data: []byte("CREATE INDEX i ON t (c);")},
+					"002_create_t.up.sql":     {Data: []byte("CREATE TABLE t (c INT);")},
+					"002_create_t.down.sql":   {Data: []byte("DROP TABLE t;")},
+					"README.md":               {Data: []byte("not a migration")},
+					"seeds/001_seed_t.up.sql": {Data: []byte("INSERT INTO t VALUES (1);")},
+				},
+				wantVersions: []int64{2, 10},
+			}
+		},
+		"Given a down file without up file, Return error": func(t *testing.T) test {
+			return test{
+				fsys: fstest.MapFS{
+					"001_create_t.down.sql": {Data: []byte("DROP TABLE t;")},
+				},
+				wantErr: true,
+			}
+		},
+		"Given two names for one version, Return error": func(t *testing.T) test {
+			return test{
+				fsys: fstest.MapFS{
+					"001_create_t.up.sql": {Data: []byte("CREATE TABLE t (c INT);")},
+					"001_create_u.up.sql": {Data: []byte("CREATE TABLE u (c INT);")},
+				},
+				wantErr: true,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			got, err := migrate.Load(tt.fsys)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			versions := make([]int64, 0, len(got))
+			for _, m := range got {
+				versions = append(versions, m.Version)
+			}
+			assert.Equal(t, tt.wantVersions, versions)
+		})
+	}
+}
+
+func TestLoad_Embedded(t *testing.T) {
+	schema, err := migrate.Load(migrations.Schema())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, schema)
+
+	for _, m := range schema {
+		assert.NotEmpty(t, m.Down, "version %d %s has no down file", m.Version, m.Name)
+	}
+
+	seeds, err := migrate.Load(migrations.Seeds())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, seeds)
+}
+
+// TestLoad_EmbeddedRerunnable checks the schema migrations create nothing
+// without IF NOT EXISTS, so they run again on a database golang-migrate set up.
+func TestLoad_EmbeddedRerunnable(t *testing.T) {
+	create := regexp.MustCompile(`(?i)\b(CREATE\s+(TABLE|INDEX)|ADD\s+COLUMN)\s+(IF\s+NOT\s+EXISTS\s+)?`)
+
+	schema, err := migrate.Load(migrations.Schema())
+	assert.NoError(t, err)
+
+	for _, m := range schema {
+		for _, match := range create.FindAllStringSubmatch(m.Up, -1) {
+			assert.NotEmpty(t, match[3], "version %d %s: %s without IF NOT EXISTS", m.Version, m.Name, match[1])
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package qdrant
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	pb "github.com/qdrant/go-client/qdrant"
+	"github.com/webws/go-moda/logger"
+)
+
+// The collection name of a client is an alias pointing to the live version,
+// a collection named <alias>_v<N>. Reindexing builds version N+1 next to the
+// live one, fills it and moves the alias in one atomic operation, so searches
+// never hit a half filled collection. Only the first reindex of a plain
+// collection, made before aliases were used, has a short gap, see SwapAlias.
+
+// Version is a versioned collection behind an alias.
+type Version struct {
+	Name   string
+	Number int
+}
+
+// AliasTarget returns the collection the alias points to, empty when the
+// alias does not exist.
+func (qc *QdrantClient) AliasTarget(ctx context.Context) (string, error) {
+	response, err := qc.Collection().ListAliases(ctx, &pb.ListAliasesRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	for _, alias := range response.Aliases {
+		if alias.AliasName == qc.collection {
+			return alias.CollectionName, nil
+		}
+	}
+
+	return "", nil
+}
+
+// Versions returns the versioned collections of the alias, oldest first.
+func (qc *QdrantClient) Versions(ctx context.Context) ([]Version, error) {
+	names, err := qc.collectionNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern := regexp.MustCompile("^" + regexp.QuoteMeta(qc.collection) + `_v(\d+)$`)
+
+	var versions []Version
+	for _, name := range names {
+		match := pattern.FindStringSubmatch(name)
+		if match == nil {
+			continue
+		}
+
+		number, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+
+		versions = append(versions, Version{Name: name, Number: number})
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Number < versions[j].Number
+	})
+
+	return versions, nil
+}
+
+// CreateVersion creates the next versioned collection with the current
+// vector, HNSW and memmap settings and returns a client working on it.
+func (qc *QdrantClient) CreateVersion(ctx context.Context) (*QdrantClient, error) {
+	versions, err := qc.Versions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	number := 1
+	if len(versions) > 0 {
+		number = versions[len(versions)-1].Number + 1
+	}
+
+	name := fmt.Sprintf("%s_v%d", qc.collection, number)
+	if err := qc.CreateCollection(name, qc.size); err != nil {
+		return nil, err
+	}
+
+	return qc.WithCollection(name), nil
+}
+
+// aliasAttempts is how many times the alias replacing a deleted plain
+// collection is created before giving up.
+const aliasAttempts = 3
+
+// SwapAlias points the alias to the collection in one atomic operation.
+//
+// A plain collection holding the alias name, created before aliases were
+// used, has to be deleted before the alias can take its name. That one time
+// migration is not atomic: searches fail between the delete and the creation
+// of the alias, which follow each other right away. Call it only once the
+// collection is filled.
+func (qc *QdrantClient) SwapAlias(ctx context.Context, collection string) error {
+	target, err := qc.AliasTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	create := &pb.AliasOperations{
+		Action: &pb.AliasOperations_CreateAlias{
+			CreateAlias: &pb.CreateAlias{CollectionName: collection, AliasName: qc.collection},
+		},
+	}
+
+	if target != "" {
+		return qc.updateAliases(ctx, &pb.AliasOperations{
+			Action: &pb.AliasOperations_DeleteAlias{
+				DeleteAlias: &pb.DeleteAlias{AliasName: qc.collection},
+			},
+		}, create)
+	}
+
+	names, err := qc.collectionNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	plain := false
+	for _, name := range names {
+		if name == qc.collection {
+			plain = true
+			break
+		}
+	}
+
+	if !plain {
+		return qc.updateAliases(ctx, create)
+	}
+
+	// nothing is deleted unless the alias has a collection to point to
+	if _, err := qc.Collection().Get(ctx, &pb.GetCollectionInfoRequest{CollectionName: collection}); err != nil {
+		return fmt.Errorf("collection %s: %w", collection, err)
+	}
+
+	logger.Infow("replacing plain collection by an alias, searches fail until it exists",
+		"collection", qc.collection, "target", collection)
+	if err := qc.DeleteCollection(qc.collection); err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = qc.updateAliases(ctx, create)
+		if err == nil || attempt == aliasAttempts || ctx.Err() != nil {
+			break
+		}
+
+		time.Sleep(time.Duration(attempt) * time.Second)
+	}
+
+	if err != nil {
+		logger.Errorw("plain collection deleted but its alias not created, point it to the target by hand",
+			"alias", qc.collection, "target", collection, "err", err)
+		return fmt.Errorf("alias %s to %s: %w", qc.collection, collection, err)
+	}
+
+	logger.Infow("replaced plain collection by an alias", "alias", qc.collection, "target", collection)
+
+	return nil
+}
+
+func (qc *QdrantClient) updateAliases(ctx context.Context, actions ...*pb.AliasOperations) error {
+	_, err := qc.Collection().UpdateAliases(ctx, &pb.ChangeAliases{Actions: actions})
+	return err
+}
+
+// EnsureAlias creates the first version and its alias when neither the alias
+// nor a plain collection of that name exists.
+func (qc *QdrantClient) EnsureAlias(ctx context.Context) error {
+	target, err := qc.AliasTarget(ctx)
+	if err != nil || target != "" {
+		return err
+	}
+
+	names, err := qc.collectionNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if name == qc.collection {
+			return nil
+		}
+	}
+
+	version, err := qc.CreateVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	return qc.SwapAlias(ctx, version.collection)
+}
+
+// DropOldVersions deletes every version the alias does not point to.
+func (qc *QdrantClient) DropOldVersions(ctx context.Context) error {
+	target, err := qc.AliasTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	versions, err := qc.Versions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		if version.Name == target {
+			continue
+		}
+
+		if err := qc.DeleteCollection(version.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DropAll deletes the alias with every version, and the plain collection of
+// that name if any.
+func (qc *QdrantClient) DropAll(ctx context.Context) error {
+	versions, err := qc.Versions(ctx)
+	if err != nil {
+		return err
+	}
+
+	// deleting a collection removes its aliases
+	for _, version := range versions {
+		if err := qc.DeleteCollection(version.Name); err != nil {
+			return err
+		}
+	}
+
+	return qc.DeleteCollection(qc.collection)
+}
+
+// Reindex builds a new version, fills it and moves the alias to it. The
+// previous versions are dropped once retention has passed, so the old one
+// stays around for a rollback meanwhile. Versions whose drop was lost to a
+// restart are dropped after the next reindex.
+func (qc *QdrantClient) Reindex(
+	ctx context.Context,
+	fill func(ctx context.Context, target *QdrantClient) error,
+	retention time.Duration,
+) (string, error) {
+	version, err := qc.CreateVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err := fill(ctx, version); err != nil {
+		// leave the live version alone, the partial one is of no use
+		if err := qc.DeleteCollection(version.collection); err != nil {
+			logger.Errorw("failed to delete partial version", "collection", version.collection, "err", err)
+		}
+		return "", err
+	}
+
+	// the filled version is kept when the alias cannot be moved, so it can
+	// still be pointed to by hand
+	if err := qc.SwapAlias(ctx, version.collection); err != nil {
+		return "", err
+	}
+
+	time.AfterFunc(retention, func() {
+		if err := qc.DropOldVersions(context.Background()); err != nil {
+			logger.Errorw("failed to drop old versions", "alias", qc.collection, "err", err)
+		}
+	})
+
+	return version.collection, nil
+}
+
+func (qc *QdrantClient) collectionNames(ctx context.Context) ([]string, error) {
+	response, err := qc.Collection().List(ctx, &pb.ListCollectionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(response.Collections))
+	for _, collection := range response.Collections {
+		names = append(names, collection.Name)
+	}
+
+	return names, nil
+}
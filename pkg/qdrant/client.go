@@ -65,6 +65,14 @@ func (qc *QdrantClient) GetCollectionName() string {
 	return qc.collection
 }
 
+// WithCollection returns a copy of the client working on another collection.
+// The copy shares the gRPC connection.
+func (qc *QdrantClient) WithCollection(name string) *QdrantClient {
+	c := *qc
+	c.collection = name
+	return &c
+}
+
 func (qc *QdrantClient) GetVectorSize() uint64 {
 	return qc.size
 }
@@ -137,6 +145,75 @@ func (qc *QdrantClient) CreatePoints(points []*pb.PointStruct) error {
 	return nil
 }
 
+// UpsertPoints writes the points and waits until they are applied.
+func (qc *QdrantClient) UpsertPoints(ctx context.Context, points []*pb.PointStruct) error {
+	pc := pb.NewPointsClient(qc.grpcConn)
+
+	wait := true
+	_, err := pc.Upsert(ctx, &pb.UpsertPoints{
+		CollectionName: qc.collection,
+		Points:         points,
+		Wait:           &wait,
+	})
+	return err
+}
+
+// DeletePoints removes the points with the given UUIDs.
+func (qc *QdrantClient) DeletePoints(ctx context.Context, uuids []string) error {
+	pc := pb.NewPointsClient(qc.grpcConn)
+
+	ids := make([]*pb.PointId, 0, len(uuids))
+	for _, uuid := range uuids {
+		ids = append(ids, &pb.PointId{PointIdOptions: &pb.PointId_Uuid{Uuid: uuid}})
+	}
+
+	wait := true
+	_, err := pc.Delete(ctx, &pb.DeletePoints{
+		CollectionName: qc.collection,
+		Wait:           &wait,
+		Points: &pb.PointsSelector{
+			PointsSelectorOneOf: &pb.PointsSelector_Points{
+				Points: &pb.PointsIdsList{Ids: ids},
+			},
+		},
+	})
+	return err
+}
+
+// PointIDs returns the UUID of every point of the collection, scrolling
+// pageSize points at a time without payloads or vectors.
+func (qc *QdrantClient) PointIDs(ctx context.Context, pageSize uint32) ([]string, error) {
+	sc := pb.NewPointsClient(qc.grpcConn)
+
+	var ids []string
+	var offset *pb.PointId
+	for {
+		response, err := sc.Scroll(ctx, &pb.ScrollPoints{
+			CollectionName: qc.collection,
+			Offset:         offset,
+			Limit:          &pageSize,
+			WithPayload: &pb.WithPayloadSelector{
+				SelectorOptions: &pb.WithPayloadSelector_Enable{Enable: false},
+			},
+			WithVectors: &pb.WithVectorsSelector{
+				SelectorOptions: &pb.WithVectorsSelector_Enable{Enable: false},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, point := range response.Result {
+			ids = append(ids, point.Id.GetUuid())
+		}
+
+		if response.NextPageOffset == nil {
+			return ids, nil
+		}
+		offset = response.NextPageOffset
+	}
+}
+
 func (qc *QdrantClient) CreatePoint(uuid string, collection string, vector []float32, payload map[string]string) error {
 	point := &pb.PointStruct{}
 	point.Id = &pb.PointId{
@@ -169,7 +246,9 @@ func (qc *QdrantClient) CreatePoint(uuid string, collection string, vector []flo
 	return nil
 }
 
-func (qc *QdrantClient) Search(ctx context.Context, vector []float32) ([]*pb.ScoredPoint, error) {
+// Search returns the limit nearest points of the collection name of the
+// client, the alias of the live version once the collection was reindexed.
+func (qc *QdrantClient) Search(ctx context.Context, vector []float32, limit uint64) ([]*pb.ScoredPoint, error) {
 	sc := pb.NewPointsClient(qc.grpcConn)
 
 	var strArr []string
@@ -183,7 +262,7 @@ func (qc *QdrantClient) Search(ctx context.Context, vector []float32) ([]*pb.Sco
 	searchResponse, err := sc.Search(ctx, &pb.SearchPoints{
 		CollectionName: qc.collection,
 		Vector:         vector,
-		Limit:          3,
+		Limit:          limit,
 		Offset:         &offset,
 		WithPayload: &pb.WithPayloadSelector{
 			SelectorOptions: &pb.WithPayloadSelector_Include{
@@ -193,12 +272,9 @@ func (qc *QdrantClient) Search(ctx context.Context, vector []float32) ([]*pb.Sco
 			},
 		},
 	})
+	// nothing was synced to the alias yet
 	if err != nil && strings.Contains(err.Error(), ErrNotFound) {
-		if err := qc.CreateCollection(qc.collection, qc.size); err != nil {
-			logger.Errorw("search vector failed", "err", err)
-			return nil, err
-		}
-		return qc.Search(ctx, vector)
+		return nil, nil
 	}
 
 	if err != nil {
@@ -212,6 +288,64 @@ func (qc *QdrantClient) Search(ctx context.Context, vector []float32) ([]*pb.Sco
 	return searchResponse.Result, nil
 }
 
+// CreateKeywordIndex indexes the payload field for exact keyword matches.
+// Indexing a field again is a no-op.
+func (qc *QdrantClient) CreateKeywordIndex(ctx context.Context, field string) error {
+	pc := pb.NewPointsClient(qc.grpcConn)
+
+	wait := true
+	fieldType := pb.FieldType_FieldTypeKeyword
+	_, err := pc.CreateFieldIndex(ctx, &pb.CreateFieldIndexCollection{
+		CollectionName: qc.collection,
+		Wait:           &wait,
+		FieldName:      field,
+		FieldType:      &fieldType,
+	})
+	return err
+}
+
+// MatchKeywords returns up to limit points whose payload field equals one of
+// the keywords. The match is case sensitive.
+func (qc *QdrantClient) MatchKeywords(ctx context.Context, field string, keywords []string, limit uint32) ([]*pb.RetrievedPoint, error) {
+	sc := pb.NewPointsClient(qc.grpcConn)
+
+	response, err := sc.Scroll(ctx, &pb.ScrollPoints{
+		CollectionName: qc.collection,
+		Limit:          &limit,
+		Filter: &pb.Filter{
+			Must: []*pb.Condition{{
+				ConditionOneOf: &pb.Condition_Field{
+					Field: &pb.FieldCondition{
+						Key: field,
+						Match: &pb.Match{
+							MatchValue: &pb.Match_Keywords{
+								Keywords: &pb.RepeatedStrings{Strings: keywords},
+							},
+						},
+					},
+				},
+			}},
+		},
+		WithPayload: &pb.WithPayloadSelector{
+			SelectorOptions: &pb.WithPayloadSelector_Include{
+				Include: &pb.PayloadIncludeSelector{
+					Fields: []string{"combined"},
+				},
+			},
+		},
+	})
+	// nothing was synced to the alias yet
+	if err != nil && strings.Contains(err.Error(), ErrNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Result, nil
+}
+
 func (qc *QdrantClient) Scroll(ctx context.Context, query string) ([]*pb.RetrievedPoint, error) {
 	sc := pb.NewPointsClient(qc.grpcConn)
 
@@ -240,11 +374,7 @@ func (qc *QdrantClient) Scroll(ctx context.Context, query string) ([]*pb.Retriev
 		},
 	})
 	if err != nil && strings.Contains(err.Error(), ErrNotFound) {
-		if err := qc.CreateCollection(qc.collection, qc.size); err != nil {
-			logger.Errorw("scroll failed", "err", err)
-			return nil, err
-		}
-		return qc.Scroll(ctx, query)
+		return nil, nil
 	}
 
 	if err != nil {
@@ -0,0 +1,216 @@
+package tabular
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var errNotObject = errors.New("not a JSON object")
+
+type jsonReader struct {
+	strict bool
+}
+
+// Read reads a JSON array of objects, one record per object.
+func (j *jsonReader) Read(r io.Reader) (*Table, error) {
+	dec := json.NewDecoder(skipBOM(r))
+	dec.UseNumber()
+
+	if err := expectDelim(dec, '['); err != nil {
+		return nil, err
+	}
+
+	objects := &objectTable{}
+	for line := 1; dec.More(); line++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("element %d: %w", line, err)
+		}
+
+		keys, values, err := decodeObject(raw)
+		if err != nil {
+			if j.strict {
+				return nil, fmt.Errorf("element %d: %w", line, err)
+			}
+
+			objects.skip(line, err.Error())
+			continue
+		}
+
+		objects.add(line, keys, values)
+	}
+
+	if err := expectDelim(dec, ']'); err != nil {
+		return nil, err
+	}
+
+	return objects.table(), nil
+}
+
+type jsonLinesReader struct {
+	strict bool
+}
+
+// Read reads one JSON object per line, blank lines are ignored.
+func (j *jsonLinesReader) Read(r io.Reader) (*Table, error) {
+	scanner := bufio.NewScanner(skipBOM(r))
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	objects := &objectTable{}
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		keys, values, err := decodeObject(text)
+		if err != nil {
+			if j.strict {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+
+			objects.skip(line, err.Error())
+			continue
+		}
+
+		objects.add(line, keys, values)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return objects.table(), nil
+}
+
+// objectTable collects objects whose keys become the table headers, in the
+// order they first appear.
+type objectTable struct {
+	headers []string
+	seen    map[string]bool
+	objects []map[string]string
+	lines   []int
+	skipped []SkippedLine
+}
+
+func (o *objectTable) add(line int, keys []string, values map[string]string) {
+	if o.seen == nil {
+		o.seen = make(map[string]bool)
+	}
+
+	for _, key := range keys {
+		if !o.seen[key] {
+			o.seen[key] = true
+			o.headers = append(o.headers, key)
+		}
+	}
+
+	o.objects = append(o.objects, values)
+	o.lines = append(o.lines, line)
+}
+
+func (o *objectTable) skip(line int, reason string) {
+	o.skipped = append(o.skipped, SkippedLine{Line: line, Reason: reason})
+}
+
+func (o *objectTable) table() *Table {
+	records := make([][]string, 0, len(o.objects))
+	for _, values := range o.objects {
+		record := make([]string, len(o.headers))
+		for i, header := range o.headers {
+			record[i] = values[header]
+		}
+		records = append(records, record)
+	}
+
+	return &Table{
+		Headers: o.headers,
+		Records: records,
+		Lines:   o.lines,
+		Skipped: o.skipped,
+	}
+}
+
+// decodeObject returns the keys of a JSON object in document order and the
+// text of every value. Nested values are kept as compact JSON.
+func decodeObject(data []byte) ([]string, map[string]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, nil, errNotObject
+	}
+
+	var keys []string
+	values := make(map[string]string)
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key, _ := token.(string)
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, nil, err
+		}
+
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = valueText(raw)
+	}
+
+	return keys, values, nil
+}
+
+func valueText(raw json.RawMessage) string {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool, float64:
+		return strings.TrimSpace(string(raw))
+	default:
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, raw); err != nil {
+			return string(raw)
+		}
+		return buf.String()
+	}
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("expected %s, got %v", delim, token)
+	}
+
+	return nil
+}
+
+// skipBOM drops a leading UTF-8 byte order mark.
+func skipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte("\ufeff")) {
+		_, _ = br.Discard(3)
+	}
+
+	return br
+}
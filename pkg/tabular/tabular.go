@@ -0,0 +1,116 @@
+package tabular
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"strings"
+
+	"github.com/yonisaka/similarity/pkg/csvreader"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSON  = "json"
+	FormatJSONL = "jsonl"
+	FormatXLSX  = "xlsx"
+)
+
+var contentTypes = map[string]string{
+	"text/csv":                 FormatCSV,
+	"application/csv":          FormatCSV,
+	"application/vnd.ms-excel": FormatCSV,
+	"application/json":         FormatJSON,
+	"application/x-ndjson":     FormatJSONL,
+	"application/jsonl":        FormatJSONL,
+	"application/x-jsonlines":  FormatJSONL,
+	"application/jsonlines":    FormatJSONL,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": FormatXLSX,
+}
+
+var extensions = map[string]string{
+	".csv":    FormatCSV,
+	".txt":    FormatCSV,
+	".json":   FormatJSON,
+	".jsonl":  FormatJSONL,
+	".ndjson": FormatJSONL,
+	".xlsx":   FormatXLSX,
+}
+
+// Table is the row model every source is read into.
+type Table struct {
+	Headers []string
+	Records [][]string
+	// Lines holds where each record starts in the source: the line for CSV
+	// and JSON Lines, the element number for JSON and the row for XLSX.
+	Lines   []int
+	Skipped []SkippedLine
+}
+
+// SkippedLine is a source line left out of the table.
+type SkippedLine struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+// Reader reads a whole source into a table.
+type Reader interface {
+	Read(r io.Reader) (*Table, error)
+}
+
+// NewReader returns the reader of the format. The dialect configures CSV and
+// the header override of XLSX; its strict mode applies to every format.
+func NewReader(format string, dialect csvreader.Dialect) (Reader, error) {
+	switch format {
+	case FormatCSV:
+		return &csvReader{dialect: dialect}, nil
+	case FormatJSON:
+		return &jsonReader{strict: dialect.Strict}, nil
+	case FormatJSONL:
+		return &jsonLinesReader{strict: dialect.Strict}, nil
+	case FormatXLSX:
+		return &xlsxReader{dialect: dialect}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// DetectFormat returns the format of a source from its content type, or from
+// the file extension when the content type is missing or generic.
+func DetectFormat(filename, contentType string) (string, error) {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		if format, ok := contentTypes[strings.ToLower(mediaType)]; ok {
+			return format, nil
+		}
+	}
+
+	if format, ok := extensions[strings.ToLower(filepath.Ext(filename))]; ok {
+		return format, nil
+	}
+
+	return "", fmt.Errorf("unsupported file %q with content type %q", filename, contentType)
+}
+
+type csvReader struct {
+	dialect csvreader.Dialect
+}
+
+func (c *csvReader) Read(r io.Reader) (*Table, error) {
+	result, err := csvreader.Read(r, c.dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &Table{
+		Headers: result.Headers,
+		Records: result.Records,
+		Lines:   result.Lines,
+	}
+
+	for _, skipped := range result.Skipped {
+		table.Skipped = append(table.Skipped, SkippedLine{Line: skipped.Line, Reason: skipped.Reason})
+	}
+
+	return table, nil
+}
@@ -0,0 +1,135 @@
+package tabular_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+	"github.com/yonisaka/similarity/pkg/csvreader"
+	"github.com/yonisaka/similarity/pkg/tabular"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := map[string]struct {
+		filename    string
+		contentType string
+		want        string
+		wantErr     bool
+	}{
+		"Given a JSON content type, Return json": {
+			filename:    "export",
+			contentType: "application/json; charset=utf-8",
+			want:        tabular.FormatJSON,
+		},
+		"Given a generic content type, Return format of the extension": {
+			filename:    "export.ndjson",
+			contentType: "application/octet-stream",
+			want:        tabular.FormatJSONL,
+		},
+		"Given an xlsx file without content type, Return xlsx": {
+			filename: "lelang.XLSX",
+			want:     tabular.FormatXLSX,
+		},
+		"Given an unknown file, Return error": {
+			filename: "lelang.pdf",
+			wantErr:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tabular.DetectFormat(tt.filename, tt.contentType)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReader_Read(t *testing.T) {
+	type test struct {
+		format      string
+		input       []byte
+		wantHeaders []string
+		wantRecords [][]string
+		wantLines   []int
+		wantSkipped []tabular.SkippedLine
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given a JSON array, When read, Return one record per object with the union of keys": func(t *testing.T) test {
+			return test{
+				format:      tabular.FormatJSON,
+				input:       []byte(`[{"stock_no":"BA1","tahun":2022},{"stock_no":"BA2","warna":"Hitam","tahun":null},"oops"]`),
+				wantHeaders: []string{"stock_no", "tahun", "warna"},
+				wantRecords: [][]string{{"BA1", "2022", ""}, {"BA2", "", "Hitam"}},
+				wantLines:   []int{1, 2},
+				wantSkipped: []tabular.SkippedLine{{Line: 3, Reason: "not a JSON object"}},
+			}
+		},
+		"Given JSON Lines, When read, Return one record per line": func(t *testing.T) test {
+			return test{
+				format:      tabular.FormatJSONL,
+				input:       []byte("{\"a\":\"1\",\"b\":{\"c\":true}}\n\n{\"a\":\"2\"}\n"),
+				wantHeaders: []string{"a", "b"},
+				wantRecords: [][]string{{"1", `{"c":true}`}, {"2", ""}},
+				wantLines:   []int{1, 3},
+			}
+		},
+		"Given a workbook, When read, Return rows of the first sheet": func(t *testing.T) test {
+			f := excelize.NewFile()
+			_ = f.SetSheetRow("Sheet1", "A1", &[]string{"stock_no", "plat_no"})
+			_ = f.SetSheetRow("Sheet1", "A2", &[]string{"BA1", "T8324AP"})
+			_ = f.SetSheetRow("Sheet1", "A4", &[]string{"BA2"})
+
+			var buf bytes.Buffer
+			_ = f.Write(&buf)
+
+			return test{
+				format:      tabular.FormatXLSX,
+				input:       buf.Bytes(),
+				wantHeaders: []string{"stock_no", "plat_no"},
+				wantRecords: [][]string{{"BA1", "T8324AP"}, {"BA2", ""}},
+				wantLines:   []int{2, 4},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			sut, err := tabular.NewReader(tt.format, csvreader.Dialect{})
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			got, err := sut.Read(bytes.NewReader(tt.input))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.wantHeaders, got.Headers)
+			assert.Equal(t, tt.wantRecords, got.Records)
+			assert.Equal(t, tt.wantLines, got.Lines)
+			assert.Equal(t, tt.wantSkipped, got.Skipped)
+		})
+	}
+}
+
+func TestReader_ReadStrict(t *testing.T) {
+	sut, err := tabular.NewReader(tabular.FormatJSONL, csvreader.Dialect{Strict: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = sut.Read(strings.NewReader("{\"a\":1}\nnot json\n"))
+
+	assert.ErrorContains(t, err, "line 2")
+}
@@ -0,0 +1,85 @@
+package tabular
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+	"github.com/yonisaka/similarity/pkg/csvreader"
+)
+
+type xlsxReader struct {
+	dialect csvreader.Dialect
+}
+
+// Read reads the first sheet of a workbook, one record per row. Blank rows
+// are ignored.
+func (x *xlsxReader) Read(r io.Reader) (*Table, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, errors.New("workbook has no sheet")
+	}
+
+	rows, err := f.GetRows(sheets[0])
+	if err != nil {
+		return nil, err
+	}
+
+	table := &Table{Headers: x.dialect.Headers}
+	firstRow := 1
+	if !x.dialect.NoHeaderRow {
+		if len(rows) == 0 {
+			return nil, errors.New("header: sheet is empty")
+		}
+
+		if len(table.Headers) == 0 {
+			table.Headers = rows[0]
+		}
+		rows = rows[1:]
+		firstRow = 2
+	} else if len(table.Headers) == 0 {
+		return nil, errors.New("headers are required when the file has no header row")
+	}
+
+	for i, row := range rows {
+		line := firstRow + i
+		if isBlank(row) {
+			continue
+		}
+
+		// trailing empty cells are not returned, pad the row to the headers
+		if len(row) > len(table.Headers) {
+			reason := "wrong number of fields"
+			if x.dialect.Strict {
+				return nil, fmt.Errorf("row %d: %s", line, reason)
+			}
+
+			table.Skipped = append(table.Skipped, SkippedLine{Line: line, Reason: reason})
+			continue
+		}
+
+		record := make([]string, len(table.Headers))
+		copy(record, row)
+
+		table.Records = append(table.Records, record)
+		table.Lines = append(table.Lines, line)
+	}
+
+	return table, nil
+}
+
+func isBlank(row []string) bool {
+	for _, cell := range row {
+		if cell != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package tokenizer
+
+import "math"
+
+// bytePairEncode returns the tokens of a piece: its bytes are merged pair by
+// pair, the pair of lowest rank first, until no adjacent pair has a rank.
+func bytePairEncode(piece []byte, ranks map[string]int) []int {
+	if rank, ok := ranks[string(piece)]; ok {
+		return []int{rank}
+	}
+
+	// parts holds the start of every part and the end of the piece
+	parts := make([]int, len(piece)+1)
+	for i := range parts {
+		parts[i] = i
+	}
+
+	for len(parts) > 2 {
+		best, bestRank := -1, math.MaxInt
+		for i := 0; i+2 < len(parts); i++ {
+			rank, ok := ranks[string(piece[parts[i]:parts[i+2]])]
+			if ok && rank < bestRank {
+				best, bestRank = i, rank
+			}
+		}
+
+		if best < 0 {
+			break
+		}
+
+		parts = append(parts[:best+1], parts[best+2:]...)
+	}
+
+	tokens := make([]int, 0, len(parts)-1)
+	for i := 0; i+1 < len(parts); i++ {
+		// every byte has a rank in a complete vocabulary
+		tokens = append(tokens, ranks[string(piece[parts[i]:parts[i+1]])])
+	}
+
+	return tokens
+}
@@ -0,0 +1,72 @@
+//go:build ignore
+
+// gen downloads the vocabularies of the encodings into vocab/ to be embedded,
+// checking them against the hashes published with tiktoken.
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+var vocabularies = []struct {
+	name string
+	url  string
+	hash string
+}{
+	{
+		name: "cl100k_base",
+		url:  "https://openaipublic.blob.core.windows.net/encodings/cl100k_base.tiktoken",
+		hash: "223921b76ee99bde995b7ff738513eef100fb51d18c93597a113bcffe865b2a7",
+	},
+	{
+		name: "o200k_base",
+		url:  "https://openaipublic.blob.core.windows.net/encodings/o200k_base.tiktoken",
+		hash: "446a9538cb6c348e3516120d7c08b09f57c36495e2acfffe59a5bf8b0cfb1a2d",
+	},
+}
+
+func main() {
+	for _, v := range vocabularies {
+		path := filepath.Join("vocab", v.name+".tiktoken")
+		if _, err := os.Stat(path); err == nil {
+			continue
+		}
+
+		if err := download(v.url, path, v.hash); err != nil {
+			log.Fatalf("%s: %v", v.name, err)
+		}
+
+		log.Printf("downloaded %s", path)
+	}
+}
+
+func download(url, path, hash string) error {
+	res, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, res.Status)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != hash {
+		return fmt.Errorf("sha256 %s, expected %s", got, hash)
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
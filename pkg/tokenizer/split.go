@@ -0,0 +1,269 @@
+package tokenizer
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// The splitters cut a text into the pieces the byte pair encoding runs on.
+// They implement the pre-tokenization patterns of tiktoken by hand, Go's
+// regexp has no lookahead for the `\s+(?!\S)` alternative. Alternatives are
+// tried in the order of the pattern and the first one matching wins.
+
+// splitCL100K follows the pattern of cl100k_base:
+//
+//	(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}|
+//	 ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+(?!\S)|\s+
+func splitCL100K(text string) []string {
+	return split([]rune(text), func(r []rune, i int) int {
+		if n := contraction(r, i); n > 0 {
+			return i + n
+		}
+
+		if end := letters(r, i); end > i {
+			return end
+		}
+
+		if end := numbers(r, i); end > i {
+			return end
+		}
+
+		if end := punctuation(r, i, isNewline); end > i {
+			return end
+		}
+
+		return whitespace(r, i)
+	})
+}
+
+// splitO200K follows the pattern of o200k_base:
+//
+//	[^\r\n\p{L}\p{N}]?[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]*[\p{Ll}\p{Lm}\p{Lo}\p{M}]+(?i:'s|'t|'re|'ve|'m|'ll|'d)?|
+//	[^\r\n\p{L}\p{N}]?[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]+[\p{Ll}\p{Lm}\p{Lo}\p{M}]*(?i:'s|'t|'re|'ve|'m|'ll|'d)?|
+//	\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n/]*|\s*[\r\n]+|\s+(?!\S)|\s+
+func splitO200K(text string) []string {
+	return split([]rune(text), func(r []rune, i int) int {
+		if end := casedWord(r, i, lowerTail); end > i {
+			return end + contraction(r, end)
+		}
+
+		if end := casedWord(r, i, upperHead); end > i {
+			return end + contraction(r, end)
+		}
+
+		if end := numbers(r, i); end > i {
+			return end
+		}
+
+		if end := punctuation(r, i, isNewlineOrSlash); end > i {
+			return end
+		}
+
+		return whitespace(r, i)
+	})
+}
+
+// split cuts the runes with next, which returns where the piece starting at
+// i ends.
+func split(r []rune, next func(r []rune, i int) int) []string {
+	var pieces []string
+	for i := 0; i < len(r); {
+		end := next(r, i)
+		if end <= i {
+			// never loop, the patterns match every rune
+			end = i + 1
+		}
+
+		pieces = append(pieces, string(r[i:end]))
+		i = end
+	}
+
+	return pieces
+}
+
+var contractions = []string{"s", "t", "re", "ve", "m", "ll", "d"}
+
+// contraction returns the length of the contraction starting at i, 0 when
+// there is none.
+func contraction(r []rune, i int) int {
+	if i >= len(r) || r[i] != '\'' {
+		return 0
+	}
+
+	for _, c := range contractions {
+		if i+1+len(c) > len(r) {
+			continue
+		}
+
+		matched := true
+		for j, want := range c {
+			if unicode.ToLower(r[i+1+j]) != want {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return 1 + len(c)
+		}
+	}
+
+	return 0
+}
+
+// letters matches [^\r\n\p{L}\p{N}]?\p{L}+.
+func letters(r []rune, i int) int {
+	start := i
+	if isPrefix(r[i]) {
+		start++
+	}
+
+	end := run(r, start, unicode.IsLetter)
+	if end > start {
+		return end
+	}
+
+	// without the prefix
+	end = run(r, i, unicode.IsLetter)
+	if end > i {
+		return end
+	}
+
+	return i
+}
+
+// numbers matches \p{N}{1,3}.
+func numbers(r []rune, i int) int {
+	end := i
+	for end < len(r) && end-i < 3 && unicode.IsNumber(r[end]) {
+		end++
+	}
+
+	return end
+}
+
+// punctuation matches ` ?[^\s\p{L}\p{N}]+` followed by the trailing runes.
+func punctuation(r []rune, i int, trailing func(rune) bool) int {
+	start := i
+	if r[i] == ' ' && i+1 < len(r) && isSymbol(r[i+1]) {
+		start++
+	}
+
+	end := run(r, start, isSymbol)
+	if end == start {
+		return i
+	}
+
+	return run(r, end, trailing)
+}
+
+// whitespace matches \s*[\r\n]+|\s+(?!\S)|\s+.
+func whitespace(r []rune, i int) int {
+	end := run(r, i, unicode.IsSpace)
+	if end == i {
+		return i
+	}
+
+	// \s*[\r\n]+ ends after the last newline of the run
+	for j := end - 1; j >= i; j-- {
+		if isNewline(r[j]) {
+			return j + 1
+		}
+	}
+
+	// \s+(?!\S) leaves the last space to the word after the run
+	if end < len(r) && end-1 > i {
+		return end - 1
+	}
+
+	return end
+}
+
+// The classes of the cased words of o200k_base.
+func upper(c rune) bool {
+	return unicode.In(c, unicode.Lu, unicode.Lt, unicode.Lm, unicode.Lo, unicode.M)
+}
+
+func lower(c rune) bool {
+	return unicode.In(c, unicode.Ll, unicode.Lm, unicode.Lo, unicode.M)
+}
+
+const (
+	// lowerTail is upper* lower+.
+	lowerTail = iota
+	// upperHead is upper+ lower*.
+	upperHead
+)
+
+// casedWord matches [^\r\n\p{L}\p{N}]? followed by the cased word of the
+// form, trying with the prefix first like the regular expression does.
+func casedWord(r []rune, i, form int) int {
+	if isPrefix(r[i]) {
+		if end := casedWordAt(r, i+1, form); end > i+1 {
+			return end
+		}
+	}
+
+	if end := casedWordAt(r, i, form); end > i {
+		return end
+	}
+
+	return i
+}
+
+func casedWordAt(r []rune, start, form int) int {
+	head := run(r, start, upper)
+
+	if form == upperHead {
+		if head == start {
+			return start
+		}
+
+		return run(r, head, lower)
+	}
+
+	// upper* is greedy and gives back runes until lower+ matches
+	for p := head; p >= start; p-- {
+		if p < len(r) && lower(r[p]) {
+			return run(r, p, lower)
+		}
+	}
+
+	return start
+}
+
+func run(r []rune, i int, in func(rune) bool) int {
+	for i < len(r) && in(r[i]) {
+		i++
+	}
+
+	return i
+}
+
+// isPrefix reports whether the rune matches [^\r\n\p{L}\p{N}].
+func isPrefix(c rune) bool {
+	return !isNewline(c) && !unicode.IsLetter(c) && !unicode.IsNumber(c)
+}
+
+// isSymbol reports whether the rune matches [^\s\p{L}\p{N}].
+func isSymbol(c rune) bool {
+	return !unicode.IsSpace(c) && !unicode.IsLetter(c) && !unicode.IsNumber(c)
+}
+
+func isNewline(c rune) bool {
+	return c == '\r' || c == '\n'
+}
+
+func isNewlineOrSlash(c rune) bool {
+	return isNewline(c) || c == '/'
+}
+
+// validPrefix returns the longest prefix of b that is valid UTF-8, so a
+// truncated text never ends in the middle of a rune.
+func validPrefix(b []byte) []byte {
+	for len(b) > 0 && !utf8.Valid(b) {
+		b = b[:len(b)-1]
+	}
+
+	return b
+}
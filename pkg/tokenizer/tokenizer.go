@@ -0,0 +1,227 @@
+// Package tokenizer counts and cuts text in the tokens of the OpenAI models
+// with the byte pair encodings cl100k_base and o200k_base. The vocabularies
+// are embedded, nothing is downloaded at runtime.
+package tokenizer
+
+//go:generate go run gen.go
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	CL100KBase = "cl100k_base"
+	O200KBase  = "o200k_base"
+)
+
+var (
+	// ErrUnknownEncoding is returned for an encoding other than CL100KBase
+	// and O200KBase.
+	ErrUnknownEncoding = errors.New("unknown encoding")
+	// ErrMissingVocabulary is returned when the vocabulary of the encoding
+	// was not embedded, see gen.go.
+	ErrMissingVocabulary = errors.New("vocabulary not embedded, run go generate ./pkg/tokenizer")
+)
+
+//go:embed vocab
+var vocab embed.FS
+
+var splitters = map[string]func(string) []string{
+	CL100KBase: splitCL100K,
+	O200KBase:  splitO200K,
+}
+
+// modelPrefixes maps the model families to their encoding, the longest
+// matching prefix wins.
+var modelPrefixes = map[string]string{
+	"gpt-4o":                 O200KBase,
+	"gpt-4.1":                O200KBase,
+	"gpt-4.5":                O200KBase,
+	"o1":                     O200KBase,
+	"o3":                     O200KBase,
+	"o4":                     O200KBase,
+	"gpt-4":                  CL100KBase,
+	"gpt-3.5-turbo":          CL100KBase,
+	"text-embedding-ada-002": CL100KBase,
+	"text-embedding-3":       CL100KBase,
+}
+
+var (
+	loadMu    sync.Mutex
+	encodings = make(map[string]*Encoding)
+)
+
+// Encoding is a byte pair encoding.
+type Encoding struct {
+	name    string
+	ranks   map[string]int
+	decoder map[int]string
+	split   func(string) []string
+}
+
+// Get returns the encoding of the name, reading its embedded vocabulary
+// once.
+func Get(name string) (*Encoding, error) {
+	split, ok := splitters[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownEncoding, name)
+	}
+
+	loadMu.Lock()
+	defer loadMu.Unlock()
+
+	if e, ok := encodings[name]; ok {
+		return e, nil
+	}
+
+	data, err := vocab.ReadFile("vocab/" + name + ".tiktoken")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", name, ErrMissingVocabulary)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ranks, err := parseVocabulary(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	e := newEncoding(name, ranks, split)
+	encodings[name] = e
+
+	return e, nil
+}
+
+// ForModel returns the encoding of the model.
+func ForModel(model string) (*Encoding, error) {
+	name, prefix := "", ""
+	for p, encoding := range modelPrefixes {
+		if strings.HasPrefix(model, p) && len(p) > len(prefix) {
+			name, prefix = encoding, p
+		}
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("%w for model %q", ErrUnknownEncoding, model)
+	}
+
+	return Get(name)
+}
+
+// NewEncoding returns an encoding with the ranks of the tokens, split like
+// the named encoding. Get returns the encodings of the embedded vocabularies.
+func NewEncoding(name string, ranks map[string]int) (*Encoding, error) {
+	split, ok := splitters[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownEncoding, name)
+	}
+
+	return newEncoding(name, ranks, split), nil
+}
+
+func newEncoding(name string, ranks map[string]int, split func(string) []string) *Encoding {
+	decoder := make(map[int]string, len(ranks))
+	for token, rank := range ranks {
+		decoder[rank] = token
+	}
+
+	return &Encoding{
+		name:    name,
+		ranks:   ranks,
+		decoder: decoder,
+		split:   split,
+	}
+}
+
+// Name returns the name of the encoding.
+func (e *Encoding) Name() string {
+	return e.name
+}
+
+// Encode returns the tokens of the text. Special tokens are encoded as text.
+func (e *Encoding) Encode(text string) []int {
+	var tokens []int
+	for _, piece := range e.split(text) {
+		tokens = append(tokens, bytePairEncode([]byte(piece), e.ranks)...)
+	}
+
+	return tokens
+}
+
+// Count returns the number of tokens of the text.
+func (e *Encoding) Count(text string) int {
+	n := 0
+	for _, piece := range e.split(text) {
+		n += len(bytePairEncode([]byte(piece), e.ranks))
+	}
+
+	return n
+}
+
+// Decode returns the text of the tokens.
+func (e *Encoding) Decode(tokens []int) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString(e.decoder[token])
+	}
+
+	return b.String()
+}
+
+// Truncate returns the text cut to its first maxTokens tokens, without
+// breaking a rune.
+func (e *Encoding) Truncate(text string, maxTokens int) string {
+	if maxTokens <= 0 {
+		return ""
+	}
+
+	tokens := e.Encode(text)
+	if len(tokens) <= maxTokens {
+		return text
+	}
+
+	return string(validPrefix([]byte(e.Decode(tokens[:maxTokens]))))
+}
+
+// parseVocabulary reads the tiktoken format, a base64 token and its rank on
+// every line.
+func parseVocabulary(data []byte) (map[string]int, error) {
+	ranks := make(map[string]int)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		encoded, rank, ok := strings.Cut(text, " ")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected a token and its rank", line)
+		}
+
+		token, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		n, err := strconv.Atoi(rank)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		ranks[string(token)] = n
+	}
+
+	return ranks, scanner.Err()
+}
@@ -0,0 +1,167 @@
+package tokenizer
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	type test struct {
+		split func(string) []string
+		text  string
+		want  []string
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given words, When splitting cl100k, Return words with their leading space": func(t *testing.T) test {
+			return test{split: splitCL100K, text: "Hello world", want: []string{"Hello", " world"}}
+		},
+		"Given a long number, When splitting cl100k, Return groups of three digits": func(t *testing.T) test {
+			return test{split: splitCL100K, text: "odometer 1085850", want: []string{"odometer", " ", "108", "585", "0"}}
+		},
+		"Given a contraction, When splitting cl100k, Return it apart": func(t *testing.T) test {
+			return test{split: splitCL100K, text: "don't", want: []string{"don", "'t"}}
+		},
+		"Given runs of spaces, When splitting cl100k, Return the last space with the next word": func(t *testing.T) test {
+			return test{split: splitCL100K, text: "a   b  ", want: []string{"a", "  ", " b", "  "}}
+		},
+		"Given newlines, When splitting cl100k, Return them with the spaces before": func(t *testing.T) test {
+			return test{split: splitCL100K, text: "nopol:  \n\nB1207KDZ", want: []string{"nopol", ":", "  \n\n", "B", "120", "7", "KDZ"}}
+		},
+		"Given punctuation, When splitting cl100k, Return it with one leading space": func(t *testing.T) test {
+			return test{split: splitCL100K, text: "harga: Rp.135.000", want: []string{"harga", ":", " Rp", ".", "135", ".", "000"}}
+		},
+		"Given camel case, When splitting o200k, Return a piece per cased word": func(t *testing.T) test {
+			return test{split: splitO200K, text: "HelloWorld MOBILIO", want: []string{"Hello", "World", " MOBILIO"}}
+		},
+		"Given a contraction, When splitting o200k, Return it with its word": func(t *testing.T) test {
+			return test{split: splitO200K, text: "don't", want: []string{"don't"}}
+		},
+		"Given a path, When splitting o200k, Return the slashes after the punctuation": func(t *testing.T) test {
+			return test{split: splitO200K, text: "a.:/b", want: []string{"a", ".:/", "b"}}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			got := tt.split(tt.text)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.text, strings.Join(got, ""))
+		})
+	}
+}
+
+// byteRanks returns a vocabulary with every byte and the merges, ranked in
+// the order given after the bytes.
+func byteRanks(merges ...string) map[string]int {
+	ranks := make(map[string]int)
+	for i := 0; i < 256; i++ {
+		ranks[string([]byte{byte(i)})] = i
+	}
+
+	for i, merge := range merges {
+		ranks[merge] = 256 + i
+	}
+
+	return ranks
+}
+
+func TestEncoding(t *testing.T) {
+	e, err := NewEncoding(CL100KBase, byteRanks("ll", "he", "hell", "hello", " w", "or"))
+	assert.NoError(t, err)
+
+	tokens := e.Encode("hello world")
+	assert.Equal(t, []int{259, 260, 261, 'l', 'd'}, tokens)
+	assert.Equal(t, 5, e.Count("hello world"))
+	assert.Equal(t, "hello world", e.Decode(tokens))
+
+	assert.Equal(t, "hello wor", e.Truncate("hello world", 3))
+	assert.Equal(t, "hello world", e.Truncate("hello world", 10))
+	assert.Equal(t, "", e.Truncate("hello world", 0))
+
+	// a rune cut in the middle is left out
+	assert.Equal(t, "a", e.Truncate("aé", 2))
+
+	_, err = NewEncoding("p50k_base", nil)
+	assert.ErrorIs(t, err, ErrUnknownEncoding)
+}
+
+func TestParseVocabulary(t *testing.T) {
+	data := fmt.Sprintf("%s 0\n%s 1\n", base64.StdEncoding.EncodeToString([]byte("a")), base64.StdEncoding.EncodeToString([]byte(" b")))
+
+	ranks, err := parseVocabulary([]byte(data))
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 0, " b": 1}, ranks)
+
+	_, err = parseVocabulary([]byte("YQ==\n"))
+	assert.Error(t, err)
+}
+
+func TestForModel(t *testing.T) {
+	tests := map[string]string{
+		"gpt-4o-mini":            O200KBase,
+		"gpt-4-turbo":            CL100KBase,
+		"gpt-3.5-turbo-0125":     CL100KBase,
+		"text-embedding-3-small": CL100KBase,
+	}
+
+	for model, want := range tests {
+		t.Run(model, func(t *testing.T) {
+			e, err := ForModel(model)
+			if !assert.NoError(t, err, "run go generate ./pkg/tokenizer") {
+				return
+			}
+
+			assert.Equal(t, want, e.Name())
+		})
+	}
+
+	_, err := ForModel("llama-3")
+	assert.ErrorIs(t, err, ErrUnknownEncoding)
+}
+
+// TestEmbeddedVocabularies checks the encodings against the output of
+// tiktoken.
+func TestEmbeddedVocabularies(t *testing.T) {
+	type test struct {
+		name string
+		text string
+		want []int
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given words, When encoding with cl100k, Return the tokens of tiktoken": func(t *testing.T) test {
+			return test{name: CL100KBase, text: "hello world", want: []int{15339, 1917}}
+		},
+		"Given punctuation, When encoding with cl100k, Return the tokens of tiktoken": func(t *testing.T) test {
+			return test{name: CL100KBase, text: "tiktoken is great!", want: []int{83, 1609, 5963, 374, 2294, 0}}
+		},
+		"Given words, When encoding with o200k, Return the tokens of tiktoken": func(t *testing.T) test {
+			return test{name: O200KBase, text: "hello world", want: []int{24912, 2375}}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			e, err := Get(tt.name)
+			if !assert.NoError(t, err, "run go generate ./pkg/tokenizer") {
+				return
+			}
+
+			got := e.Encode(tt.text)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.text, e.Decode(got))
+		})
+	}
+}